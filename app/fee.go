@@ -75,7 +75,18 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 			cacheCtx, _ := ctx.CacheContext()
 			if acc := dfd.ak.GetAccount(ctx, signer); acc == nil {
 				dfd.ak.SetAccount(cacheCtx, types.NewBaseAccountWithAddress(signer))
-				err := dfd.airdropKeeper.ClaimAllocation(cacheCtx, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+				err := dfd.airdropKeeper.ClaimAllocation(cacheCtx, msg.CampaignId, msg.Chain, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+				if err == nil {
+					dfd.ak.SetAccount(ctx, types.NewBaseAccountWithAddress(signer))
+					return next(ctx, tx, simulate)
+				}
+			}
+		case *airdroptypes.MsgClaimWithProof:
+			signer := msg.GetSigners()[0]
+			cacheCtx, _ := ctx.CacheContext()
+			if acc := dfd.ak.GetAccount(ctx, signer); acc == nil {
+				dfd.ak.SetAccount(cacheCtx, types.NewBaseAccountWithAddress(signer))
+				err := dfd.airdropKeeper.ClaimWithProof(cacheCtx, msg)
 				if err == nil {
 					dfd.ak.SetAccount(ctx, types.NewBaseAccountWithAddress(signer))
 					return next(ctx, tx, simulate)
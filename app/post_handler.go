@@ -0,0 +1,65 @@
+package teritori
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	airdropkeeper "github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	airdroptypes "github.com/TERITORI/teritori-chain/x/airdrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// AirdropActionDecorator records the IBC transfers and wasm contract
+// executions of the reward addresses of action gated airdrop allocations, once
+// the messages of the tx succeeded.
+type AirdropActionDecorator struct {
+	airdropKeeper *airdropkeeper.Keeper
+}
+
+func NewAirdropActionDecorator(airdropKeeper *airdropkeeper.Keeper) AirdropActionDecorator {
+	return AirdropActionDecorator{
+		airdropKeeper: airdropKeeper,
+	}
+}
+
+func (aad AirdropActionDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if success {
+		for _, m := range tx.GetMsgs() {
+			aad.recordAction(ctx, m)
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+func (aad AirdropActionDecorator) recordAction(ctx sdk.Context, m sdk.Msg) {
+	switch msg := m.(type) {
+	case *ibctransfertypes.MsgTransfer:
+		if sender, err := sdk.AccAddressFromBech32(msg.Sender); err == nil {
+			aad.airdropKeeper.TryRecordAction(ctx, sender, airdroptypes.ActionIBCTransfer, "")
+		}
+	case *wasmtypes.MsgExecuteContract:
+		if sender, err := sdk.AccAddressFromBech32(msg.Sender); err == nil {
+			aad.airdropKeeper.TryRecordAction(ctx, sender, airdroptypes.ActionWasmExecute, msg.Contract)
+		}
+	case *authz.MsgExec:
+		// the granter performs the messages executed on its behalf
+		innerMsgs, err := msg.GetMessages()
+		if err != nil {
+			return
+		}
+		for _, innerMsg := range innerMsgs {
+			aad.recordAction(ctx, innerMsg)
+		}
+	}
+}
+
+// NewPostHandler returns a PostHandler recording the actions of the reward
+// addresses of action gated airdrop allocations.
+func NewPostHandler(options HandlerOptions) sdk.PostHandler {
+	postDecorators := []sdk.PostDecorator{
+		NewAirdropActionDecorator(options.AirdropKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...)
+}
@@ -136,7 +136,7 @@ func TestAppStateDeterminism(t *testing.T) {
 			}
 
 			db := dbm.NewMemDB()
-			app := teritori.NewTeritoriApp(logger, db, nil, true, map[int64]bool{}, teritori.DefaultNodeHome, teritori.MakeEncodingConfig(), appOptions, interBlockCacheOpt())
+			app := teritori.NewTeritoriApp(logger, db, nil, true, map[int64]bool{}, teritori.DefaultNodeHome, teritori.MakeEncodingConfig(), appOptions, interBlockCacheOpt(), baseapp.SetChainID(config.ChainID))
 
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
@@ -0,0 +1,18 @@
+package v210
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/TERITORI/teritori-chain/app/upgrades"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v2.1.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagTeamVestingFile = "team-vesting-file"
+
 // PrepareGenesisCmd returns prepare-genesis cobra Command.
 func PrepareGenesisCmd(defaultNodeHome string, mbm module.BasicManager) *cobra.Command {
 	cmd := &cobra.Command{
@@ -42,7 +44,7 @@ func PrepareGenesisCmd(defaultNodeHome string, mbm module.BasicManager) *cobra.C
 		Short: "Prepare a genesis file with initial setup",
 		Long: `Prepare a genesis file with initial setup.
 Example:
-	teritorid prepare-genesis teritori-1 cosmos_aidrop.csv crew3_airdrop.csv evmos_orbital_ape.csv
+	teritorid prepare-genesis teritori-1 cosmos_aidrop.csv crew3_airdrop.csv evmos_orbital_ape.csv --team-vesting-file=mainnet/teritori-1/team_vesting.csv
 	- Check input genesis:
 		file is at ~/.teritorid/config/genesis.json
 `,
@@ -63,9 +65,13 @@ Example:
 
 			// get genesis params
 			chainID := args[0]
+			teamVestingPath, err := cmd.Flags().GetString(flagTeamVestingFile)
+			if err != nil {
+				return err
+			}
 
 			// run Prepare Genesis
-			appState, genDoc, err = PrepareGenesis(clientCtx, appState, genDoc, chainID, args[1], args[2], args[3])
+			appState, genDoc, err = PrepareGenesis(clientCtx, appState, genDoc, chainID, args[1], args[2], args[3], teamVestingPath)
 			if err != nil {
 				return err
 			}
@@ -88,11 +94,26 @@ Example:
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagTeamVestingFile, "", "The team vesting schedule CSV, no team vesting if empty")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+func parseTeamVesting(path string) ([]minttypes.MonthlyVestingAddress, error) {
+	if path == "" {
+		return []minttypes.MonthlyVestingAddress{}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return minttypes.ParseVestingScheduleCSV(f, 6)
+}
+
 func parseCosmosAirdropAmount(path string) ([]airdroptypes.AirdropAllocation, sdk.Coin) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -177,7 +198,7 @@ func combineAirdropAllocations(allocations1, allocations2 []airdroptypes.Airdrop
 	return allocations
 }
 
-func PrepareGenesis(clientCtx client.Context, appState map[string]json.RawMessage, genDoc *tmtypes.GenesisDoc, chainID, cosmosAirdropPath, crew3AirdropPath, evmosOrbitalApePath string) (map[string]json.RawMessage, *tmtypes.GenesisDoc, error) {
+func PrepareGenesis(clientCtx client.Context, appState map[string]json.RawMessage, genDoc *tmtypes.GenesisDoc, chainID, cosmosAirdropPath, crew3AirdropPath, evmosOrbitalApePath, teamVestingPath string) (map[string]json.RawMessage, *tmtypes.GenesisDoc, error) {
 	depCdc := clientCtx.Codec
 	cdc := depCdc
 
@@ -193,6 +214,11 @@ func PrepareGenesis(clientCtx client.Context, appState map[string]json.RawMessag
 	mintGenState.Params = minttypes.DefaultParams()
 	mintGenState.Params.MintDenom = appparams.BaseCoinUnit
 	mintGenState.Params.MintingRewardsDistributionStartBlock = 51840 // 3 days after launch - 86400s x 3 / 5s
+	teamVesting, err := parseTeamVesting(teamVestingPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse team vesting schedule: %w", err)
+	}
+	mintGenState.Params.WeightedDeveloperRewardsReceivers = teamVesting
 	mintGenStateBz, err := cdc.MarshalJSON(mintGenState)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal mint genesis state: %w", err)
@@ -210,6 +236,9 @@ func PrepareGenesis(clientCtx client.Context, appState map[string]json.RawMessag
 	evmosOrbitalApeAllocations, totalEvmosAirdropAllocataion := parseEvmosOrbitalApeAirdropAmount(evmosOrbitalApePath)
 	allocations := append(cosmosAllocations, evmosOrbitalApeAllocations...)
 	airdropGenState.Allocations = allocations
+	genesisCampaign := airdroptypes.NewGenesisCampaign(appparams.BaseCoinUnit)
+	genesisCampaign.TotalAmount = totalCosmosAirdropAllocation.Add(totalEvmosAirdropAllocataion).Amount
+	airdropGenState.Campaigns = []airdroptypes.Campaign{genesisCampaign}
 	airdropGenStateBz, err := cdc.MarshalJSON(airdropGenState)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal airdrop genesis state: %w", err)
@@ -11,13 +11,19 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
+
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
 )
 
+const flagExcludeSupplyLocked = "exclude-supply-locked"
+
 func writeCSV(path string, records [][]string) {
 	f, err := os.Create(path)
 	if err != nil {
@@ -39,8 +45,10 @@ type GenesisState struct {
 
 // AppState is app state structure for app state
 type AppState struct {
+	Auth    interface{}
 	Staking interface{}
 	Bank    interface{}
+	Mint    interface{}
 }
 
 // SnapshotAccount provide fields of snapshot per account
@@ -57,6 +65,9 @@ func ExportRichestSnapshotCmd() *cobra.Command {
 		Use:   "export-richest-snapshot [genesis-file] [output-snapshot-csv]",
 		Short: "Export richest snapshot from genesis export",
 		Long: `Export richest snapshot from genesis export
+The delegations of the module accounts are excluded. With --exclude-supply-locked, the balances
+and the delegations of the module accounts and of the addresses locked from the circulating supply
+by the mint parameters are excluded instead.
 Example:
 	teritorid export-richest-snapshot ./snapshot-teritori-richest.json ./snapshot-teritori-richest.csv
 `,
@@ -103,6 +114,19 @@ Example:
 				return err
 			}
 
+			// exclude both the balances and the delegations of the module accounts
+			// and the accounts whose tokens are locked, as for the circulating supply
+			excludeSupplyLocked, err := cmd.Flags().GetBool(flagExcludeSupplyLocked)
+			if err != nil {
+				return err
+			}
+			if excludeSupplyLocked {
+				excludeAddrs, err = supplyLockedAddrs(codec, genState)
+				if err != nil {
+					return err
+				}
+			}
+
 			bankBytes, err := json.Marshal(genState.AppState.Bank)
 			if err != nil {
 				panic(err)
@@ -114,6 +138,10 @@ Example:
 			snapshotAccs := make(map[string]SnapshotAccount)
 
 			for _, balance := range bankGen.Balances {
+				if excludeSupplyLocked && excludeAddrs[balance.Address] {
+					continue
+				}
+
 				acc, ok := snapshotAccs[balance.Address]
 				if !ok {
 					acc = SnapshotAccount{
@@ -198,7 +226,42 @@ Example:
 		},
 	}
 
+	cmd.Flags().Bool(flagExcludeSupplyLocked, false, "Exclude the balances and delegations of the module accounts and the supply locked addresses")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// supplyLockedAddrs returns the module accounts and the supply locked
+// addresses of the mint parameters of a genesis export.
+func supplyLockedAddrs(cdc codec.Codec, genState GenesisState) (map[string]bool, error) {
+	addrs := make(map[string]bool)
+
+	authBytes, err := json.Marshal(genState.AppState.Auth)
+	if err != nil {
+		return nil, err
+	}
+	authGen := authtypes.GenesisState{}
+	cdc.MustUnmarshalJSON(authBytes, &authGen)
+	accounts, err := authtypes.UnpackAccounts(authGen.Accounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if _, ok := account.(authtypes.ModuleAccountI); ok {
+			addrs[account.GetAddress().String()] = true
+		}
+	}
+
+	mintBytes, err := json.Marshal(genState.AppState.Mint)
+	if err != nil {
+		return nil, err
+	}
+	mintGen := minttypes.GenesisState{}
+	cdc.MustUnmarshalJSON(mintBytes, &mintGen)
+	for _, address := range mintGen.Params.SupplyLockedAddresses() {
+		addrs[address] = true
+	}
+
+	return addrs, nil
+}
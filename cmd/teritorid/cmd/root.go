@@ -32,6 +32,7 @@ import (
 
 	teritori "github.com/TERITORI/teritori-chain/app"
 	"github.com/TERITORI/teritori-chain/app/params"
+	mintcli "github.com/TERITORI/teritori-chain/x/mint/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -128,6 +129,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.ValidateGenesisCmd(teritori.ModuleBasics),
 		PrepareGenesisCmd(teritori.DefaultNodeHome, teritori.ModuleBasics),
 		ExportRichestSnapshotCmd(),
+		mintCommand(),
 		AddGenesisAccountCmd(teritori.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(teritori.ModuleBasics, banktypes.GenesisBalancesIterator{}),
@@ -175,6 +177,22 @@ func queryCommand() *cobra.Command {
 	return cmd
 }
 
+func mintCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "mint",
+		Short:                      "Mint module genesis subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		mintcli.GetVestingCmd(teritori.DefaultNodeHome),
+	)
+
+	return cmd
+}
+
 func txCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "tx",
@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker called every block, sweeps the unclaimed funds of the campaigns
+// whose claim deadline passed to the community pool.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SweepEndedCampaigns(ctx)
 }
@@ -48,6 +48,11 @@ Example:
 				return err
 			}
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
 			newAllocations := []airdroptypes.AirdropAllocation{}
 			allocationRecords := parseStarsAirdropAmount(args[0])
 			for _, line := range allocationRecords[1:] {
@@ -55,9 +60,10 @@ Example:
 				amountDec := sdk.MustNewDecFromStr(amountStr)
 				amount := amountDec.Mul(sdk.NewDec(1000_000)).TruncateInt()
 
-				params := &airdroptypes.QueryAllocationRequest{Address: starsAddr}
+				params := &airdroptypes.QueryAllocationRequest{Address: starsAddr, CampaignId: campaignID, Chain: "stargaze"}
 
 				allocation := airdroptypes.AirdropAllocation{
+					CampaignId:    campaignID,
 					Chain:         "stargaze",
 					Address:       starsAddr,
 					Amount:        sdk.NewInt64Coin(appparams.BaseCoinUnit, 0),
@@ -110,6 +116,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, airdroptypes.GenesisCampaignID, "The campaign of the allocations")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -79,6 +79,11 @@ Example:
 				return err
 			}
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
 			allocations := []airdroptypes.AirdropAllocation{}
 			allocationRecords := parseEvmosOrbitalApeAirdropAmount(args[0])
 			for _, line := range allocationRecords[1:] {
@@ -86,9 +91,10 @@ Example:
 				amountDec := sdk.MustNewDecFromStr(amountStr)
 				amount := amountDec.Mul(sdk.NewDec(1000_000)).TruncateInt()
 
-				params := &airdroptypes.QueryAllocationRequest{Address: airdropAddr}
+				params := &airdroptypes.QueryAllocationRequest{Address: airdropAddr, CampaignId: campaignID, Chain: "evmos"}
 
 				allocation := airdroptypes.AirdropAllocation{
+					CampaignId:    campaignID,
 					Chain:         "evmos",
 					Address:       airdropAddr,
 					Amount:        sdk.NewCoin(appparams.BaseCoinUnit, amount),
@@ -147,6 +153,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, airdroptypes.GenesisCampaignID, "The campaign of the allocations")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -0,0 +1,33 @@
+package cli
+
+const (
+	// The campaign of the allocations
+	FlagCampaignID = "campaign-id"
+	// The chain of the native chain address
+	FlagChain = "chain"
+	// The hex encoded public key of the native chain address
+	FlagPubKey = "pub-key"
+)
+
+const (
+	// The start of the claim window of the campaign, in RFC3339 format
+	FlagStartTime = "start-time"
+	// The end of the claim window of the campaign, in RFC3339 format
+	FlagEndTime = "end-time"
+	// The hex encoded root of the allocation tree of a merkle campaign
+	FlagMerkleRoot = "merkle-root"
+	// The sum of the allocations of the tree of a merkle campaign
+	FlagTotalAmount = "total-amount"
+	// The duration after the claim before which nothing vests
+	FlagVestingCliff = "vesting-cliff"
+	// The duration after the cliff over which the claims vest
+	FlagVestingDuration = "vesting-duration"
+	// The number of periods of the vesting, linear if zero
+	FlagVestingPeriods = "vesting-periods"
+	// The fraction of the allocations paid on the first claim of an action gated campaign
+	FlagInitialFraction = "initial-fraction"
+	// The actions unlocking the rest of the allocations of an action gated campaign
+	FlagActions = "actions"
+	// The contracts whose execution completes the wasm-execute action
+	FlagWasmContracts = "wasm-contracts"
+)
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	appparams "github.com/TERITORI/teritori-chain/app/params"
+	airdroptypes "github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// MerkleAirdrop is the allocation tree of a Merkle campaign, with the proof of
+// each allocation, as written by build-merkle-airdrop.
+type MerkleAirdrop struct {
+	MerkleRoot  string               `json:"merkle_root"`
+	TotalAmount sdk.Coin             `json:"total_amount"`
+	Claims      []MerkleAirdropClaim `json:"claims"`
+}
+
+// MerkleAirdropClaim is an allocation of a Merkle campaign with its proof.
+type MerkleAirdropClaim struct {
+	Index   uint64   `json:"index"`
+	Chain   string   `json:"chain"`
+	Address string   `json:"address"`
+	Amount  math.Int `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+// parseAirdropAmounts reads an airdrop CSV file with a header line and an
+// address and an amount in TORI per line.
+func parseAirdropAmounts(path string) ([]string, []math.Int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%s: missing header line", path)
+	}
+
+	addresses := []string{}
+	amounts := []math.Int{}
+	for i, line := range records[1:] {
+		if len(line) < 2 {
+			return nil, nil, fmt.Errorf("%s: line %d: expected an address and an amount", path, i+2)
+		}
+		amountDec, err := sdk.NewDecFromStr(line[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: line %d: %w", path, i+2, err)
+		}
+		addresses = append(addresses, line[0])
+		amounts = append(amounts, amountDec.Mul(sdk.NewDec(1000_000)).TruncateInt())
+	}
+
+	return addresses, amounts, nil
+}
+
+// buildMerkleAirdrop builds the allocation tree of the airdrop files, given as
+// chain:path. The amounts of an address listed several times on a chain are
+// added up, as allocate-further-airdrop does for on-chain allocations.
+func buildMerkleAirdrop(sources []string) (MerkleAirdrop, error) {
+	claims := []MerkleAirdropClaim{}
+	claimIndex := map[string]int{}
+	totalAmount := math.ZeroInt()
+	for _, source := range sources {
+		chain, path, found := strings.Cut(source, ":")
+		if !found || chain == "" || path == "" {
+			return MerkleAirdrop{}, fmt.Errorf("invalid airdrop file %q, expected chain:path", source)
+		}
+
+		addresses, amounts, err := parseAirdropAmounts(path)
+		if err != nil {
+			return MerkleAirdrop{}, err
+		}
+		for i, address := range addresses {
+			totalAmount = totalAmount.Add(amounts[i])
+			key := chain + "/" + address
+			if index, ok := claimIndex[key]; ok {
+				claims[index].Amount = claims[index].Amount.Add(amounts[i])
+				continue
+			}
+			claimIndex[key] = len(claims)
+			claims = append(claims, MerkleAirdropClaim{
+				Index:   uint64(len(claims)),
+				Chain:   chain,
+				Address: address,
+				Amount:  amounts[i],
+			})
+		}
+	}
+	if len(claims) == 0 {
+		return MerkleAirdrop{}, fmt.Errorf("no allocation in the airdrop files")
+	}
+
+	leaves := make([][]byte, 0, len(claims))
+	for _, claim := range claims {
+		leaves = append(leaves, airdroptypes.MerkleLeaf(claim.Index, claim.Chain, claim.Address, claim.Amount))
+	}
+	root, proofs := airdroptypes.BuildMerkleTree(leaves)
+	for i, proof := range proofs {
+		claims[i].Proof = []string{}
+		for _, hash := range proof {
+			claims[i].Proof = append(claims[i].Proof, hex.EncodeToString(hash))
+		}
+	}
+
+	return MerkleAirdrop{
+		MerkleRoot:  hex.EncodeToString(root),
+		TotalAmount: sdk.NewCoin(appparams.BaseCoinUnit, totalAmount),
+		Claims:      claims,
+	}, nil
+}
+
+// BuildMerkleAirdropCmd returns build merkle airdrop cobra Command.
+func BuildMerkleAirdropCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-merkle-airdrop [output_file] [chain:airdrop_file_path]...",
+		Short: "Build the allocation tree and proofs of a merkle campaign",
+		Long: `Build the allocation tree of a merkle campaign from airdrop CSV files, and write its root, total amount and the proof of each allocation to a JSON file.
+The CSV files have the format read by allocate-further-airdrop, and each is prefixed with the chain of its addresses.
+Example:
+	teritorid tx airdrop build-merkle-airdrop merkle_airdrop.json cosmos:further_airdrop.csv stargaze:Airdrop_HuahuaPunks_Feuille_1.csv evm:evmos_orbital_ape.csv
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			airdrop, err := buildMerkleAirdrop(args[1:])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(airdrop, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[0], bz, 0o644); err != nil {
+				return err
+			}
+
+			fmt.Println("merkle root", airdrop.MerkleRoot)
+			fmt.Println("total amount", airdrop.TotalAmount)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetTxClaimWithProofCmd implement cli command for MsgClaimWithProof
+func GetTxClaimWithProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-with-proof [campaign_id] [merkle_airdrop_file] [native_chain_address] [signature]",
+		Short: "Claim the allocation of a merkle campaign",
+		Long: `Claim the allocation of a native chain address in a merkle campaign, with the proof read from the file written by build-merkle-airdrop.
+Example:
+	teritorid tx airdrop claim-with-proof 1 merkle_airdrop.json 0x583e8DD54b7C3F5Ea23862E0E852f0e6914475D5 $SIGNATURE --from=reward
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var airdrop MerkleAirdrop
+			if err := json.Unmarshal(bz, &airdrop); err != nil {
+				return err
+			}
+
+			pubKey, err := cmd.Flags().GetString(FlagPubKey)
+			if err != nil {
+				return err
+			}
+
+			for _, claim := range airdrop.Claims {
+				if claim.Address != args[2] {
+					continue
+				}
+
+				msg := airdroptypes.NewMsgClaimWithProof(
+					campaignID,
+					claim.Index,
+					claim.Chain,
+					claim.Address,
+					claim.Amount,
+					claim.Proof,
+					pubKey,
+					clientCtx.GetFromAddress(),
+					args[3],
+				)
+
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			return fmt.Errorf("no allocation for %s in %s", args[2], args[1])
+		},
+	}
+
+	cmd.Flags().String(FlagPubKey, "", "The hex encoded public key of the native chain address, for chains that need it to verify the signature")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
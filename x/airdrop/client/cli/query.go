@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/TERITORI/teritori-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +26,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAllocation(),
 		GetCmdQueryParams(),
 		GetCmdQueryAirdropModuleAccount(),
+		GetCmdQueryCampaign(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryCampaignAllocations(),
+		GetCmdQueryMerkleClaimed(),
+		GetCmdQuerySwept(),
+		GetCmdQueryVestingClaims(),
+		GetCmdQueryClaimActions(),
 	)
 
 	return queryCmd
@@ -38,7 +46,16 @@ func GetCmdQueryAllocation() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
-			params := &types.QueryAllocationRequest{Address: args[0]}
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+			chain, err := cmd.Flags().GetString(FlagChain)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllocationRequest{Address: args[0], CampaignId: campaignID, Chain: chain}
 
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Allocation(context.Background(), params)
@@ -50,6 +67,8 @@ func GetCmdQueryAllocation() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, types.GenesisCampaignID, "The campaign of the allocation")
+	cmd.Flags().String(FlagChain, "", "The chain of the address, the first allocation of the address if empty")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -96,3 +115,214 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign [id]",
+		Short: "Query an airdrop campaign and the balance of its escrow account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCampaignRequest{Id: id}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Campaign(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaigns",
+		Short: "Query all airdrop campaigns",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCampaignsRequest{Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Campaigns(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaigns")
+
+	return cmd
+}
+
+func GetCmdQueryCampaignAllocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign-allocations [campaign_id]",
+		Short: "Query the on-chain allocations of an airdrop campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCampaignAllocationsRequest{CampaignId: campaignID, Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CampaignAllocations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaign-allocations")
+
+	return cmd
+}
+
+func GetCmdQueryMerkleClaimed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-claimed [campaign_id] [index]",
+		Short: "Query whether an allocation of a merkle campaign is claimed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMerkleClaimedRequest{CampaignId: campaignID, Index: index}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MerkleClaimed(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySwept() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swept [campaign_id]",
+		Short: "Query the amount of an ended airdrop campaign swept to the community pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySweptRequest{CampaignId: campaignID}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Swept(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVestingClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-claims [address]",
+		Short: "Query the vesting airdrop claims of a reward address and their releasable amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			params := &types.QueryVestingClaimsRequest{Address: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VestingClaims(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryClaimActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-actions [address]",
+		Short: "Query the completed and pending actions of the action gated allocations claimed by a reward address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			params := &types.QueryClaimActionsRequest{Address: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimActions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
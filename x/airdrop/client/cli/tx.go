@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/TERITORI/teritori-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,6 +30,11 @@ func GetTxCmd() *cobra.Command {
 		AllocateFurtherAirdropCmd(),
 		FetchAndRemoveAirdropCmd(),
 		AllocateStarsAirdropCmd(),
+		BuildMerkleAirdropCmd(),
+		GetTxCreateCampaignCmd(),
+		GetTxClaimWithProofCmd(),
+		GetTxSetClaimDeadlineCmd(),
+		GetTxReleaseVestedCmd(),
 	)
 
 	return txCmd
@@ -45,11 +52,27 @@ func GetTxClaimAllocationCmd() *cobra.Command {
 				return err
 			}
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+			chain, err := cmd.Flags().GetString(FlagChain)
+			if err != nil {
+				return err
+			}
+			pubKey, err := cmd.Flags().GetString(FlagPubKey)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgClaimAllocation(
+				campaignID,
+				chain,
 				args[0],
 				clientCtx.FromAddress,
 				args[1],
 			)
+			msg.PubKey = pubKey
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -60,6 +83,9 @@ func GetTxClaimAllocationCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, types.GenesisCampaignID, "The campaign of the allocation")
+	cmd.Flags().String(FlagChain, "", "The chain of the native chain address, the first allocation of the address if empty")
+	cmd.Flags().String(FlagPubKey, "", "The hex encoded public key of the native chain address, for chains that need it to verify the signature")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -88,9 +114,15 @@ func GetTxSetAllocationCmd() *cobra.Command {
 				return err
 			}
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetAllocation(
 				clientCtx.FromAddress.String(),
 				types.AirdropAllocation{
+					CampaignId:    campaignID,
 					Chain:         args[0],
 					Address:       args[1],
 					Amount:        amount,
@@ -107,6 +139,7 @@ func GetTxSetAllocationCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, types.GenesisCampaignID, "The campaign of the allocation")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -145,7 +178,7 @@ func GetTxTransferModuleOwnership() *cobra.Command {
 func GetTxDepositTokensCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deposit-tokens [amount] [flags]",
-		Long: "Deposit tokens to airdrop module",
+		Long: "Deposit tokens to the escrow account of an airdrop campaign",
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -157,8 +190,13 @@ func GetTxDepositTokensCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgDepositTokens(
 				clientCtx.GetFromAddress(),
+				campaignID,
 				amount,
 			)
 
@@ -170,7 +208,220 @@ func GetTxDepositTokensCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignID, types.GenesisCampaignID, "The campaign to fund")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxCreateCampaignCmd implement cli command for MsgCreateCampaign
+func GetTxCreateCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign [description] [denom]",
+		Short: "Create an airdrop campaign",
+		Long: `Create an airdrop campaign. Its allocations are set with set-allocation, or committed to by the root and total amount written by build-merkle-airdrop.
+Example:
+	teritorid tx airdrop create-campaign "Stars airdrop" utori --start-time=2024-01-01T00:00:00Z --end-time=2024-07-01T00:00:00Z --from=owner
+	teritorid tx airdrop create-campaign "Merkle airdrop" utori --merkle-root=$(jq -r .merkle_root merkle_airdrop.json) --total-amount=$(jq -r .total_amount.amount merkle_airdrop.json) --from=owner
+	teritorid tx airdrop create-campaign "Vesting airdrop" utori --vesting-cliff=720h --vesting-duration=4320h --vesting-periods=6 --from=owner
+	teritorid tx airdrop create-campaign "Participation airdrop" utori --initial-fraction=0.2 --actions=delegate,vote,ibc-transfer --from=owner
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+			merkleRoot, err := cmd.Flags().GetString(FlagMerkleRoot)
+			if err != nil {
+				return err
+			}
+			totalAmountStr, err := cmd.Flags().GetString(FlagTotalAmount)
+			if err != nil {
+				return err
+			}
+			totalAmount, ok := sdk.NewIntFromString(totalAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid total amount %s", totalAmountStr)
+			}
+			vesting, err := parseVestingFlags(cmd)
+			if err != nil {
+				return err
+			}
+			actionGate, err := parseActionGateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCampaign(
+				clientCtx.GetFromAddress(),
+				args[0],
+				startTime,
+				endTime,
+				args[1],
+				merkleRoot,
+				totalAmount,
+				vesting,
+				actionGate,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "The start of the claim window, in RFC3339 format, claimable right away if empty")
+	cmd.Flags().String(FlagEndTime, "", "The end of the claim window, in RFC3339 format, no end if empty")
+	cmd.Flags().String(FlagMerkleRoot, "", "The hex encoded root of the allocation tree, empty to set the allocations on-chain")
+	cmd.Flags().String(FlagTotalAmount, "0", "The sum of the allocations of the tree of a merkle campaign")
+	cmd.Flags().Duration(FlagVestingCliff, 0, "The duration after the claim before which nothing vests, claims are paid right away if both the cliff and duration are zero")
+	cmd.Flags().Duration(FlagVestingDuration, 0, "The duration after the cliff over which the claims vest")
+	cmd.Flags().Uint32(FlagVestingPeriods, 0, "The number of equal periods of the vesting duration, linear if zero")
+	cmd.Flags().String(FlagInitialFraction, "0", "The fraction of the allocations paid on the first claim of an action gated campaign")
+	cmd.Flags().StringSlice(FlagActions, nil, "The actions each unlocking an equal part of the rest of the allocations, among delegate, vote, ibc-transfer and wasm-execute, paid in full on claim if empty")
+	cmd.Flags().StringSlice(FlagWasmContracts, nil, "The contracts whose execution completes the wasm-execute action")
 	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
 }
+
+// GetTxSetClaimDeadlineCmd implement cli command for MsgSetClaimDeadline
+func GetTxSetClaimDeadlineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-claim-deadline [campaign_id] [deadline]",
+		Short: "Set the claim deadline of an airdrop campaign",
+		Long: `Set the end of the claim window of an airdrop campaign, in RFC3339 format. The unclaimed funds of the campaign are swept to the community pool once the deadline passed.
+Example:
+	teritorid tx airdrop set-claim-deadline 0 2024-07-01T00:00:00Z --from=owner
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			deadline, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetClaimDeadline(
+				clientCtx.GetFromAddress(),
+				campaignID,
+				deadline,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxReleaseVestedCmd implement cli command for MsgReleaseVested
+func GetTxReleaseVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-vested",
+		Short: "Release the vested amount of the airdrop claims of the sender",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseVested(clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// parseVestingFlags parses the vesting policy flags of a campaign.
+func parseVestingFlags(cmd *cobra.Command) (types.VestingPolicy, error) {
+	cliff, err := cmd.Flags().GetDuration(FlagVestingCliff)
+	if err != nil {
+		return types.VestingPolicy{}, err
+	}
+	duration, err := cmd.Flags().GetDuration(FlagVestingDuration)
+	if err != nil {
+		return types.VestingPolicy{}, err
+	}
+	periods, err := cmd.Flags().GetUint32(FlagVestingPeriods)
+	if err != nil {
+		return types.VestingPolicy{}, err
+	}
+	return types.VestingPolicy{Cliff: cliff, Duration: duration, Periods: periods}, nil
+}
+
+// parseActionGateFlags parses the action gate flags of a campaign.
+func parseActionGateFlags(cmd *cobra.Command) (types.ActionGate, error) {
+	initialFractionStr, err := cmd.Flags().GetString(FlagInitialFraction)
+	if err != nil {
+		return types.ActionGate{}, err
+	}
+	initialFraction, err := sdk.NewDecFromStr(initialFractionStr)
+	if err != nil {
+		return types.ActionGate{}, err
+	}
+	actionNames, err := cmd.Flags().GetStringSlice(FlagActions)
+	if err != nil {
+		return types.ActionGate{}, err
+	}
+	actions := []types.Action{}
+	for _, name := range actionNames {
+		action, err := types.ParseAction(name)
+		if err != nil {
+			return types.ActionGate{}, err
+		}
+		actions = append(actions, action)
+	}
+	wasmContracts, err := cmd.Flags().GetStringSlice(FlagWasmContracts)
+	if err != nil {
+		return types.ActionGate{}, err
+	}
+	return types.ActionGate{InitialFraction: initialFraction, Actions: actions, WasmContracts: wasmContracts}, nil
+}
+
+// parseTimeFlag parses an RFC3339 time flag, returning the zero time if empty.
+func parseTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
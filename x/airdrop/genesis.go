@@ -13,13 +13,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetParamSet(ctx, genState.Params)
 	for _, allocation := range genState.Allocations {
 		k.SetAllocation(ctx, allocation)
+		if allocation.RewardAddress != "" {
+			k.SetRewardAddressIndex(ctx, sdk.MustAccAddressFromBech32(allocation.RewardAddress), allocation)
+		}
+	}
+	for _, campaign := range genState.Campaigns {
+		k.SetCampaign(ctx, campaign)
+	}
+	for _, chunk := range genState.MerkleClaimedChunks {
+		k.SetMerkleClaimedChunk(ctx, chunk)
+	}
+	for _, claim := range genState.VestingClaims {
+		k.SetVestingClaim(ctx, claim)
 	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:      k.GetParamSet(ctx),
-		Allocations: k.GetAllAllocations(ctx),
+		Params:              k.GetParamSet(ctx),
+		Allocations:         k.GetAllAllocations(ctx),
+		Campaigns:           k.GetAllCampaigns(ctx),
+		MerkleClaimedChunks: k.GetAllMerkleClaimedChunks(ctx),
+		VestingClaims:       k.GetAllVestingClaims(ctx),
 	}
 }
@@ -34,6 +34,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.DepositTokens(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateCampaign:
+			res, err := msgServer.CreateCampaign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgClaimWithProof:
+			res, err := msgServer.ClaimWithProof(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetClaimDeadline:
+			res, err := msgServer.SetClaimDeadline(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgReleaseVested:
+			res, err := msgServer.ReleaseVested(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
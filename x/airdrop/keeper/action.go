@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRewardAddressIndex indexes an action gated allocation by the reward
+// address that claimed it, for its actions to unlock the allocation.
+func (k Keeper) SetRewardAddressIndex(ctx sdk.Context, addr sdk.AccAddress, allocation types.AirdropAllocation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.GetRewardAddressAllocationKey(addr, allocation.CampaignId, allocation.Chain, allocation.Address),
+		types.GetAllocationKey(allocation.CampaignId, allocation.Chain, allocation.Address),
+	)
+}
+
+// GetRewardAddressAllocations returns the action gated allocations claimed by
+// a reward address.
+func (k Keeper) GetRewardAddressAllocations(ctx sdk.Context, addr sdk.AccAddress) []types.AirdropAllocation {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetRewardAddressAllocationsPrefix(addr))
+	iterator := indexStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allocations := []types.AirdropAllocation{}
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(iterator.Value())
+		if bz == nil {
+			// the allocation was deleted since it was claimed
+			continue
+		}
+		allocation := types.AirdropAllocation{}
+		k.cdc.MustUnmarshal(bz, &allocation)
+		if allocation.RewardAddress != addr.String() {
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations
+}
+
+// RecordAction unlocks the part of the action gated allocations claimed by a
+// reward address that the action gates, and pays it to the reward address.
+// The execution of a wasm contract is only recorded for the contracts
+// whitelisted by the campaign. Actions after the claim deadline unlock nothing.
+func (k Keeper) RecordAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action, contract string) error {
+	for _, allocation := range k.GetRewardAddressAllocations(ctx, addr) {
+		campaign, found := k.GetCampaign(ctx, allocation.CampaignId)
+		if !found || !campaign.ActionGate.HasAction(action) || allocation.HasCompleted(action) {
+			continue
+		}
+		if allocation.Expired || campaign.HasEnded(ctx.BlockTime()) {
+			continue
+		}
+		if action == types.ActionWasmExecute && !campaign.ActionGate.IsWasmContract(contract) {
+			continue
+		}
+
+		allocation.CompletedActions = append(allocation.CompletedActions, action)
+		unlocked := campaign.ActionGate.UnlockedAmount(allocation.Amount.Amount, allocation.CompletedGatingActions())
+		amount := unlocked.Sub(allocation.ClaimedAmount.Amount)
+		if amount.IsPositive() {
+			if err := k.payClaim(ctx, campaign, allocation.RewardAddress, amount); err != nil {
+				return err
+			}
+			allocation.ClaimedAmount = allocation.ClaimedAmount.AddAmount(amount)
+			campaign.ClaimedAmount = campaign.ClaimedAmount.Add(amount)
+			k.SetCampaign(ctx, campaign)
+		} else {
+			amount = sdk.ZeroInt()
+		}
+		k.SetAllocation(ctx, allocation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteAction,
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAddress, allocation.Address),
+				sdk.NewAttribute(types.AttributeKeyRewardAddress, allocation.RewardAddress),
+				sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(campaign.Denom, amount).String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// TryRecordAction records an action like RecordAction, a failure being logged
+// and its changes discarded, so that it does not affect the recorded action.
+func (k Keeper) TryRecordAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action, contract string) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.RecordAction(cacheCtx, addr, action, contract); err != nil {
+		k.Logger(ctx).Error("failed to record airdrop action", "address", addr, "action", action, "error", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	simapp "github.com/TERITORI/teritori-chain/app"
+	"github.com/TERITORI/teritori-chain/x/airdrop"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestActionGatedClaims() {
+	airdropKeeper := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(airdropKeeper)
+	denom := suite.app.MintKeeper.GetParams(suite.ctx).MintDenom
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	airdropKeeper.SetParamSet(suite.ctx, types.NewParams(owner.String()))
+
+	rewardAddr, err := sdk.AccAddressFromBech32("tori1pkmvlnstq8q7djns3w882pcu92xh4c9x4ukhcd")
+	suite.Require().NoError(err)
+	claimer := newEvmClaimer()
+	evmAddr := claimer.Address()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, owner, coins))
+
+	// the first claim unlocks a fifth, each action a third of the rest
+	contract := sdk.AccAddress(address.Module("wasm", []byte("contract"))).String()
+	gate := types.ActionGate{
+		InitialFraction: sdk.NewDecWithPrec(2, 1),
+		Actions:         []types.Action{types.ActionDelegate, types.ActionVote, types.ActionWasmExecute},
+		WasmContracts:   []string{contract},
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err = msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(owner, "gated", time.Time{}, time.Time{}, denom, "0000000000000000000000000000000000000000000000000000000000000000", sdk.NewInt(1000000), types.VestingPolicy{}, gate))
+	suite.Require().ErrorIs(err, types.ErrMerkleCampaign)
+	res, err := msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(owner, "gated", time.Time{}, time.Time{}, denom, "", sdk.ZeroInt(), types.VestingPolicy{}, gate))
+	suite.Require().NoError(err)
+	suite.Require().NoError(airdropKeeper.DepositTokens(suite.ctx, res.CampaignId, owner, coins))
+	suite.Require().NoError(airdropKeeper.UpdateAllocation(suite.ctx, types.AirdropAllocation{
+		CampaignId:    res.CampaignId,
+		Chain:         "evm",
+		Address:       evmAddr,
+		Amount:        sdk.NewInt64Coin(denom, 1000000),
+		ClaimedAmount: sdk.NewInt64Coin(denom, 0),
+	}))
+	balance := func() sdk.Coin {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom)
+	}
+
+	// actions before the claim unlock nothing
+	airdropKeeper.Hooks().AfterProposalVote(suite.ctx, 1, rewardAddr)
+	suite.Require().True(balance().IsZero())
+
+	_, err = msgServer.ClaimAllocation(ctx, types.NewMsgClaimAllocation(res.CampaignId, "evm", evmAddr, rewardAddr, claimer.Sign(res.CampaignId, keeper.ClaimTypeAllocation, rewardAddr)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 200000), balance())
+	_, err = msgServer.ClaimAllocation(ctx, types.NewMsgClaimAllocation(res.CampaignId, "evm", evmAddr, rewardAddr, claimer.Sign(res.CampaignId, keeper.ClaimTypeAllocation, rewardAddr)))
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationAlreadyClaimed)
+
+	// each gating action unlocks its part once
+	airdropKeeper.Hooks().AfterProposalVote(suite.ctx, 1, rewardAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 466666), balance())
+	airdropKeeper.Hooks().AfterProposalVote(suite.ctx, 2, rewardAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 466666), balance())
+
+	// actions out of the gate and executions of other contracts unlock nothing
+	airdropKeeper.TryRecordAction(suite.ctx, rewardAddr, types.ActionIBCTransfer, "")
+	airdropKeeper.TryRecordAction(suite.ctx, rewardAddr, types.ActionWasmExecute, owner.String())
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 466666), balance())
+	airdropKeeper.TryRecordAction(suite.ctx, rewardAddr, types.ActionWasmExecute, contract)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 733333), balance())
+
+	actions, err := airdropKeeper.ClaimActions(ctx, &types.QueryClaimActionsRequest{Address: rewardAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(actions.Statuses, 1)
+	suite.Require().Equal([]types.Action{types.ActionInitialClaim, types.ActionVote, types.ActionWasmExecute}, actions.Statuses[0].Completed)
+	suite.Require().Equal([]types.Action{types.ActionDelegate}, actions.Statuses[0].Pending)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 733333), actions.Statuses[0].ClaimedAmount)
+
+	// the reward address index is rebuilt from the genesis allocations
+	genesis := airdrop.ExportGenesis(suite.ctx, airdropKeeper)
+	suite.Require().NoError(genesis.Validate())
+	app := simapp.Setup(initChain)
+	genesisCtx := app.BaseApp.NewContext(initChain, tmproto.Header{}).WithBlockTime(now)
+	airdrop.InitGenesis(genesisCtx, app.AirdropKeeper, *genesis)
+	suite.Require().Len(app.AirdropKeeper.GetRewardAddressAllocations(genesisCtx, rewardAddr), 1)
+
+	// the last action unlocks the rest of the allocation
+	suite.Require().NoError(airdropKeeper.Hooks().AfterDelegationModified(suite.ctx, rewardAddr, sdk.ValAddress(owner)))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1000000), balance())
+	campaign, found := airdropKeeper.GetCampaign(suite.ctx, res.CampaignId)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000000), campaign.ClaimedAmount)
+}
@@ -1,16 +1,34 @@
 package keeper
 
 import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/TERITORI/teritori-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) GetAllocation(ctx sdk.Context, address string) *types.AirdropAllocation {
+// GetAllocation returns the allocation of an address on a chain in a campaign.
+// If chain is empty, the first allocation of the address in the campaign is
+// returned.
+func (k Keeper) GetAllocation(ctx sdk.Context, campaignID uint64, chain, address string) *types.AirdropAllocation {
 	allocation := types.AirdropAllocation{}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
-	bz := prefixStore.Get([]byte(address))
+	if chain == "" {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAddressAllocationsPrefix(campaignID, address))
+		iterator := prefixStore.Iterator(nil, nil)
+		defer iterator.Close()
+		if !iterator.Valid() {
+			return nil
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &allocation)
+		return &allocation
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAllocationKey(campaignID, chain, address))
 	if bz == nil {
 		return nil
 	}
@@ -34,55 +52,119 @@ func (k Keeper) GetAllAllocations(ctx sdk.Context) []types.AirdropAllocation {
 }
 
 func (k Keeper) SetAllocation(ctx sdk.Context, allocation types.AirdropAllocation) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&allocation)
-	prefixStore.Set([]byte(allocation.Address), bz)
+	store.Set(types.GetAllocationKey(allocation.CampaignId, allocation.Chain, allocation.Address), bz)
 }
 
-func (k Keeper) DeleteAllocation(ctx sdk.Context, address string) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
-	prefixStore.Delete([]byte(address))
+func (k Keeper) DeleteAllocation(ctx sdk.Context, campaignID uint64, chain, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAllocationKey(campaignID, chain, address))
 }
 
-func (k Keeper) ClaimAllocation(ctx sdk.Context, address string, pubKey string, rewardAddress string, signature string) error {
+// UpdateAllocation sets the amount of an allocation of a campaign with on-chain
+// allocations, updating the total amount of the campaign. Only the amount is
+// editable: a new allocation starts unclaimed, and an existing one keeps its
+// claimed amount, expiry and reward address, its amount not going below the
+// claimed amount.
+func (k Keeper) UpdateAllocation(ctx sdk.Context, allocation types.AirdropAllocation) error {
+	campaign, found := k.GetCampaign(ctx, allocation.CampaignId)
+	if !found {
+		return errors.Wrapf(types.ErrCampaignDoesNotExist, "id %d", allocation.CampaignId)
+	}
+
+	updated := types.AirdropAllocation{
+		CampaignId:    allocation.CampaignId,
+		Chain:         allocation.Chain,
+		Address:       allocation.Address,
+		ClaimedAmount: sdk.NewCoin(campaign.Denom, math.ZeroInt()),
+	}
+	previous := k.GetAllocation(ctx, allocation.CampaignId, allocation.Chain, allocation.Address)
+	if previous != nil {
+		updated = *previous
+	}
+	updated.Amount = allocation.Amount
+	if err := campaign.ValidateAllocation(updated); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		campaign.TotalAmount = campaign.TotalAmount.Sub(previous.Amount.Amount)
+	}
+	campaign.TotalAmount = campaign.TotalAmount.Add(updated.Amount.Amount)
+
+	k.SetCampaign(ctx, campaign)
+	k.SetAllocation(ctx, updated)
+	return nil
+}
+
+func (k Keeper) ClaimAllocation(ctx sdk.Context, campaignID uint64, chain string, address string, pubKey string, rewardAddress string, signature string) error {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return errors.Wrapf(types.ErrCampaignDoesNotExist, "id %d", campaignID)
+	}
+	if err := campaign.CheckClaimWindow(ctx.BlockTime()); err != nil {
+		return err
+	}
+
 	// ensure allocation exists for the address
-	allocation := k.GetAllocation(ctx, address)
+	allocation := k.GetAllocation(ctx, campaignID, chain, address)
 	if allocation == nil {
 		return types.ErrAirdropAllocationDoesNotExists
 	}
 
-	// ensure allocation is not claimed already
+	// ensure allocation is not swept after the claim deadline
+	if allocation.Expired {
+		return types.ErrAirdropAllocationExpired
+	}
+
+	// ensure allocation is not claimed already, the actions of the reward
+	// address paying the rest of an action gated allocation
 	unclaimed := allocation.Amount.Sub(allocation.ClaimedAmount)
-	if unclaimed.IsZero() {
+	if unclaimed.IsZero() || allocation.RewardAddress != "" {
 		return types.ErrAirdropAllocationAlreadyClaimed
 	}
 
 	// verify native chain account with signature
-	sigOk := VerifySignature(allocation.Chain, allocation.Address, pubKey, rewardAddress, signature)
+	sigOk := VerifySignature(campaignID, ClaimTypeAllocation, allocation.Chain, allocation.Address, pubKey, rewardAddress, signature)
 	if !sigOk {
 		return types.ErrNativeChainAccountSigVerificationFailure
 	}
 
-	// send coins from airdrop module account to beneficiary address
-	sdkAddr, err := sdk.AccAddressFromBech32(rewardAddress)
-	if err != nil {
-		return err
+	// the first claim of an action gated allocation pays its initial fraction
+	amount := unclaimed.Amount
+	if !campaign.ActionGate.IsZero() {
+		sdkAddr, err := sdk.AccAddressFromBech32(rewardAddress)
+		if err != nil {
+			return err
+		}
+		amount = math.MaxInt(campaign.ActionGate.UnlockedAmount(allocation.Amount.Amount, 0).Sub(allocation.ClaimedAmount.Amount), math.ZeroInt())
+		allocation.RewardAddress = sdkAddr.String()
+		allocation.CompletedActions = []types.Action{types.ActionInitialClaim}
+		k.SetRewardAddressIndex(ctx, sdkAddr, *allocation)
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdkAddr, sdk.Coins{unclaimed})
-	if err != nil {
-		return err
+	// send coins from the campaign escrow account to beneficiary address, or
+	// to the vesting escrow account if the campaign has a vesting policy
+	if amount.IsPositive() {
+		err := k.payClaim(ctx, campaign, rewardAddress, amount)
+		if err != nil {
+			return err
+		}
 	}
 
 	// update claimed amount and set the record on-chain
-	allocation.ClaimedAmount = allocation.Amount
+	allocation.ClaimedAmount = allocation.ClaimedAmount.AddAmount(amount)
 	k.SetAllocation(ctx, *allocation)
+	campaign.ClaimedAmount = campaign.ClaimedAmount.Add(amount)
+	k.SetCampaign(ctx, campaign)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeClaimAllocation,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaignID, 10)),
 			sdk.NewAttribute(types.AttributeKeyAddress, address),
-			sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(campaign.Denom, amount).String()),
 			sdk.NewAttribute(types.AttributeKeyRewardAddress, rewardAddress),
 		),
 	)
@@ -7,7 +7,7 @@ import (
 
 func (suite *KeeperTestSuite) TestAllocationGetSet() {
 	// get allocation for an address before set
-	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, types.GenesisCampaignID, "evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 	suite.Require().Nil(allocation)
 
 	allocations := suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
@@ -23,16 +23,16 @@ func (suite *KeeperTestSuite) TestAllocationGetSet() {
 	suite.app.AirdropKeeper.SetAllocation(suite.ctx, evmAllocation)
 
 	// check allocation after set
-	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, types.GenesisCampaignID, "evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 	suite.Require().Equal(*allocation, evmAllocation)
 
 	allocations = suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
 	suite.Require().Len(allocations, 7)
 
 	// check allocation after delete
-	suite.app.AirdropKeeper.DeleteAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	suite.app.AirdropKeeper.DeleteAllocation(suite.ctx, types.GenesisCampaignID, "evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 
-	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, types.GenesisCampaignID, "evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 	suite.Require().Nil(allocation)
 
 	allocations = suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCampaign returns the campaign with the given id.
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (types.Campaign, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCampaignKey(id))
+	if bz == nil {
+		return types.Campaign{}, false
+	}
+
+	campaign := types.Campaign{}
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// SetCampaign stores a campaign, indexing it by deadline until it is swept.
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	store := ctx.KVStore(k.storeKey)
+	if previous, found := k.GetCampaign(ctx, campaign.Id); found && !previous.EndTime.IsZero() {
+		store.Delete(types.GetCampaignDeadlineKey(previous.EndTime, previous.Id))
+	}
+	if !campaign.EndTime.IsZero() && !campaign.Swept {
+		store.Set(types.GetCampaignDeadlineKey(campaign.EndTime, campaign.Id), []byte{})
+	}
+	store.Set(types.GetCampaignKey(campaign.Id), k.cdc.MustMarshal(&campaign))
+}
+
+// GetEndedCampaignIDs returns the ids of the campaigns whose deadline passed
+// and that are not swept yet, ordered by deadline.
+func (k Keeper) GetEndedCampaignIDs(ctx sdk.Context) []uint64 {
+	ids := []uint64{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixCampaignDeadline, sdk.PrefixEndBytes(types.GetCampaignDeadlinePrefix(ctx.BlockTime())))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+
+	return ids
+}
+
+// GetAllCampaigns returns all campaigns ordered by id.
+func (k Keeper) GetAllCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaign)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		campaign := types.Campaign{}
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns
+}
+
+// nextCampaignID returns the id following the one of the latest campaign,
+// created campaigns starting at 1.
+func (k Keeper) nextCampaignID(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaign)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.GenesisCampaignID + 1
+	}
+	return sdk.BigEndianToUint64(iterator.Key()) + 1
+}
+
+// CreateCampaign creates a campaign from the description, claim window, denom,
+// vesting policy, action gate and Merkle root and total amount of the given
+// campaign, and returns its id.
+// The campaign is paid from its escrow account, funded with DepositTokens.
+func (k Keeper) CreateCampaign(ctx sdk.Context, campaign types.Campaign) (uint64, error) {
+	campaign.Id = k.nextCampaignID(ctx)
+	campaign.EscrowAddress = types.CampaignEscrowAddress(campaign.Id).String()
+	campaign.ClaimedAmount = math.ZeroInt()
+	campaign.SweptAmount = math.ZeroInt()
+	campaign.Swept = false
+	if !campaign.IsMerkle() || campaign.TotalAmount.IsNil() {
+		// the total amount adds up the allocations set on-chain
+		campaign.TotalAmount = math.ZeroInt()
+	}
+	if err := campaign.Validate(); err != nil {
+		return 0, err
+	}
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEscrowAddress, campaign.EscrowAddress),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, campaign.MerkleRoot),
+		),
+	)
+
+	return campaign.Id, nil
+}
+
+// DepositTokens funds the escrow account of a campaign that has not ended with
+// coins of the campaign denom.
+func (k Keeper) DepositTokens(ctx sdk.Context, campaignID uint64, sender sdk.AccAddress, amount sdk.Coins) error {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return errors.Wrapf(types.ErrCampaignDoesNotExist, "id %d", campaignID)
+	}
+	// the deposits of an ended campaign could neither be claimed nor swept
+	if campaign.Swept || campaign.HasEnded(ctx.BlockTime()) {
+		return errors.Wrapf(types.ErrCampaignEnded, "campaign %d ended at %s", campaign.Id, campaign.EndTime)
+	}
+	for _, coin := range amount {
+		if coin.Denom != campaign.Denom {
+			return errors.Wrapf(types.ErrInvalidCampaignDenom, "%s, campaign %d distributes %s", coin.Denom, campaign.Id, campaign.Denom)
+		}
+	}
+
+	return k.bankKeeper.SendCoins(ctx, sender, types.CampaignEscrowAddress(campaign.Id), amount)
+}
+
+// SetClaimDeadline sets the end of the claim window of a campaign that has not
+// ended yet, after which the unclaimed funds of the campaign are swept to the
+// community pool.
+func (k Keeper) SetClaimDeadline(ctx sdk.Context, campaignID uint64, deadline time.Time) error {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return errors.Wrapf(types.ErrCampaignDoesNotExist, "id %d", campaignID)
+	}
+	if campaign.HasEnded(ctx.BlockTime()) {
+		return errors.Wrapf(types.ErrCampaignEnded, "campaign %d ended at %s", campaign.Id, campaign.EndTime)
+	}
+	if !deadline.After(ctx.BlockTime()) {
+		return errors.Wrapf(types.ErrInvalidClaimWindow, "deadline %s is not after the block time", deadline)
+	}
+
+	campaign.EndTime = deadline
+	if err := campaign.Validate(); err != nil {
+		return err
+	}
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDeadline,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDeadline, deadline.Format(time.RFC3339)),
+		),
+	)
+
+	return nil
+}
+
+// payFromEscrow sends amount of the campaign denom from the escrow account of
+// the campaign to the reward address.
+func (k Keeper) payFromEscrow(ctx sdk.Context, campaign types.Campaign, rewardAddress string, amount math.Int) error {
+	sdkAddr, err := sdk.AccAddressFromBech32(rewardAddress)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(campaign.Denom, amount))
+	return k.bankKeeper.SendCoins(ctx, types.CampaignEscrowAddress(campaign.Id), sdkAddr, coins)
+}
+
+// GetCampaignBalance returns the balance of the escrow account of a campaign in
+// the campaign denom.
+func (k Keeper) GetCampaignBalance(ctx sdk.Context, campaign types.Campaign) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, types.CampaignEscrowAddress(campaign.Id), campaign.Denom)
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TERITORI/teritori-chain/x/airdrop"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestCampaign() {
+	airdropKeeper := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(airdropKeeper)
+	denom := suite.app.MintKeeper.GetParams(suite.ctx).MintDenom
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	airdropKeeper.SetParamSet(suite.ctx, types.NewParams(owner.String()))
+
+	rewardAddr, err := sdk.AccAddressFromBech32("tori1pkmvlnstq8q7djns3w882pcu92xh4c9x4ukhcd")
+	suite.Require().NoError(err)
+	claimer := newEvmClaimer()
+	evmAddr := claimer.Address()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, owner, coins))
+
+	// the genesis campaign is escrowed by the module account
+	genesisCampaign, found := airdropKeeper.GetCampaign(suite.ctx, types.GenesisCampaignID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(600000000), genesisCampaign.TotalAmount)
+	suite.Require().Equal(suite.app.AccountKeeper.GetModuleAddress(types.ModuleName).String(), genesisCampaign.EscrowAddress)
+
+	// created campaigns have their own escrow account and a claim window
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	startTime, endTime := now.Add(time.Hour), now.Add(2*time.Hour)
+	res, err := msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(owner, "community", startTime, endTime, denom, "", sdk.ZeroInt(), types.VestingPolicy{}, types.ActionGate{}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.CampaignId)
+	escrowAddr := types.CampaignEscrowAddress(res.CampaignId)
+	suite.Require().NotEqual(genesisCampaign.EscrowAddress, escrowAddr.String())
+
+	// deposits are in the campaign denom
+	_, err = msgServer.DepositTokens(ctx, types.NewMsgDepositTokens(owner, 1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))))
+	suite.Require().ErrorIs(err, types.ErrInvalidCampaignDenom)
+	_, err = msgServer.DepositTokens(ctx, types.NewMsgDepositTokens(owner, 2, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	suite.Require().ErrorIs(err, types.ErrCampaignDoesNotExist)
+	_, err = msgServer.DepositTokens(ctx, types.NewMsgDepositTokens(owner, 1, sdk.NewCoins(sdk.NewInt64Coin(denom, 3000000))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 3000000), suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddr, denom))
+
+	// the campaign total adds up its allocations
+	setAllocation := func(address string, amount int64) error {
+		_, err := msgServer.SetAllocation(ctx, types.NewMsgSetAllocation(owner.String(), types.AirdropAllocation{
+			CampaignId:    1,
+			Chain:         "evm",
+			Address:       address,
+			Amount:        sdk.NewInt64Coin(denom, amount),
+			ClaimedAmount: sdk.NewInt64Coin(denom, 0),
+		}))
+		return err
+	}
+	suite.Require().NoError(setAllocation(evmAddr, 2000000))
+	suite.Require().NoError(setAllocation("0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9", 1000000))
+	suite.Require().NoError(setAllocation(evmAddr, 1500000))
+	campaign, err := airdropKeeper.Campaign(ctx, &types.QueryCampaignRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2500000), campaign.Campaign.TotalAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 3000000), campaign.Balance)
+	allocations, err := airdropKeeper.CampaignAllocations(ctx, &types.QueryCampaignAllocationsRequest{CampaignId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(allocations.Allocations, 2)
+	campaigns, err := airdropKeeper.Campaigns(ctx, &types.QueryCampaignsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(campaigns.Campaigns, 2)
+
+	// allocations are claimed within the claim window only
+	claimMsg := types.NewMsgClaimAllocation(1, "evm", evmAddr, rewardAddr, claimer.Sign(1, keeper.ClaimTypeAllocation, rewardAddr))
+	_, err = msgServer.ClaimAllocation(ctx, claimMsg)
+	suite.Require().ErrorIs(err, types.ErrCampaignNotStarted)
+
+	ctx = sdk.WrapSDKContext(suite.ctx.WithBlockTime(startTime))
+	_, err = msgServer.ClaimAllocation(ctx, claimMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1500000), suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1500000), suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddr, denom))
+	campaign, err = airdropKeeper.Campaign(ctx, &types.QueryCampaignRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1500000), campaign.Campaign.ClaimedAmount)
+
+	ctx = sdk.WrapSDKContext(suite.ctx.WithBlockTime(endTime))
+	_, err = msgServer.ClaimAllocation(ctx, types.NewMsgClaimAllocation(1, "evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9", rewardAddr, claimer.Sign(1, keeper.ClaimTypeAllocation, rewardAddr)))
+	suite.Require().ErrorIs(err, types.ErrCampaignEnded)
+
+	// the owner only edits the amount of an allocation, not below its claimed amount
+	suite.Require().ErrorContains(setAllocation(evmAddr, 1000000), "exceeds amount")
+	_, err = msgServer.SetAllocation(ctx, types.NewMsgSetAllocation(owner.String(), types.AirdropAllocation{
+		CampaignId:    1,
+		Chain:         "evm",
+		Address:       evmAddr,
+		Amount:        sdk.NewInt64Coin(denom, 2000000),
+		ClaimedAmount: sdk.NewInt64Coin(denom, 0),
+		Expired:       true,
+	}))
+	suite.Require().NoError(err)
+	allocation := airdropKeeper.GetAllocation(suite.ctx, 1, "evm", evmAddr)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 2000000), allocation.Amount)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1500000), allocation.ClaimedAmount)
+	suite.Require().False(allocation.Expired)
+
+	// nothing is deposited into an ended campaign
+	_, err = msgServer.DepositTokens(ctx, types.NewMsgDepositTokens(owner, 1, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	suite.Require().ErrorIs(err, types.ErrCampaignEnded)
+
+	// the campaigns and their allocations are exported in genesis
+	genesis := airdrop.ExportGenesis(suite.ctx, airdropKeeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Campaigns, 2)
+	suite.Require().Len(genesis.Allocations, 8)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,7 +21,7 @@ func (k Keeper) Allocation(c context.Context, req *types.QueryAllocationRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 
 	return &types.QueryAllocationResponse{
-		Allocation: k.GetAllocation(ctx, req.Address),
+		Allocation: k.GetAllocation(ctx, req.CampaignId, req.Chain, req.Address),
 	}, nil
 }
 
@@ -34,3 +36,157 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 		Params: k.GetParamSet(ctx),
 	}, nil
 }
+
+func (k Keeper) Campaign(c context.Context, req *types.QueryCampaignRequest) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d does not exist", req.Id)
+	}
+
+	return &types.QueryCampaignResponse{
+		Campaign: campaign,
+		Balance:  k.GetCampaignBalance(ctx, campaign),
+	}, nil
+}
+
+func (k Keeper) Campaigns(c context.Context, req *types.QueryCampaignsRequest) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaign)
+
+	campaigns := []types.Campaign{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var campaign types.Campaign
+		if err := k.cdc.Unmarshal(value, &campaign); err != nil {
+			return err
+		}
+		campaigns = append(campaigns, campaign)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignsResponse{Campaigns: campaigns, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CampaignAllocations(c context.Context, req *types.QueryCampaignAllocationsRequest) (*types.QueryCampaignAllocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetCampaign(ctx, req.CampaignId); !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d does not exist", req.CampaignId)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCampaignAllocationsPrefix(req.CampaignId))
+
+	allocations := []types.AirdropAllocation{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var allocation types.AirdropAllocation
+		if err := k.cdc.Unmarshal(value, &allocation); err != nil {
+			return err
+		}
+		allocations = append(allocations, allocation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignAllocationsResponse{Allocations: allocations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MerkleClaimed(c context.Context, req *types.QueryMerkleClaimedRequest) (*types.QueryMerkleClaimedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found || !campaign.IsMerkle() {
+		return nil, status.Errorf(codes.NotFound, "merkle campaign %d does not exist", req.CampaignId)
+	}
+
+	return &types.QueryMerkleClaimedResponse{
+		Claimed: k.IsMerkleClaimed(ctx, req.CampaignId, req.Index),
+	}, nil
+}
+
+func (k Keeper) Swept(c context.Context, req *types.QuerySweptRequest) (*types.QuerySweptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d does not exist", req.CampaignId)
+	}
+
+	return &types.QuerySweptResponse{
+		Amount:    sdk.NewCoin(campaign.Denom, campaign.SweptAmount),
+		Completed: campaign.Swept,
+	}, nil
+}
+
+func (k Keeper) VestingClaims(c context.Context, req *types.QueryVestingClaimsRequest) (*types.QueryVestingClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVestingClaimsResponse{
+		Claims:     k.GetVestingClaims(ctx, addr),
+		Releasable: k.GetReleasableAmount(ctx, addr),
+	}, nil
+}
+
+func (k Keeper) ClaimActions(c context.Context, req *types.QueryClaimActionsRequest) (*types.QueryClaimActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	statuses := []types.ClaimActionsStatus{}
+	for _, allocation := range k.GetRewardAddressAllocations(ctx, addr) {
+		campaign, found := k.GetCampaign(ctx, allocation.CampaignId)
+		if !found {
+			continue
+		}
+		statuses = append(statuses, types.ClaimActionsStatus{
+			CampaignId:    allocation.CampaignId,
+			Chain:         allocation.Chain,
+			Address:       allocation.Address,
+			Completed:     allocation.CompletedActions,
+			Pending:       allocation.PendingActions(campaign.ActionGate),
+			Amount:        allocation.Amount,
+			ClaimedAmount: allocation.ClaimedAmount,
+		})
+	}
+
+	return &types.QueryClaimActionsResponse{Statuses: statuses}, nil
+}
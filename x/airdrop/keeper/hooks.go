@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ stakingtypes.StakingHooks = Hooks{}
+	_ govtypes.GovHooks         = Hooks{}
+)
+
+// Hooks records the delegations and votes of the reward addresses of action
+// gated allocations.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking and gov hooks of the airdrop module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterDelegationModified records the delegation of a delegator.
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	h.k.TryRecordAction(ctx, delAddr, types.ActionDelegate, "")
+	return nil
+}
+
+// AfterProposalVote records the vote of a voter.
+func (h Hooks) AfterProposalVote(ctx sdk.Context, _ uint64, voterAddr sdk.AccAddress) {
+	h.k.TryRecordAction(ctx, voterAddr, types.ActionVote, "")
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error   { return nil }
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error { return nil }
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error { return nil }
+
+func (h Hooks) AfterProposalSubmission(_ sdk.Context, _ uint64)                {}
+func (h Hooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+func (h Hooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64)          {}
+func (h Hooks) AfterProposalVotingPeriodEnded(_ sdk.Context, _ uint64)         {}
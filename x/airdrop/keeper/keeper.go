@@ -18,6 +18,7 @@ type Keeper struct {
 	storeKey      storetypes.StoreKey
 	paramSpace    paramstypes.Subspace
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
 	acountKeeper  types.AccountKeeper
 }
@@ -27,7 +28,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	paramSpace paramstypes.Subspace,
-	bk types.BankKeeper, sk types.StakingKeeper, ak types.AccountKeeper) *Keeper {
+	bk types.BankKeeper, dk types.DistrKeeper, sk types.StakingKeeper, ak types.AccountKeeper) *Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -37,6 +38,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		paramSpace:    paramSpace,
 		bankKeeper:    bk,
+		distrKeeper:   dk,
 		stakingKeeper: sk,
 		acountKeeper:  ak,
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -9,8 +10,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	simapp "github.com/TERITORI/teritori-chain/app"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
 )
 
 const (
@@ -36,3 +41,35 @@ func (suite *KeeperTestSuite) SetupTest() {
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// evmClaimer owns an evm address and signs its airdrop claims.
+type evmClaimer struct {
+	key *ecdsa.PrivateKey
+}
+
+func newEvmClaimer() evmClaimer {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	return evmClaimer{key: key}
+}
+
+func (c evmClaimer) Address() string {
+	return crypto.PubkeyToAddress(c.key.PublicKey).String()
+}
+
+// Sign returns the signature of a claim of the address, the way wallets sign
+// personal messages.
+func (c evmClaimer) Sign(campaignID uint64, claimType string, rewardAddr sdk.AccAddress) string {
+	signBytes, err := keeper.ClaimSignBytes(campaignID, claimType, "evm", c.Address(), rewardAddr.String())
+	if err != nil {
+		panic(err)
+	}
+	signature, err := crypto.Sign(accounts.TextHash(signBytes), c.key)
+	if err != nil {
+		panic(err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to the yellow paper 27/28
+	return hexutil.Encode(signature)
+}
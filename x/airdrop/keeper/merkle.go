@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsMerkleClaimed returns whether the leaf at index of the Merkle campaign is
+// claimed.
+func (k Keeper) IsMerkleClaimed(ctx sdk.Context, campaignID, index uint64) bool {
+	bits := k.getMerkleClaimedChunk(ctx, campaignID, index/types.MerkleClaimedChunkBits)
+	bit := index % types.MerkleClaimedChunkBits
+	return bits[bit/8]&(1<<(bit%8)) != 0
+}
+
+// setMerkleClaimed marks the leaf at index of the Merkle campaign as claimed.
+func (k Keeper) setMerkleClaimed(ctx sdk.Context, campaignID, index uint64) {
+	chunk := index / types.MerkleClaimedChunkBits
+	bits := k.getMerkleClaimedChunk(ctx, campaignID, chunk)
+	bit := index % types.MerkleClaimedChunkBits
+	bits[bit/8] |= 1 << (bit % 8)
+	k.SetMerkleClaimedChunk(ctx, types.MerkleClaimedChunk{CampaignId: campaignID, Chunk: chunk, Bits: bits})
+}
+
+// getMerkleClaimedChunk returns a copy of a chunk of the claimed bitmap of a
+// Merkle campaign, all bits being unset if it is not stored.
+func (k Keeper) getMerkleClaimedChunk(ctx sdk.Context, campaignID, chunk uint64) []byte {
+	bits := make([]byte, types.MerkleClaimedChunkBits/8)
+	store := ctx.KVStore(k.storeKey)
+	copy(bits, store.Get(types.GetMerkleClaimedChunkKey(campaignID, chunk)))
+	return bits
+}
+
+// SetMerkleClaimedChunk stores a chunk of the claimed bitmap of a Merkle
+// campaign.
+func (k Keeper) SetMerkleClaimedChunk(ctx sdk.Context, chunk types.MerkleClaimedChunk) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMerkleClaimedChunkKey(chunk.CampaignId, chunk.Chunk), chunk.Bits)
+}
+
+// GetAllMerkleClaimedChunks returns the stored chunks of the claimed bitmaps of
+// all Merkle campaigns.
+func (k Keeper) GetAllMerkleClaimedChunks(ctx sdk.Context) []types.MerkleClaimedChunk {
+	chunks := []types.MerkleClaimedChunk{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleClaimed)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixMerkleClaimed):]
+		chunks = append(chunks, types.MerkleClaimedChunk{
+			CampaignId: sdk.BigEndianToUint64(key[:8]),
+			Chunk:      sdk.BigEndianToUint64(key[8:]),
+			Bits:       iterator.Value(),
+		})
+	}
+
+	return chunks
+}
+
+// ClaimWithProof pays the allocation of a leaf of a Merkle campaign to the
+// reward address, once the proof shows the allocation is part of the campaign
+// tree and the signature proves the ownership of the native chain address.
+func (k Keeper) ClaimWithProof(ctx sdk.Context, msg *types.MsgClaimWithProof) error {
+	campaign, found := k.GetCampaign(ctx, msg.CampaignId)
+	if !found {
+		return errors.Wrapf(types.ErrCampaignDoesNotExist, "id %d", msg.CampaignId)
+	}
+	if !campaign.IsMerkle() {
+		return errors.Wrapf(types.ErrNotMerkleCampaign, "id %d", msg.CampaignId)
+	}
+	if err := campaign.CheckClaimWindow(ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	// ensure the leaf is not claimed already
+	if k.IsMerkleClaimed(ctx, campaign.Id, msg.Index) {
+		return types.ErrMerkleAllocationAlreadyClaimed
+	}
+
+	// verify the allocation is part of the campaign tree
+	root, err := types.DecodeMerkleHash(campaign.MerkleRoot)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidMerkleRoot, err.Error())
+	}
+	proof, err := types.DecodeMerkleProof(msg.Proof)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidMerkleProof, err.Error())
+	}
+	leaf := types.MerkleLeaf(msg.Index, msg.Chain, msg.Address, msg.Amount)
+	if !types.VerifyMerkleProof(root, leaf, proof) {
+		return types.ErrInvalidMerkleProof
+	}
+
+	// verify native chain account with signature
+	sigOk := VerifySignature(campaign.Id, ClaimTypeMerkle, msg.Chain, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+	if !sigOk {
+		return types.ErrNativeChainAccountSigVerificationFailure
+	}
+
+	// a tree summing to more than the campaign total cannot drain other funds
+	unclaimed := campaign.TotalAmount.Sub(campaign.ClaimedAmount)
+	if unclaimed.LT(msg.Amount) {
+		return errors.Wrapf(types.ErrCampaignExhausted, "%s%s left", unclaimed, campaign.Denom)
+	}
+
+	// send coins from the campaign escrow account to beneficiary address, or
+	// to the vesting escrow account if the campaign has a vesting policy
+	err = k.payClaim(ctx, campaign, msg.RewardAddress, msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	k.setMerkleClaimed(ctx, campaign.Id, msg.Index)
+	campaign.ClaimedAmount = campaign.ClaimedAmount.Add(msg.Amount)
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimWithProof,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyIndex, strconv.FormatUint(msg.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(campaign.Denom, msg.Amount).String()),
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, msg.RewardAddress),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/TERITORI/teritori-chain/x/airdrop"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimWithProof() {
+	airdropKeeper := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(airdropKeeper)
+	denom := suite.app.MintKeeper.GetParams(suite.ctx).MintDenom
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	airdropKeeper.SetParamSet(suite.ctx, types.NewParams(owner.String()))
+
+	rewardAddr, err := sdk.AccAddressFromBech32("tori1pkmvlnstq8q7djns3w882pcu92xh4c9x4ukhcd")
+	suite.Require().NoError(err)
+	cosmosAddr, err := bech32.ConvertAndEncode("cosmos", rewardAddr)
+	suite.Require().NoError(err)
+	claimer := newEvmClaimer()
+	evmAddr := claimer.Address()
+
+	type allocation struct {
+		chain   string
+		address string
+		amount  math.Int
+	}
+	allocations := []allocation{
+		{"evm", evmAddr, sdk.NewInt(1000000)},
+		{"cosmos", cosmosAddr, sdk.NewInt(2000000)},
+		{"juno", "juno1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn7hzdtn", sdk.NewInt(3000000)},
+	}
+	leaves := [][]byte{}
+	for i, allocation := range allocations {
+		leaves = append(leaves, types.MerkleLeaf(uint64(i), allocation.chain, allocation.address, allocation.amount))
+	}
+	root, hashes := types.BuildMerkleTree(leaves)
+	merkleRoot := hex.EncodeToString(root)
+	proofs := make([][]string, len(hashes))
+	for i, proof := range hashes {
+		for _, hash := range proof {
+			proofs[i] = append(proofs[i], hex.EncodeToString(hash))
+		}
+	}
+	claimMsg := func(campaignID, index uint64, signature string) *types.MsgClaimWithProof {
+		allocation := allocations[index]
+		return types.NewMsgClaimWithProof(campaignID, index, allocation.chain, allocation.address, allocation.amount, proofs[index], "", rewardAddr, signature)
+	}
+
+	// only the owner creates campaigns
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	totalAmount := sdk.NewInt(6000000)
+	_, err = msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(rewardAddr, "merkle", time.Time{}, time.Time{}, denom, merkleRoot, totalAmount, types.VestingPolicy{}, types.ActionGate{}))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	res, err := msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(owner, "merkle", time.Time{}, time.Time{}, denom, merkleRoot, totalAmount, types.VestingPolicy{}, types.ActionGate{}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.CampaignId)
+
+	// fund the campaign escrow account
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10000000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, owner, coins))
+	_, err = msgServer.DepositTokens(ctx, types.NewMsgDepositTokens(owner, 1, sdk.NewCoins(sdk.NewCoin(denom, totalAmount))))
+	suite.Require().NoError(err)
+
+	// the allocation must be part of the tree and the address owned by the claimer
+	invalidAmount := claimMsg(1, 0, claimer.Sign(1, keeper.ClaimTypeMerkle, rewardAddr))
+	invalidAmount.Amount = sdk.NewInt(6000000)
+	suite.Require().ErrorIs(airdropKeeper.ClaimWithProof(suite.ctx, invalidAmount), types.ErrInvalidMerkleProof)
+	suite.Require().ErrorIs(airdropKeeper.ClaimWithProof(suite.ctx, claimMsg(1, 2, "")), types.ErrNativeChainAccountSigVerificationFailure)
+	suite.Require().ErrorIs(airdropKeeper.ClaimWithProof(suite.ctx, claimMsg(2, 0, claimer.Sign(2, keeper.ClaimTypeMerkle, rewardAddr))), types.ErrCampaignDoesNotExist)
+
+	// each allocation is paid once
+	_, err = msgServer.ClaimWithProof(ctx, claimMsg(1, 0, claimer.Sign(1, keeper.ClaimTypeMerkle, rewardAddr)))
+	suite.Require().NoError(err)
+	suite.Require().Equal("1000000", suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom).Amount.String())
+	_, err = msgServer.ClaimWithProof(ctx, claimMsg(1, 0, claimer.Sign(1, keeper.ClaimTypeMerkle, rewardAddr)))
+	suite.Require().ErrorIs(err, types.ErrMerkleAllocationAlreadyClaimed)
+	suite.Require().NoError(airdropKeeper.ClaimWithProof(suite.ctx, claimMsg(1, 1, "")))
+	suite.Require().Equal("3000000", suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom).Amount.String())
+
+	claimed, err := airdropKeeper.MerkleClaimed(ctx, &types.QueryMerkleClaimedRequest{CampaignId: 1, Index: 1})
+	suite.Require().NoError(err)
+	suite.Require().True(claimed.Claimed)
+	claimed, err = airdropKeeper.MerkleClaimed(ctx, &types.QueryMerkleClaimedRequest{CampaignId: 1, Index: 2})
+	suite.Require().NoError(err)
+	suite.Require().False(claimed.Claimed)
+	campaign, err := airdropKeeper.Campaign(ctx, &types.QueryCampaignRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(3000000), campaign.Campaign.ClaimedAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 3000000), campaign.Balance)
+
+	// a campaign never pays more than its total amount
+	id, err := airdropKeeper.CreateCampaign(suite.ctx, types.Campaign{Denom: denom, MerkleRoot: merkleRoot, TotalAmount: sdk.NewInt(1500000)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), id)
+	suite.Require().NoError(airdropKeeper.DepositTokens(suite.ctx, id, owner, sdk.NewCoins(sdk.NewInt64Coin(denom, 3000000))))
+	suite.Require().NoError(airdropKeeper.ClaimWithProof(suite.ctx, claimMsg(2, 0, claimer.Sign(2, keeper.ClaimTypeMerkle, rewardAddr))))
+	suite.Require().ErrorIs(airdropKeeper.ClaimWithProof(suite.ctx, claimMsg(2, 1, "")), types.ErrCampaignExhausted)
+
+	// the campaigns and claimed bitmaps are exported in genesis
+	genesis := airdrop.ExportGenesis(suite.ctx, airdropKeeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Campaigns, 3)
+	suite.Require().Len(genesis.MerkleClaimedChunks, 2)
+	suite.Require().Equal(byte(0b11), genesis.MerkleClaimedChunks[0].Bits[0])
+	suite.Require().Equal(byte(0b01), genesis.MerkleClaimedChunks[1].Bits[0])
+}
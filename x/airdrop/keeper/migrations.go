@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	v2 "github.com/TERITORI/teritori-chain/x/airdrop/migrations/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/airdrop module state from the consensus version 1
+// to version 2. Specifically, it moves the allocations into the genesis
+// campaign.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
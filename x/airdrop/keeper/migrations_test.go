@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	v2 "github.com/TERITORI/teritori-chain/x/airdrop/migrations/v2"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	airdropKeeper := suite.app.AirdropKeeper
+	allocations := airdropKeeper.GetAllAllocations(suite.ctx)
+	claimed := allocations[1]
+	claimed.ClaimedAmount = claimed.Amount
+	allocations[1] = claimed
+
+	// replace the state written by InitGenesis with v1 allocations keyed by address
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, allocation := range airdropKeeper.GetAllAllocations(suite.ctx) {
+		airdropKeeper.DeleteAllocation(suite.ctx, allocation.CampaignId, allocation.Chain, allocation.Address)
+	}
+	store.Delete(types.GetCampaignKey(types.GenesisCampaignID))
+	for _, allocation := range allocations {
+		key := append(append([]byte{}, v2.AllocationKeyPrefix...), allocation.Address...)
+		store.Set(key, suite.app.AppCodec().MustMarshal(&allocation))
+	}
+
+	migrator := keeper.NewMigrator(airdropKeeper)
+	suite.Require().NoError(migrator.Migrate1to2(suite.ctx))
+
+	suite.Require().Equal(allocations, airdropKeeper.GetAllAllocations(suite.ctx))
+	iterator := sdk.KVStorePrefixIterator(store, v2.AllocationKeyPrefix)
+	suite.Require().False(iterator.Valid())
+	iterator.Close()
+	campaign, found := airdropKeeper.GetCampaign(suite.ctx, types.GenesisCampaignID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.CampaignEscrowAddress(types.GenesisCampaignID).String(), campaign.EscrowAddress)
+	suite.Require().Equal(sdk.NewInt(600000000), campaign.TotalAmount)
+	suite.Require().Equal(claimed.Amount.Amount, campaign.ClaimedAmount)
+	suite.Require().Equal(claimed, *airdropKeeper.GetAllocation(suite.ctx, types.GenesisCampaignID, claimed.Chain, claimed.Address))
+}
@@ -24,7 +24,7 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) ClaimAllocation(goCtx context.Context, msg *types.MsgClaimAllocation) (*types.MsgClaimAllocationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.keeper.ClaimAllocation(ctx, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+	err := k.keeper.ClaimAllocation(ctx, msg.CampaignId, msg.Chain, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
 	return &types.MsgClaimAllocationResponse{}, err
 }
 
@@ -35,7 +35,9 @@ func (k msgServer) SetAllocation(goCtx context.Context, msg *types.MsgSetAllocat
 	if msg.Sender != params.Owner {
 		return nil, types.ErrNotEnoughPermission
 	}
-	k.keeper.SetAllocation(ctx, msg.Allocation)
+	if err := k.keeper.UpdateAllocation(ctx, msg.Allocation); err != nil {
+		return nil, err
+	}
 	return &types.MsgSetAllocationResponse{}, nil
 }
 
@@ -59,10 +61,74 @@ func (m msgServer) DepositTokens(goCtx context.Context, msg *types.MsgDepositTok
 		return nil, err
 	}
 
-	err = m.keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amount)
+	err = m.keeper.DepositTokens(ctx, msg.CampaignId, sender, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgDepositTokensResponse{}, nil
 }
+
+func (m msgServer) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCampaign) (*types.MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := m.keeper.GetParamSet(ctx)
+	if msg.Sender != params.Owner {
+		return nil, types.ErrNotEnoughPermission
+	}
+
+	id, err := m.keeper.CreateCampaign(ctx, types.Campaign{
+		Description: msg.Description,
+		StartTime:   msg.StartTime,
+		EndTime:     msg.EndTime,
+		Denom:       msg.Denom,
+		MerkleRoot:  msg.MerkleRoot,
+		TotalAmount: msg.TotalAmount,
+		Vesting:     msg.Vesting,
+		ActionGate:  msg.ActionGate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateCampaignResponse{CampaignId: id}, nil
+}
+
+func (m msgServer) SetClaimDeadline(goCtx context.Context, msg *types.MsgSetClaimDeadline) (*types.MsgSetClaimDeadlineResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := m.keeper.GetParamSet(ctx)
+	if msg.Sender != params.Owner {
+		return nil, types.ErrNotEnoughPermission
+	}
+
+	err := m.keeper.SetClaimDeadline(ctx, msg.CampaignId, msg.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetClaimDeadlineResponse{}, nil
+}
+
+func (m msgServer) ReleaseVested(goCtx context.Context, msg *types.MsgReleaseVested) (*types.MsgReleaseVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.keeper.ReleaseVested(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseVestedResponse{Amount: amount}, nil
+}
+
+func (m msgServer) ClaimWithProof(goCtx context.Context, msg *types.MsgClaimWithProof) (*types.MsgClaimWithProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := m.keeper.ClaimWithProof(ctx, msg)
+	return &types.MsgClaimWithProofResponse{}, err
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	appparams "github.com/TERITORI/teritori-chain/app/params"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/accounts"
@@ -13,19 +14,52 @@ import (
 	solana "github.com/gagliardetto/solana-go"
 )
 
+// Types of the claims an ownership signature is bound to.
+const (
+	ClaimTypeAllocation = "allocation"
+	ClaimTypeMerkle     = "merkle"
+)
+
+// SignMessage is the message signed to claim a genesis campaign allocation.
 type SignMessage struct {
 	Chain      string `json:"chain"`
 	Address    string `json:"address"`
 	RewardAddr string `json:"rewardAddr"`
 }
 
-func VerifySignature(chain string, address string, pubKey string, rewardAddr string, signatureBytes string) bool {
-	signMsg := SignMessage{
+// ClaimSignMessage is the message signed to claim from the other campaigns. It
+// is bound to the campaign and the type of claim, so that a signature cannot be
+// replayed against another campaign or another kind of claim.
+type ClaimSignMessage struct {
+	CampaignID uint64 `json:"campaignId"`
+	ClaimType  string `json:"claimType"`
+	Chain      string `json:"chain"`
+	Address    string `json:"address"`
+	RewardAddr string `json:"rewardAddr"`
+}
+
+// ClaimSignBytes returns the bytes a native chain account signs to claim from a
+// campaign. The allocations of the genesis campaign keep the legacy message
+// their owners already signed.
+func ClaimSignBytes(campaignID uint64, claimType string, chain string, address string, rewardAddr string) ([]byte, error) {
+	if campaignID == types.GenesisCampaignID && claimType == ClaimTypeAllocation {
+		return json.Marshal(SignMessage{
+			Chain:      chain,
+			Address:    address,
+			RewardAddr: rewardAddr,
+		})
+	}
+	return json.Marshal(ClaimSignMessage{
+		CampaignID: campaignID,
+		ClaimType:  claimType,
 		Chain:      chain,
 		Address:    address,
 		RewardAddr: rewardAddr,
-	}
-	signBytes, err := json.Marshal(signMsg)
+	})
+}
+
+func VerifySignature(campaignID uint64, claimType string, chain string, address string, pubKey string, rewardAddr string, signatureBytes string) bool {
+	signBytes, err := ClaimSignBytes(campaignID, claimType, chain, address, rewardAddr)
 	if err != nil {
 		return false
 	}
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
 )
 
 func (suite *KeeperTestSuite) TestVerifySignature() {
@@ -35,7 +38,7 @@ func (suite *KeeperTestSuite) TestVerifySignature() {
 	}
 
 	for _, tc := range tests {
-		passed := keeper.VerifySignature(tc.chain, tc.address, tc.pubKey, tc.rewardAddr, tc.signature)
+		passed := keeper.VerifySignature(types.GenesisCampaignID, keeper.ClaimTypeAllocation, tc.chain, tc.address, tc.pubKey, tc.rewardAddr, tc.signature)
 		if tc.expectPass {
 			suite.Require().True(passed)
 		} else {
@@ -43,3 +46,24 @@ func (suite *KeeperTestSuite) TestVerifySignature() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestVerifySignatureReplay() {
+	claimer := newEvmClaimer()
+	rewardAddr := sdk.MustAccAddressFromBech32("tori1pkmvlnstq8q7djns3w882pcu92xh4c9x4ukhcd")
+	verify := func(campaignID uint64, claimType string, signature string) bool {
+		return keeper.VerifySignature(campaignID, claimType, "evm", claimer.Address(), "", rewardAddr.String(), signature)
+	}
+
+	// a signature only proves the ownership for the campaign and the claim type it binds
+	signature := claimer.Sign(1, keeper.ClaimTypeAllocation, rewardAddr)
+	suite.Require().True(verify(1, keeper.ClaimTypeAllocation, signature))
+	suite.Require().False(verify(2, keeper.ClaimTypeAllocation, signature))
+	suite.Require().False(verify(1, keeper.ClaimTypeMerkle, signature))
+	suite.Require().False(verify(types.GenesisCampaignID, keeper.ClaimTypeAllocation, signature))
+
+	// the legacy signature of the genesis campaign is not accepted by the other campaigns
+	legacySignature := claimer.Sign(types.GenesisCampaignID, keeper.ClaimTypeAllocation, rewardAddr)
+	suite.Require().True(verify(types.GenesisCampaignID, keeper.ClaimTypeAllocation, legacySignature))
+	suite.Require().False(verify(1, keeper.ClaimTypeAllocation, legacySignature))
+	suite.Require().False(verify(types.GenesisCampaignID, keeper.ClaimTypeMerkle, legacySignature))
+}
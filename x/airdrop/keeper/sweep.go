@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SweepEndedCampaigns sweeps the unclaimed funds of the campaigns whose claim
+// deadline passed to the community pool. At most SweepBatchSize allocations
+// are expired per block, the sweep of a campaign with more allocations
+// resuming in the next blocks.
+func (k Keeper) SweepEndedCampaigns(ctx sdk.Context) {
+	budget := types.SweepBatchSize
+	for _, id := range k.GetEndedCampaignIDs(ctx) {
+		if budget == 0 {
+			return
+		}
+		campaign, found := k.GetCampaign(ctx, id)
+		if !found {
+			continue
+		}
+
+		// a failed sweep leaves the campaign untouched, to be retried next block
+		cacheCtx, write := ctx.CacheContext()
+		swept, err := k.sweepCampaign(cacheCtx, campaign, budget)
+		if err != nil {
+			k.Logger(ctx).Error("failed to sweep airdrop campaign", "campaign_id", campaign.Id, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		budget -= swept
+	}
+}
+
+// sweepCampaign expires up to budget unclaimed allocations of an ended
+// campaign and sends their unclaimed amount from the campaign escrow account to
+// the community pool. Once all the allocations are expired, the remaining
+// escrow balance is swept as well, except for the genesis campaign, and the
+// campaign is marked as swept. It returns the number of allocations visited.
+func (k Keeper) sweepCampaign(ctx sdk.Context, campaign types.Campaign, budget int) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+	cursorKey := types.GetSweepCursorKey(campaign.Id)
+
+	allocationStore := prefix.NewStore(store, types.GetCampaignAllocationsPrefix(campaign.Id))
+	iterator := allocationStore.Iterator(store.Get(cursorKey), nil)
+	allocations := []types.AirdropAllocation{}
+	var next []byte
+	for ; iterator.Valid(); iterator.Next() {
+		if len(allocations) == budget {
+			next = append([]byte{}, iterator.Key()...)
+			break
+		}
+		allocation := types.AirdropAllocation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &allocation)
+		allocations = append(allocations, allocation)
+	}
+	iterator.Close()
+
+	unclaimed := math.ZeroInt()
+	expired := 0
+	for _, allocation := range allocations {
+		if allocation.Expired || allocation.ClaimedAmount.IsGTE(allocation.Amount) {
+			continue
+		}
+		unclaimed = unclaimed.Add(allocation.Amount.Amount.Sub(allocation.ClaimedAmount.Amount))
+		allocation.Expired = true
+		k.SetAllocation(ctx, allocation)
+		expired++
+	}
+
+	escrowAddress := types.CampaignEscrowAddress(campaign.Id)
+	balance := k.bankKeeper.GetBalance(ctx, escrowAddress, campaign.Denom).Amount
+	completed := next == nil
+	amount := math.MinInt(unclaimed, balance)
+	if completed {
+		// the deposits exceeding the allocations are swept as well, but for the
+		// genesis campaign escrowed by the airdrop module account holding other funds
+		if campaign.Id != types.GenesisCampaignID {
+			amount = balance
+		}
+		campaign.Swept = true
+		store.Delete(cursorKey)
+	} else {
+		store.Set(cursorKey, next)
+	}
+
+	if amount.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(campaign.Denom, amount))
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, escrowAddress); err != nil {
+			return 0, err
+		}
+		campaign.SweptAmount = campaign.SweptAmount.Add(amount)
+	}
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(campaign.Denom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyExpired, strconv.Itoa(expired)),
+			sdk.NewAttribute(types.AttributeKeyCompleted, strconv.FormatBool(completed)),
+		),
+	)
+
+	return len(allocations), nil
+}
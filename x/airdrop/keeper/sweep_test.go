@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TERITORI/teritori-chain/x/airdrop"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestSweepEndedCampaigns() {
+	airdropKeeper := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(airdropKeeper)
+	denom := suite.app.MintKeeper.GetParams(suite.ctx).MintDenom
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	airdropKeeper.SetParamSet(suite.ctx, types.NewParams(owner.String()))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, owner, coins))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	id, err := airdropKeeper.CreateCampaign(suite.ctx, types.Campaign{Denom: denom})
+	suite.Require().NoError(err)
+
+	// 150 allocations of 1000, the first one being claimed, and an excess deposit of 50000
+	allocations := types.SweepBatchSize + 50
+	for i := 0; i < allocations; i++ {
+		allocation := types.AirdropAllocation{
+			CampaignId:    id,
+			Chain:         "evm",
+			Address:       fmt.Sprintf("0x%040x", i),
+			Amount:        sdk.NewInt64Coin(denom, 1000),
+			ClaimedAmount: sdk.NewInt64Coin(denom, 0),
+		}
+		suite.Require().NoError(airdropKeeper.UpdateAllocation(suite.ctx, allocation))
+		if i == 0 {
+			allocation.ClaimedAmount = allocation.Amount
+			airdropKeeper.SetAllocation(suite.ctx, allocation)
+			campaign, _ := airdropKeeper.GetCampaign(suite.ctx, id)
+			campaign.ClaimedAmount = campaign.ClaimedAmount.Add(allocation.Amount.Amount)
+			airdropKeeper.SetCampaign(suite.ctx, campaign)
+		}
+	}
+	suite.Require().NoError(airdropKeeper.DepositTokens(suite.ctx, id, owner, sdk.NewCoins(sdk.NewInt64Coin(denom, 199000))))
+
+	// only the owner sets a deadline, in the future
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	deadline := now.Add(time.Hour)
+	_, err = msgServer.SetClaimDeadline(ctx, types.NewMsgSetClaimDeadline(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), id, deadline))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	_, err = msgServer.SetClaimDeadline(ctx, types.NewMsgSetClaimDeadline(owner, id, now))
+	suite.Require().ErrorIs(err, types.ErrInvalidClaimWindow)
+	_, err = msgServer.SetClaimDeadline(ctx, types.NewMsgSetClaimDeadline(owner, id, deadline))
+	suite.Require().NoError(err)
+
+	// nothing is swept before the deadline
+	communityPool := func() sdk.Int {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).TruncateInt()
+	}
+	poolBefore := communityPool()
+	airdrop.EndBlocker(suite.ctx, airdropKeeper)
+	suite.Require().Equal(poolBefore, communityPool())
+
+	// the unclaimed allocations are swept in batches after the deadline
+	suite.ctx = suite.ctx.WithBlockTime(deadline)
+	airdrop.EndBlocker(suite.ctx, airdropKeeper)
+	suite.Require().Equal(sdk.NewInt(99000), communityPool().Sub(poolBefore))
+	swept, err := airdropKeeper.Swept(sdk.WrapSDKContext(suite.ctx), &types.QuerySweptRequest{CampaignId: id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 99000), swept.Amount)
+	suite.Require().False(swept.Completed)
+	suite.Require().False(airdropKeeper.GetAllocation(suite.ctx, id, "evm", fmt.Sprintf("0x%040x", 0)).Expired)
+	suite.Require().True(airdropKeeper.GetAllocation(suite.ctx, id, "evm", fmt.Sprintf("0x%040x", 1)).Expired)
+	suite.Require().False(airdropKeeper.GetAllocation(suite.ctx, id, "evm", fmt.Sprintf("0x%040x", allocations-1)).Expired)
+
+	// the last batch sweeps the remaining escrow balance
+	airdrop.EndBlocker(suite.ctx, airdropKeeper)
+	suite.Require().Equal(sdk.NewInt(199000), communityPool().Sub(poolBefore))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.CampaignEscrowAddress(id), denom).IsZero())
+	suite.Require().True(airdropKeeper.GetAllocation(suite.ctx, id, "evm", fmt.Sprintf("0x%040x", allocations-1)).Expired)
+	swept, err = airdropKeeper.Swept(sdk.WrapSDKContext(suite.ctx), &types.QuerySweptRequest{CampaignId: id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 199000), swept.Amount)
+	suite.Require().True(swept.Completed)
+
+	// a swept campaign is left untouched, and not visited any more
+	suite.Require().Empty(airdropKeeper.GetEndedCampaignIDs(suite.ctx))
+	airdrop.EndBlocker(suite.ctx, airdropKeeper)
+	suite.Require().Equal(sdk.NewInt(199000), communityPool().Sub(poolBefore))
+	_, err = msgServer.SetClaimDeadline(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetClaimDeadline(owner, id, deadline.Add(time.Hour)))
+	suite.Require().ErrorIs(err, types.ErrCampaignEnded)
+
+	genesis := airdrop.ExportGenesis(suite.ctx, airdropKeeper)
+	suite.Require().NoError(genesis.Validate())
+}
+
+func (suite *KeeperTestSuite) TestSweepGenesisCampaign() {
+	airdropKeeper := suite.app.AirdropKeeper
+	suite.Require().NoError(airdropKeeper.UpdateAllocation(suite.ctx, types.AirdropAllocation{
+		CampaignId:    types.GenesisCampaignID,
+		Chain:         "evm",
+		Address:       fmt.Sprintf("0x%040x", 1),
+		Amount:        sdk.NewInt64Coin("utori", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("utori", 0),
+	}))
+	genesisCampaign, found := airdropKeeper.GetCampaign(suite.ctx, types.GenesisCampaignID)
+	suite.Require().True(found)
+	denom := genesisCampaign.Denom
+	unclaimed := genesisCampaign.TotalAmount.Sub(genesisCampaign.ClaimedAmount)
+
+	// the airdrop module account escrows the genesis campaign along with other funds
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, unclaimed.AddRaw(5000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, coins))
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denom).Amount
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	suite.Require().NoError(airdropKeeper.SetClaimDeadline(suite.ctx, types.GenesisCampaignID, now.Add(time.Hour)))
+
+	// only the unclaimed allocations are swept
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Hour))
+	for i := 0; i < 10 && len(airdropKeeper.GetEndedCampaignIDs(suite.ctx)) > 0; i++ {
+		airdrop.EndBlocker(suite.ctx, airdropKeeper)
+	}
+	campaign, found := airdropKeeper.GetCampaign(suite.ctx, types.GenesisCampaignID)
+	suite.Require().True(found)
+	suite.Require().True(campaign.Swept)
+	suite.Require().Equal(unclaimed, campaign.SweptAmount)
+	suite.Require().Equal(balanceBefore.Sub(unclaimed), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denom).Amount)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVestingClaim stores a vesting claim.
+func (k Keeper) SetVestingClaim(ctx sdk.Context, claim types.VestingClaim) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(claim.Address)
+	store.Set(types.GetVestingClaimKey(addr, claim.CampaignId, claim.StartTime), k.cdc.MustMarshal(&claim))
+}
+
+// GetVestingClaims returns the vesting claims of a reward address.
+func (k Keeper) GetVestingClaims(ctx sdk.Context, addr sdk.AccAddress) []types.VestingClaim {
+	return k.getVestingClaims(ctx, types.GetAddressVestingClaimsPrefix(addr))
+}
+
+// GetAllVestingClaims returns the vesting claims of all reward addresses.
+func (k Keeper) GetAllVestingClaims(ctx sdk.Context) []types.VestingClaim {
+	return k.getVestingClaims(ctx, types.KeyPrefixVestingClaim)
+}
+
+func (k Keeper) getVestingClaims(ctx sdk.Context, keyPrefix []byte) []types.VestingClaim {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	claims := []types.VestingClaim{}
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.VestingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
+// payClaim pays a claim of a campaign to the reward address. Without vesting
+// policy the claim is paid right away, otherwise it is moved from the campaign
+// escrow account to the vesting escrow account, and released as it vests.
+func (k Keeper) payClaim(ctx sdk.Context, campaign types.Campaign, rewardAddress string, amount math.Int) error {
+	if campaign.Vesting.IsZero() {
+		return k.payFromEscrow(ctx, campaign, rewardAddress, amount)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(rewardAddress)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(campaign.Denom, amount))
+	if err := k.bankKeeper.SendCoins(ctx, types.CampaignEscrowAddress(campaign.Id), types.VestingEscrowAddress(), coins); err != nil {
+		return err
+	}
+
+	// the claims of an address in a campaign within a block share a schedule
+	claim := types.VestingClaim{
+		CampaignId:     campaign.Id,
+		Address:        addr.String(),
+		Denom:          campaign.Denom,
+		StartTime:      ctx.BlockTime(),
+		TotalAmount:    math.ZeroInt(),
+		ReleasedAmount: math.ZeroInt(),
+	}
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetVestingClaimKey(addr, campaign.Id, ctx.BlockTime())); bz != nil {
+		k.cdc.MustUnmarshal(bz, &claim)
+	}
+	claim.TotalAmount = claim.TotalAmount.Add(amount)
+	k.SetVestingClaim(ctx, claim)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVestClaim,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, rewardAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetReleasableAmount returns the amount of the vesting claims of a reward
+// address vested and not released yet.
+func (k Keeper) GetReleasableAmount(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	releasable := sdk.NewCoins()
+	for _, claim := range k.GetVestingClaims(ctx, addr) {
+		amount := k.releasableAmount(ctx, claim)
+		if amount.IsPositive() {
+			releasable = releasable.Add(sdk.NewCoin(claim.Denom, amount))
+		}
+	}
+	return releasable
+}
+
+func (k Keeper) releasableAmount(ctx sdk.Context, claim types.VestingClaim) math.Int {
+	campaign, found := k.GetCampaign(ctx, claim.CampaignId)
+	if !found {
+		return math.ZeroInt()
+	}
+	return campaign.Vesting.VestedAmount(claim.TotalAmount, claim.StartTime, ctx.BlockTime()).Sub(claim.ReleasedAmount)
+}
+
+// ReleaseVested pays the vested amount of the claims of a reward address from
+// the vesting escrow account. Fully released claims are deleted.
+func (k Keeper) ReleaseVested(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+	released := sdk.NewCoins()
+	for _, claim := range k.GetVestingClaims(ctx, addr) {
+		amount := k.releasableAmount(ctx, claim)
+		if !amount.IsPositive() {
+			continue
+		}
+		released = released.Add(sdk.NewCoin(claim.Denom, amount))
+
+		claim.ReleasedAmount = claim.ReleasedAmount.Add(amount)
+		if claim.ReleasedAmount.Equal(claim.TotalAmount) {
+			store.Delete(types.GetVestingClaimKey(addr, claim.CampaignId, claim.StartTime))
+		} else {
+			k.SetVestingClaim(ctx, claim)
+		}
+	}
+	if released.IsZero() {
+		return nil, errors.Wrapf(types.ErrNoVestedClaims, "%s", addr)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.VestingEscrowAddress(), addr, released); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseVested,
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, released.String()),
+		),
+	)
+
+	return released, nil
+}
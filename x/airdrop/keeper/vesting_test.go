@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TERITORI/teritori-chain/x/airdrop"
+	"github.com/TERITORI/teritori-chain/x/airdrop/keeper"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	minttypes "github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestVestingClaims() {
+	airdropKeeper := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(airdropKeeper)
+	denom := suite.app.MintKeeper.GetParams(suite.ctx).MintDenom
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	airdropKeeper.SetParamSet(suite.ctx, types.NewParams(owner.String()))
+
+	rewardAddr, err := sdk.AccAddressFromBech32("tori1pkmvlnstq8q7djns3w882pcu92xh4c9x4ukhcd")
+	suite.Require().NoError(err)
+	claimer := newEvmClaimer()
+	evmAddr := claimer.Address()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1200000))
+	suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, owner, coins))
+
+	// a one month cliff, then four quarterly periods
+	month := 30 * 24 * time.Hour
+	vesting := types.VestingPolicy{Cliff: month, Duration: 12 * month, Periods: 4}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := msgServer.CreateCampaign(ctx, types.NewMsgCreateCampaign(owner, "vesting", time.Time{}, time.Time{}, denom, "", sdk.ZeroInt(), vesting, types.ActionGate{}))
+	suite.Require().NoError(err)
+	suite.Require().NoError(airdropKeeper.DepositTokens(suite.ctx, res.CampaignId, owner, coins))
+	suite.Require().NoError(airdropKeeper.UpdateAllocation(suite.ctx, types.AirdropAllocation{
+		CampaignId:    res.CampaignId,
+		Chain:         "evm",
+		Address:       evmAddr,
+		Amount:        sdk.NewInt64Coin(denom, 1200000),
+		ClaimedAmount: sdk.NewInt64Coin(denom, 0),
+	}))
+
+	// the claim is held by the vesting escrow account
+	_, err = msgServer.ClaimAllocation(ctx, types.NewMsgClaimAllocation(res.CampaignId, "evm", evmAddr, rewardAddr, claimer.Sign(res.CampaignId, keeper.ClaimTypeAllocation, rewardAddr)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1200000), suite.app.BankKeeper.GetBalance(suite.ctx, types.VestingEscrowAddress(), denom))
+	campaign, found := airdropKeeper.GetCampaign(suite.ctx, res.CampaignId)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1200000), campaign.ClaimedAmount)
+
+	// nothing is released before the cliff and the end of the first period
+	release := func(blockTime time.Time) (sdk.Coins, error) {
+		res, err := msgServer.ReleaseVested(sdk.WrapSDKContext(suite.ctx.WithBlockTime(blockTime)), types.NewMsgReleaseVested(rewardAddr))
+		if err != nil {
+			return nil, err
+		}
+		return res.Amount, nil
+	}
+	_, err = release(now.Add(3 * month))
+	suite.Require().ErrorIs(err, types.ErrNoVestedClaims)
+
+	// each period releases a quarter
+	claims, err := airdropKeeper.VestingClaims(sdk.WrapSDKContext(suite.ctx.WithBlockTime(now.Add(7*month))), &types.QueryVestingClaimsRequest{Address: rewardAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(claims.Claims, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 600000)), claims.Releasable)
+	released, err := release(now.Add(4 * month))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 300000)), released)
+	released, err = release(now.Add(7 * month))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 300000)), released)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 600000), suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, denom))
+
+	genesis := airdrop.ExportGenesis(suite.ctx, airdropKeeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.VestingClaims, 1)
+	suite.Require().Equal(sdk.NewInt(600000), genesis.VestingClaims[0].ReleasedAmount)
+
+	// fully released claims are deleted
+	released, err = release(now.Add(13 * month))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 600000)), released)
+	suite.Require().Empty(airdropKeeper.GetVestingClaims(suite.ctx, rewardAddr))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.VestingEscrowAddress(), denom).IsZero())
+}
@@ -0,0 +1,66 @@
+package v2
+
+import (
+	"fmt"
+
+	appparams "github.com/TERITORI/teritori-chain/app/params"
+	"github.com/TERITORI/teritori-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllocationKeyPrefix is the prefix of the allocations keyed by address of the
+// consensus version 1.
+var AllocationKeyPrefix = []byte{0x01}
+
+// Migrate migrates the x/airdrop module state from the consensus version 1 to
+// version 2. Specifically, it creates the genesis campaign, whose escrow
+// account is the airdrop module account, and moves the allocations keyed by
+// address into it, keyed by campaign, address and chain.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	campaign := types.NewGenesisCampaign(appparams.BaseCoinUnit)
+	allocations := []types.AirdropAllocation{}
+	oldKeys := [][]byte{}
+	iterator := sdk.KVStorePrefixIterator(store, AllocationKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var allocation types.AirdropAllocation
+		if err := cdc.Unmarshal(iterator.Value(), &allocation); err != nil {
+			iterator.Close()
+			return err
+		}
+		allocation.CampaignId = campaign.Id
+		allocations = append(allocations, allocation)
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i, allocation := range allocations {
+		if err := campaign.ValidateAllocation(allocation); err != nil {
+			return fmt.Errorf("invalid allocation: %w", err)
+		}
+		campaign.TotalAmount = campaign.TotalAmount.Add(allocation.Amount.Amount)
+		campaign.ClaimedAmount = campaign.ClaimedAmount.Add(allocation.ClaimedAmount.Amount)
+
+		bz, err := cdc.Marshal(&allocation)
+		if err != nil {
+			return err
+		}
+		store.Delete(oldKeys[i])
+		store.Set(types.GetAllocationKey(allocation.CampaignId, allocation.Chain, allocation.Address), bz)
+	}
+
+	bz, err := cdc.Marshal(&campaign)
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetCampaignKey(campaign.Id), bz)
+
+	return nil
+}
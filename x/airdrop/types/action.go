@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseAction parses an action from its name, with or without the ACTION_
+// prefix, case insensitive.
+func ParseAction(name string) (Action, error) {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	if !strings.HasPrefix(name, "ACTION_") {
+		name = "ACTION_" + name
+	}
+	action, ok := Action_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown action %s", name)
+	}
+	return Action(action), nil
+}
+
+// IsZero returns whether the allocations are paid in full on claim.
+func (g ActionGate) IsZero() bool {
+	return len(g.Actions) == 0
+}
+
+// Validate performs a basic validation of the action gate.
+func (g ActionGate) Validate() error {
+	if g.IsZero() {
+		if len(g.WasmContracts) > 0 {
+			return fmt.Errorf("wasm contracts are set without %s action", ActionWasmExecute)
+		}
+		return nil
+	}
+	if g.InitialFraction.IsNil() || g.InitialFraction.IsNegative() || g.InitialFraction.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("initial fraction %s must be in [0, 1)", g.InitialFraction)
+	}
+	seen := make(map[Action]bool, len(g.Actions))
+	for _, action := range g.Actions {
+		if _, ok := Action_name[int32(action)]; !ok || action == ActionInitialClaim {
+			return fmt.Errorf("invalid gating action %s", action)
+		}
+		if seen[action] {
+			return fmt.Errorf("duplicate gating action %s", action)
+		}
+		seen[action] = true
+	}
+	if seen[ActionWasmExecute] != (len(g.WasmContracts) > 0) {
+		return fmt.Errorf("%s action requires wasm contracts, and only it", ActionWasmExecute)
+	}
+	for _, contract := range g.WasmContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid wasm contract %s: %w", contract, err)
+		}
+	}
+	return nil
+}
+
+// HasAction returns whether the action unlocks a part of the allocations.
+func (g ActionGate) HasAction(action Action) bool {
+	for _, a := range g.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWasmContract returns whether the execution of the contract completes the
+// wasm execute action.
+func (g ActionGate) IsWasmContract(contract string) bool {
+	for _, c := range g.WasmContracts {
+		if c == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// UnlockedAmount returns the part of amount unlocked by the first claim and
+// the given number of completed actions.
+func (g ActionGate) UnlockedAmount(amount math.Int, completed int) math.Int {
+	if completed >= len(g.Actions) {
+		return amount
+	}
+	initial := g.InitialFraction.MulInt(amount).TruncateInt()
+	return initial.Add(amount.Sub(initial).MulRaw(int64(completed)).QuoRaw(int64(len(g.Actions))))
+}
+
+// HasCompleted returns whether the action unlocked a part of the allocation.
+func (a AirdropAllocation) HasCompleted(action Action) bool {
+	for _, completed := range a.CompletedActions {
+		if completed == action {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingActions returns the actions of the gate the allocation has not
+// completed yet.
+func (a AirdropAllocation) PendingActions(gate ActionGate) []Action {
+	pending := []Action{}
+	for _, action := range gate.Actions {
+		if !a.HasCompleted(action) {
+			pending = append(pending, action)
+		}
+	}
+	return pending
+}
+
+// CompletedGatingActions returns the number of gating actions that unlocked a
+// part of the allocation, the initial claim excluded.
+func (a AirdropAllocation) CompletedGatingActions() int {
+	completed := 0
+	for _, action := range a.CompletedActions {
+		if action != ActionInitialClaim {
+			completed++
+		}
+	}
+	return completed
+}
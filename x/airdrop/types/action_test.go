@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAction(t *testing.T) {
+	for name, expected := range map[string]Action{
+		"delegate":            ActionDelegate,
+		"VOTE":                ActionVote,
+		"ibc-transfer":        ActionIBCTransfer,
+		"ACTION_WASM_EXECUTE": ActionWasmExecute,
+	} {
+		action, err := ParseAction(name)
+		require.NoError(t, err, name)
+		require.Equal(t, expected, action)
+	}
+
+	_, err := ParseAction("swap")
+	require.Error(t, err)
+}
+
+func TestActionGate(t *testing.T) {
+	contract := sdk.AccAddress(address.Module("wasm", []byte("contract"))).String()
+	gate := ActionGate{
+		InitialFraction: sdk.NewDecWithPrec(25, 2),
+		Actions:         []Action{ActionDelegate, ActionVote, ActionWasmExecute},
+		WasmContracts:   []string{contract},
+	}
+	require.NoError(t, gate.Validate())
+	require.NoError(t, ActionGate{}.Validate())
+
+	for _, tc := range []struct {
+		name string
+		gate ActionGate
+	}{
+		{"negative initial fraction", ActionGate{InitialFraction: sdk.NewDec(-1), Actions: []Action{ActionVote}}},
+		{"initial fraction of one", ActionGate{InitialFraction: sdk.OneDec(), Actions: []Action{ActionVote}}},
+		{"initial claim action", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{ActionInitialClaim}}},
+		{"duplicate action", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{ActionVote, ActionVote}}},
+		{"unknown action", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{Action(42)}}},
+		{"wasm action without contracts", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{ActionWasmExecute}}},
+		{"contracts without wasm action", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{ActionVote}, WasmContracts: []string{contract}}},
+		{"invalid contract", ActionGate{InitialFraction: sdk.ZeroDec(), Actions: []Action{ActionWasmExecute}, WasmContracts: []string{"contract"}}},
+	} {
+		require.Error(t, tc.gate.Validate(), tc.name)
+	}
+
+	// the initial claim unlocks a quarter, each action a third of the rest
+	amount := sdk.NewInt(1000)
+	require.Equal(t, sdk.NewInt(250), gate.UnlockedAmount(amount, 0))
+	require.Equal(t, sdk.NewInt(500), gate.UnlockedAmount(amount, 1))
+	require.Equal(t, sdk.NewInt(750), gate.UnlockedAmount(amount, 2))
+	require.Equal(t, amount, gate.UnlockedAmount(amount, 3))
+
+	allocation := AirdropAllocation{CompletedActions: []Action{ActionInitialClaim, ActionVote}}
+	require.True(t, allocation.HasCompleted(ActionVote))
+	require.Equal(t, 1, allocation.CompletedGatingActions())
+	require.Equal(t, []Action{ActionDelegate, ActionWasmExecute}, allocation.PendingActions(gate))
+}
@@ -31,6 +31,16 @@ type AirdropAllocation struct {
 	Address       string                                  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=claimed_amount,json=claimedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"claimed_amount"`
+	CampaignId    uint64                                  `protobuf:"varint,5,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// expired is set once the unclaimed amount is swept after the claim deadline
+	// of the campaign.
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	// reward_address is the address the allocation of an action gated campaign
+	// is paid to as actions complete, set on the first claim.
+	RewardAddress string `protobuf:"bytes,7,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// completed_actions are the actions of the reward address that unlocked a
+	// part of the allocation.
+	CompletedActions []Action `protobuf:"varint,8,rep,packed,name=completed_actions,json=completedActions,proto3,enum=teritori.airdrop.v1beta1.Action" json:"completed_actions,omitempty"`
 }
 
 func (m *AirdropAllocation) Reset()         { *m = AirdropAllocation{} }
@@ -80,6 +90,34 @@ func (m *AirdropAllocation) GetAddress() string {
 	return ""
 }
 
+func (m *AirdropAllocation) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *AirdropAllocation) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *AirdropAllocation) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *AirdropAllocation) GetCompletedActions() []Action {
+	if m != nil {
+		return m.CompletedActions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AirdropAllocation)(nil), "teritori.airdrop.v1beta1.AirdropAllocation")
 }
@@ -89,25 +127,32 @@ func init() {
 }
 
 var fileDescriptor_c1e3c9fead94de4f = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x49, 0x2d, 0xca,
-	0x2c, 0xc9, 0x2f, 0xca, 0xd4, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcc, 0xc9, 0xc9, 0x4f, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80, 0x29, 0xd5, 0x83, 0x2a, 0xd5, 0x83, 0x2a, 0x95,
-	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x24, 0x93, 0xf3,
-	0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12, 0x10, 0x0e, 0x44, 0x4a, 0xe9, 0x09, 0x23, 0x97, 0xa0,
-	0x23, 0xc4, 0x10, 0x47, 0xb8, 0x35, 0x42, 0x22, 0x5c, 0xac, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51,
-	0x6a, 0x71, 0xb1, 0x04, 0x13, 0x58, 0x1c, 0xc6, 0x15, 0x72, 0xe7, 0x62, 0x4b, 0xcc, 0xcd, 0x2f,
-	0xcd, 0x2b, 0x91, 0x60, 0x06, 0x49, 0x38, 0xe9, 0x9f, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc,
-	0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xd4, 0x5a, 0x28, 0xa5, 0x5b,
-	0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x9c, 0x9f, 0x99, 0x17, 0x04, 0xd5,
-	0x2e, 0x14, 0xc6, 0xc5, 0x97, 0x9c, 0x93, 0x98, 0x99, 0x9b, 0x9a, 0x12, 0x0f, 0x35, 0x90, 0x85,
-	0x3c, 0x03, 0x79, 0xa1, 0xc6, 0x38, 0x82, 0x4d, 0x71, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0x23, 0x24, 0x13, 0x43, 0x5c, 0x83, 0x3c, 0x43, 0xfc, 0x83, 0x3c,
-	0xf5, 0x61, 0xe1, 0xab, 0x0b, 0xf6, 0xb8, 0x7e, 0x05, 0x3c, 0x4a, 0xc0, 0x36, 0x24, 0xb1, 0x81,
-	0xc3, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xc4, 0x21, 0x86, 0xb3, 0x01, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x6d, 0xe5, 0x3d, 0x1e, 0x8e, 0x81, 0x48, 0xc3, 0x62, 0x64, 0x51, 0x1a, 0x13, 0x43, 0x5d,
+	0xd0, 0x09, 0xf8, 0x05, 0xc5, 0x18, 0x43, 0xa2, 0x31, 0x69, 0x88, 0x0b, 0x37, 0xcd, 0x30, 0x33,
+	0x29, 0x13, 0xdb, 0x4e, 0x33, 0x33, 0x28, 0xfe, 0x85, 0x1b, 0xff, 0x89, 0x25, 0x4b, 0xe3, 0x82,
+	0x18, 0xf8, 0x11, 0xd3, 0xce, 0x94, 0xb8, 0x61, 0xf3, 0x56, 0xed, 0xbd, 0xf7, 0xcc, 0xb9, 0xf7,
+	0x9c, 0x03, 0x5e, 0x6b, 0x26, 0xb9, 0x16, 0x92, 0x23, 0xcc, 0x25, 0x95, 0xa2, 0x42, 0xdf, 0xe6,
+	0x1b, 0xa6, 0xf1, 0x1c, 0xe1, 0x3c, 0x17, 0x04, 0x6b, 0x2e, 0xca, 0xa8, 0x92, 0x42, 0x0b, 0x0f,
+	0xb6, 0xd0, 0xc8, 0x42, 0x23, 0x0b, 0x1d, 0x8f, 0x32, 0x91, 0x89, 0x06, 0x84, 0xea, 0x3f, 0x83,
+	0x1f, 0xbf, 0x20, 0x42, 0x15, 0x42, 0xa5, 0x66, 0x60, 0x0a, 0x3b, 0x9a, 0xde, 0xdc, 0x4a, 0x70,
+	0x51, 0x61, 0x9e, 0xd9, 0x9d, 0x2f, 0x7f, 0x75, 0xc0, 0x30, 0x36, 0x90, 0xf8, 0x7a, 0x8f, 0x37,
+	0x02, 0xf7, 0x64, 0x8b, 0x79, 0x09, 0xdd, 0xc0, 0x0d, 0x9f, 0x26, 0xa6, 0xf0, 0x20, 0x78, 0xc0,
+	0x94, 0x4a, 0xa6, 0x14, 0x7c, 0xd2, 0xf4, 0xdb, 0xd2, 0x7b, 0x0f, 0xba, 0xb8, 0x10, 0xbb, 0x52,
+	0xc3, 0x4e, 0x3d, 0x58, 0xa2, 0xc3, 0x69, 0xe2, 0xfc, 0x39, 0x4d, 0xa6, 0x19, 0xd7, 0xdb, 0xdd,
+	0x26, 0x22, 0xa2, 0xb0, 0xf7, 0xd9, 0xcf, 0x4c, 0xd1, 0xaf, 0x48, 0xff, 0xa8, 0x98, 0x8a, 0xde,
+	0x0a, 0x5e, 0x26, 0xf6, 0xb9, 0xf7, 0x19, 0x0c, 0x48, 0x8e, 0x79, 0xc1, 0x68, 0x6a, 0x09, 0xef,
+	0x1e, 0x47, 0xd8, 0xb7, 0x34, 0xb1, 0xe1, 0x9d, 0x80, 0x67, 0xad, 0xf0, 0x94, 0x53, 0x78, 0x1f,
+	0xb8, 0xe1, 0x5d, 0x02, 0xda, 0xd6, 0x8a, 0xd6, 0xda, 0xd8, 0xbe, 0xe2, 0x92, 0x51, 0xd8, 0x0d,
+	0xdc, 0xb0, 0x97, 0xb4, 0xa5, 0xf7, 0x0a, 0x0c, 0x24, 0xfb, 0x8e, 0x25, 0x4d, 0x5b, 0xf1, 0x0f,
+	0x8d, 0xf8, 0xbe, 0xe9, 0xc6, 0xd6, 0x82, 0x8f, 0x60, 0x48, 0x44, 0x51, 0xe5, 0x4c, 0xd7, 0xb7,
+	0x93, 0xda, 0x46, 0x05, 0x7b, 0x41, 0x27, 0x1c, 0x2c, 0x82, 0xe8, 0x56, 0xb0, 0x51, 0xdc, 0x00,
+	0x93, 0xe7, 0xd7, 0xa7, 0xa6, 0xa1, 0x96, 0x1f, 0x0e, 0x67, 0xdf, 0x3d, 0x9e, 0x7d, 0xf7, 0xef,
+	0xd9, 0x77, 0x7f, 0x5e, 0x7c, 0xe7, 0x78, 0xf1, 0x9d, 0xdf, 0x17, 0xdf, 0xf9, 0xb2, 0xf8, 0xcf,
+	0x82, 0xf5, 0xbb, 0x64, 0xb5, 0xfe, 0x94, 0xac, 0x50, 0xbb, 0x60, 0xd6, 0x24, 0x85, 0xf6, 0xd7,
+	0xd8, 0x1b, 0x4b, 0x36, 0xdd, 0x26, 0xec, 0x37, 0xff, 0x06, 0x00, 0x6e, 0x89, 0x3b, 0xc7, 0x8d,
+	0x02, 0x00, 0x00,
 }
 
 func (m *AirdropAllocation) Marshal() (dAtA []byte, err error) {
@@ -130,6 +175,46 @@ func (m *AirdropAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompletedActions) > 0 {
+		dAtA2 := make([]byte, len(m.CompletedActions)*10)
+		var j1 int
+		for _, num := range m.CompletedActions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAllocation(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintAllocation(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintAllocation(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.ClaimedAmount.Size()
 		i -= size
@@ -196,6 +281,23 @@ func (m *AirdropAllocation) Size() (n int) {
 	n += 1 + l + sovAllocation(uint64(l))
 	l = m.ClaimedAmount.Size()
 	n += 1 + l + sovAllocation(uint64(l))
+	if m.CampaignId != 0 {
+		n += 1 + sovAllocation(uint64(m.CampaignId))
+	}
+	if m.Expired {
+		n += 2
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovAllocation(uint64(l))
+	}
+	if len(m.CompletedActions) > 0 {
+		l = 0
+		for _, e := range m.CompletedActions {
+			l += sovAllocation(uint64(e))
+		}
+		n += 1 + sovAllocation(uint64(l)) + l
+	}
 	return n
 }
 
@@ -366,6 +468,146 @@ func (m *AirdropAllocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAllocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompletedActions = append(m.CompletedActions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAllocation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAllocation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAllocation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CompletedActions) == 0 {
+					m.CompletedActions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAllocation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CompletedActions = append(m.CompletedActions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedActions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAllocation(dAtA[iNdEx:])
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SweepBatchSize is the maximum number of allocations expired per block when
+// sweeping the unclaimed funds of ended campaigns.
+const SweepBatchSize = 100
+
+// GenesisCampaignID is the id of the campaign of the genesis airdrop, whose
+// escrow account is the airdrop module account. Created campaigns have
+// positive ids.
+const GenesisCampaignID = uint64(0)
+
+// CampaignEscrowAddress returns the address of the escrow account of a
+// campaign, derived from the airdrop module account.
+func CampaignEscrowAddress(id uint64) sdk.AccAddress {
+	if id == GenesisCampaignID {
+		return authtypes.NewModuleAddress(ModuleName)
+	}
+	return address.Module(ModuleName, sdk.Uint64ToBigEndian(id))
+}
+
+// NewGenesisCampaign returns the campaign of the genesis airdrop, with no
+// claim window and no allocation.
+func NewGenesisCampaign(denom string) Campaign {
+	return Campaign{
+		Id:            GenesisCampaignID,
+		Description:   "Genesis airdrop",
+		Denom:         denom,
+		EscrowAddress: CampaignEscrowAddress(GenesisCampaignID).String(),
+		TotalAmount:   sdk.ZeroInt(),
+		ClaimedAmount: sdk.ZeroInt(),
+		SweptAmount:   sdk.ZeroInt(),
+	}
+}
+
+// IsMerkle returns whether the allocations of the campaign are committed to by
+// a Merkle root instead of being stored on-chain.
+func (c Campaign) IsMerkle() bool {
+	return c.MerkleRoot != ""
+}
+
+// CheckClaimWindow returns an error if the allocations of the campaign cannot
+// be claimed at the given time.
+func (c Campaign) CheckClaimWindow(t time.Time) error {
+	if t.Before(c.StartTime) {
+		return errors.Wrapf(ErrCampaignNotStarted, "campaign %d starts at %s", c.Id, c.StartTime)
+	}
+	if c.HasEnded(t) {
+		return errors.Wrapf(ErrCampaignEnded, "campaign %d ended at %s", c.Id, c.EndTime)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the campaign.
+func (c Campaign) Validate() error {
+	if c.EscrowAddress != CampaignEscrowAddress(c.Id).String() {
+		return fmt.Errorf("campaign %d: escrow address must be %s", c.Id, CampaignEscrowAddress(c.Id))
+	}
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("campaign %d: %w", c.Id, err)
+	}
+	if !c.EndTime.IsZero() && !c.EndTime.After(c.StartTime) {
+		return errors.Wrapf(ErrInvalidClaimWindow, "campaign %d ends at %s, before its start at %s", c.Id, c.EndTime, c.StartTime)
+	}
+	if c.IsMerkle() {
+		if _, err := DecodeMerkleHash(c.MerkleRoot); err != nil {
+			return errors.Wrapf(ErrInvalidMerkleRoot, "campaign %d: %s", c.Id, err)
+		}
+	}
+	if c.TotalAmount.IsNil() || c.TotalAmount.IsNegative() {
+		return fmt.Errorf("campaign %d: invalid total amount %s", c.Id, c.TotalAmount)
+	}
+	if c.ClaimedAmount.IsNil() || c.ClaimedAmount.IsNegative() {
+		return fmt.Errorf("campaign %d: invalid claimed amount %s", c.Id, c.ClaimedAmount)
+	}
+	if c.ClaimedAmount.GT(c.TotalAmount) {
+		return fmt.Errorf("campaign %d: claimed amount %s exceeds total amount %s", c.Id, c.ClaimedAmount, c.TotalAmount)
+	}
+	if err := c.Vesting.Validate(); err != nil {
+		return fmt.Errorf("campaign %d: %w", c.Id, err)
+	}
+	if err := c.ActionGate.Validate(); err != nil {
+		return fmt.Errorf("campaign %d: %w", c.Id, err)
+	}
+	if c.IsMerkle() && !c.ActionGate.IsZero() {
+		return errors.Wrapf(ErrMerkleCampaign, "campaign %d cannot be action gated", c.Id)
+	}
+	if c.SweptAmount.IsNil() || c.SweptAmount.IsNegative() {
+		return fmt.Errorf("campaign %d: invalid swept amount %s", c.Id, c.SweptAmount)
+	}
+	return nil
+}
+
+// HasEnded returns whether the claim deadline of the campaign passed at the
+// given time.
+func (c Campaign) HasEnded(t time.Time) bool {
+	return !c.EndTime.IsZero() && !t.Before(c.EndTime)
+}
+
+// ValidateAllocation performs a basic validation of an allocation of the
+// campaign.
+func (c Campaign) ValidateAllocation(allocation AirdropAllocation) error {
+	if allocation.CampaignId != c.Id {
+		return fmt.Errorf("allocation of %s belongs to campaign %d, not %d", allocation.Address, allocation.CampaignId, c.Id)
+	}
+	if c.IsMerkle() {
+		return errors.Wrapf(ErrMerkleCampaign, "campaign %d", c.Id)
+	}
+	if allocation.Address == "" {
+		return ErrEmptyOnChainAllocationAddress
+	}
+	if len(allocation.Address) > MaxAddressLength {
+		return fmt.Errorf("address %s is longer than %d bytes", allocation.Address, MaxAddressLength)
+	}
+	if err := allocation.Amount.Validate(); err != nil {
+		return fmt.Errorf("allocation of %s: %w", allocation.Address, err)
+	}
+	if err := allocation.ClaimedAmount.Validate(); err != nil {
+		return fmt.Errorf("allocation of %s: %w", allocation.Address, err)
+	}
+	if allocation.Amount.Denom != c.Denom || allocation.ClaimedAmount.Denom != c.Denom {
+		return fmt.Errorf("allocation of %s must be in the campaign denom %s", allocation.Address, c.Denom)
+	}
+	if allocation.ClaimedAmount.Amount.GT(allocation.Amount.Amount) {
+		return fmt.Errorf("allocation of %s: claimed amount %s exceeds amount %s", allocation.Address, allocation.ClaimedAmount, allocation.Amount)
+	}
+	if allocation.RewardAddress != "" {
+		if c.ActionGate.IsZero() {
+			return fmt.Errorf("allocation of %s has a reward address, but campaign %d is not action gated", allocation.Address, c.Id)
+		}
+		if _, err := sdk.AccAddressFromBech32(allocation.RewardAddress); err != nil {
+			return fmt.Errorf("allocation of %s: %w", allocation.Address, err)
+		}
+	}
+	for _, action := range allocation.CompletedActions {
+		if action != ActionInitialClaim && !c.ActionGate.HasAction(action) {
+			return fmt.Errorf("allocation of %s: action %s is not a gating action of campaign %d", allocation.Address, action, c.Id)
+		}
+	}
+	return nil
+}
@@ -10,12 +10,16 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimAllocation{}, "teritori/airdrop/ClaimAllocation", nil)
+	cdc.RegisterConcrete(&MsgClaimWithProof{}, "teritori/airdrop/ClaimWithProof", nil)
+	cdc.RegisterConcrete(&MsgReleaseVested{}, "teritori/airdrop/ReleaseVested", nil)
 	cdc.RegisterConcrete(&MsgSignData{}, "sign/MsgSignData", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimAllocation{},
+		&MsgClaimWithProof{},
+		&MsgReleaseVested{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -13,4 +13,17 @@ var (
 	ErrNativeChainAccountSigVerificationFailure = errors.Register(ModuleName, 5, "native chain account signature verification failure")
 	ErrEmptyAddress                             = errors.Register(ModuleName, 6, "empty address")
 	ErrNotEnoughPermission                      = errors.Register(ModuleName, 7, "not enough permission for the action")
+	ErrCampaignDoesNotExist                     = errors.Register(ModuleName, 8, "airdrop campaign does not exist")
+	ErrInvalidMerkleRoot                        = errors.Register(ModuleName, 9, "invalid merkle root")
+	ErrInvalidMerkleProof                       = errors.Register(ModuleName, 10, "invalid merkle proof")
+	ErrMerkleAllocationAlreadyClaimed           = errors.Register(ModuleName, 11, "merkle campaign allocation is already claimed")
+	ErrCampaignExhausted                        = errors.Register(ModuleName, 12, "claim exceeds the unclaimed amount of the campaign")
+	ErrCampaignNotStarted                       = errors.Register(ModuleName, 13, "airdrop campaign has not started")
+	ErrCampaignEnded                            = errors.Register(ModuleName, 14, "airdrop campaign has ended")
+	ErrInvalidClaimWindow                       = errors.Register(ModuleName, 15, "invalid claim window")
+	ErrMerkleCampaign                           = errors.Register(ModuleName, 16, "allocations of a merkle campaign are not stored on-chain")
+	ErrNotMerkleCampaign                        = errors.Register(ModuleName, 17, "airdrop campaign is not a merkle campaign")
+	ErrInvalidCampaignDenom                     = errors.Register(ModuleName, 18, "denom differs from the campaign denom")
+	ErrAirdropAllocationExpired                 = errors.Register(ModuleName, 19, "airdrop allocation expired after the claim deadline")
+	ErrNoVestedClaims                           = errors.Register(ModuleName, 20, "no vested airdrop claims to release")
 )
@@ -2,8 +2,23 @@ package types
 
 const (
 	EventTypeClaimAllocation = "claim_allocation"
+	EventTypeCreateCampaign  = "create_campaign"
+	EventTypeClaimWithProof  = "claim_with_proof"
+	EventTypeSetDeadline     = "set_claim_deadline"
+	EventTypeSweepCampaign   = "sweep_campaign"
+	EventTypeVestClaim       = "vest_claim"
+	EventTypeReleaseVested   = "release_vested"
+	EventTypeCompleteAction  = "complete_action"
 
 	AttributeKeyAddress       = "address"
 	AttributeKeyAmount        = "amount"
 	AttributeKeyRewardAddress = "reward_address"
+	AttributeKeyCampaignID    = "campaign_id"
+	AttributeKeyIndex         = "index"
+	AttributeKeyMerkleRoot    = "merkle_root"
+	AttributeKeyEscrowAddress = "escrow_address"
+	AttributeKeyDeadline      = "deadline"
+	AttributeKeyExpired       = "expired_allocations"
+	AttributeKeyCompleted     = "completed"
+	AttributeKeyAction        = "action"
 )
@@ -1,10 +1,17 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	genesisCampaign := NewGenesisCampaign("utori")
+	genesisCampaign.TotalAmount = types.NewInt(600000000)
 	return &GenesisState{
+		Campaigns: []Campaign{genesisCampaign},
 		Allocations: []AirdropAllocation{
 			{
 				Chain:         "evm",
@@ -49,5 +56,93 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	campaigns := make(map[uint64]Campaign, len(gs.Campaigns))
+	for _, campaign := range gs.Campaigns {
+		if _, ok := campaigns[campaign.Id]; ok {
+			return fmt.Errorf("duplicate campaign %d", campaign.Id)
+		}
+		if err := campaign.Validate(); err != nil {
+			return err
+		}
+		campaigns[campaign.Id] = campaign
+	}
+
+	// the totals of the campaigns with on-chain allocations add up the allocations
+	totals := make(map[uint64]*campaignTotals, len(gs.Campaigns))
+	seen := make(map[string]bool, len(gs.Allocations))
+	for _, allocation := range gs.Allocations {
+		campaign, ok := campaigns[allocation.CampaignId]
+		if !ok {
+			return fmt.Errorf("allocation of %s belongs to unknown campaign %d", allocation.Address, allocation.CampaignId)
+		}
+		if err := campaign.ValidateAllocation(allocation); err != nil {
+			return err
+		}
+		key := string(GetAllocationKey(allocation.CampaignId, allocation.Chain, allocation.Address))
+		if seen[key] {
+			return fmt.Errorf("duplicate allocation of %s on %s in campaign %d", allocation.Address, allocation.Chain, allocation.CampaignId)
+		}
+		seen[key] = true
+
+		total, ok := totals[campaign.Id]
+		if !ok {
+			total = newCampaignTotals()
+			totals[campaign.Id] = total
+		}
+		total.amount = total.amount.Add(allocation.Amount.Amount)
+		total.claimed = total.claimed.Add(allocation.ClaimedAmount.Amount)
+	}
+	for _, campaign := range gs.Campaigns {
+		if campaign.IsMerkle() {
+			continue
+		}
+		total, ok := totals[campaign.Id]
+		if !ok {
+			total = newCampaignTotals()
+		}
+		if !campaign.TotalAmount.Equal(total.amount) || !campaign.ClaimedAmount.Equal(total.claimed) {
+			return fmt.Errorf("campaign %d: total amount %s and claimed amount %s differ from the allocations %s and %s",
+				campaign.Id, campaign.TotalAmount, campaign.ClaimedAmount, total.amount, total.claimed)
+		}
+	}
+
+	for _, chunk := range gs.MerkleClaimedChunks {
+		campaign, ok := campaigns[chunk.CampaignId]
+		if !ok || !campaign.IsMerkle() {
+			return fmt.Errorf("claimed bitmap of unknown merkle campaign %d", chunk.CampaignId)
+		}
+		if len(chunk.Bits) != MerkleClaimedChunkBits/8 {
+			return fmt.Errorf("claimed bitmap chunk %d of merkle campaign %d has %d bytes", chunk.Chunk, chunk.CampaignId, len(chunk.Bits))
+		}
+	}
+
+	seen = make(map[string]bool, len(gs.VestingClaims))
+	for _, claim := range gs.VestingClaims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+		campaign, ok := campaigns[claim.CampaignId]
+		if !ok || campaign.Vesting.IsZero() {
+			return fmt.Errorf("vesting claim of %s belongs to unknown vesting campaign %d", claim.Address, claim.CampaignId)
+		}
+		if claim.Denom != campaign.Denom {
+			return fmt.Errorf("vesting claim of %s must be in the campaign denom %s", claim.Address, campaign.Denom)
+		}
+		key := string(GetVestingClaimKey(types.MustAccAddressFromBech32(claim.Address), claim.CampaignId, claim.StartTime))
+		if seen[key] {
+			return fmt.Errorf("duplicate vesting claim of %s in campaign %d at %s", claim.Address, claim.CampaignId, claim.StartTime)
+		}
+		seen[key] = true
+	}
 	return nil
 }
+
+// campaignTotals adds up the allocations of a campaign.
+type campaignTotals struct {
+	amount  types.Int
+	claimed types.Int
+}
+
+func newCampaignTotals() *campaignTotals {
+	return &campaignTotals{amount: types.ZeroInt(), claimed: types.ZeroInt()}
+}
@@ -27,8 +27,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
-	Params      Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Allocations []AirdropAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Allocations         []AirdropAllocation  `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+	Campaigns           []Campaign           `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns"`
+	MerkleClaimedChunks []MerkleClaimedChunk `protobuf:"bytes,4,rep,name=merkle_claimed_chunks,json=merkleClaimedChunks,proto3" json:"merkle_claimed_chunks"`
+	VestingClaims       []VestingClaim       `protobuf:"bytes,5,rep,name=vesting_claims,json=vestingClaims,proto3" json:"vesting_claims"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,27 @@ func (m *GenesisState) GetAllocations() []AirdropAllocation {
 	return nil
 }
 
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleClaimedChunks() []MerkleClaimedChunk {
+	if m != nil {
+		return m.MerkleClaimedChunks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingClaims() []VestingClaim {
+	if m != nil {
+		return m.VestingClaims
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "teritori.airdrop.v1beta1.GenesisState")
 }
@@ -87,26 +111,32 @@ func init() {
 }
 
 var fileDescriptor_70ea57bcfeb0bccc = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x49, 0x2d, 0xca,
-	0x2c, 0xc9, 0x2f, 0xca, 0xd4, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xa9, 0xd3, 0x83, 0xaa, 0xd3, 0x83, 0xaa, 0x93, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0xe4, 0xd2, 0xf3, 0xf3, 0xd3, 0x73,
-	0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x94, 0xd2, 0xa2, 0xc4, 0x92, 0xcc, 0xfc, 0x3c,
-	0xa8, 0xbc, 0x3c, 0xba, 0x7c, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e, 0x01, 0x54, 0x81,
-	0x26, 0x4e, 0x87, 0x25, 0xe6, 0xe4, 0xe4, 0x27, 0x23, 0x9b, 0xa5, 0x8a, 0x53, 0x69, 0x41, 0x62,
-	0x51, 0x62, 0x2e, 0xd4, 0x0b, 0x4a, 0x8b, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0x9e, 0x0a, 0x2e, 0x49,
-	0x2c, 0x49, 0x15, 0xb2, 0xe3, 0x62, 0x83, 0x28, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52,
-	0xd0, 0xc3, 0xe5, 0x49, 0xbd, 0x00, 0xb0, 0x3a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0,
-	0xba, 0x84, 0x82, 0xb9, 0xb8, 0x11, 0x6e, 0x29, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0xd2,
-	0xc6, 0x6d, 0x88, 0x23, 0x84, 0xef, 0x08, 0xd7, 0x03, 0x35, 0x0f, 0xd9, 0x14, 0x27, 0x9f, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x0f, 0x71, 0x0d, 0xf2, 0x0c, 0xf1, 0x0f, 0xf2, 0xd4, 0x87, 0x59,
-	0xa6, 0x9b, 0x9c, 0x91, 0x98, 0x99, 0xa7, 0x5f, 0x01, 0x0f, 0x82, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xd7, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xc3, 0xef, 0xdf, 0xe7,
-	0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xda, 0x30,
+	0x18, 0xc7, 0x93, 0xc1, 0x90, 0x16, 0xb6, 0x1d, 0xb2, 0x4d, 0x8a, 0x38, 0x04, 0x84, 0x34, 0xc6,
+	0xb4, 0x2d, 0x16, 0xec, 0x3e, 0x09, 0xd0, 0x36, 0x21, 0xb5, 0x6a, 0x05, 0xa8, 0x87, 0x5e, 0x90,
+	0x13, 0x8c, 0xb1, 0x88, 0xe3, 0xc8, 0x76, 0x50, 0xfb, 0x16, 0x7d, 0x8c, 0x3e, 0x0a, 0x47, 0x8e,
+	0x3d, 0x55, 0x15, 0xbc, 0x48, 0x85, 0xe3, 0x00, 0x6a, 0xe5, 0x5b, 0x9c, 0xef, 0xf7, 0xff, 0x7d,
+	0xfe, 0xbe, 0xc4, 0x69, 0x49, 0xc4, 0x89, 0x64, 0x9c, 0x00, 0x48, 0xf8, 0x8c, 0xb3, 0x14, 0xac,
+	0x3a, 0x21, 0x92, 0xb0, 0x03, 0x30, 0x4a, 0x90, 0x20, 0x22, 0x48, 0x39, 0x93, 0xcc, 0xf5, 0x0a,
+	0x2e, 0xd0, 0x5c, 0xa0, 0xb9, 0xda, 0x67, 0xcc, 0x30, 0x53, 0x10, 0xd8, 0x3f, 0xe5, 0x7c, 0xcd,
+	0xc7, 0x8c, 0xe1, 0x18, 0x01, 0x75, 0x0a, 0xb3, 0x39, 0x98, 0x65, 0x1c, 0x4a, 0xc2, 0x12, 0x5d,
+	0xaf, 0xbf, 0xac, 0x4b, 0x42, 0x91, 0x90, 0x90, 0xa6, 0x1a, 0xf8, 0x6e, 0xbc, 0x18, 0x8c, 0x63,
+	0x16, 0x9d, 0xba, 0xbe, 0x19, 0xd1, 0x08, 0xd2, 0x14, 0x12, 0x5c, 0x80, 0x5f, 0x8d, 0x60, 0x0a,
+	0x39, 0xa4, 0x7a, 0xd6, 0xe6, 0x7d, 0xc9, 0x79, 0xff, 0x3f, 0x9f, 0x7e, 0x2c, 0xa1, 0x44, 0xee,
+	0x1f, 0xa7, 0x92, 0x03, 0x9e, 0xdd, 0xb0, 0xdb, 0xd5, 0x6e, 0x23, 0x30, 0x6d, 0x23, 0xb8, 0x54,
+	0x5c, 0xbf, 0xbc, 0x7e, 0xac, 0x5b, 0x23, 0x9d, 0x72, 0xc7, 0x4e, 0xf5, 0x78, 0x69, 0xe1, 0xbd,
+	0x69, 0x94, 0xda, 0xd5, 0xee, 0x0f, 0xb3, 0xa4, 0x97, 0x9f, 0x7b, 0x87, 0x8c, 0xf6, 0x9d, 0x5a,
+	0xdc, 0x7f, 0xce, 0xbb, 0x62, 0x3c, 0xe1, 0x95, 0x94, 0xb2, 0x69, 0x56, 0x0e, 0x34, 0xaa, 0x4d,
+	0xc7, 0xa8, 0x3b, 0x77, 0xbe, 0x50, 0xc4, 0x97, 0x31, 0x9a, 0x46, 0x31, 0x24, 0x14, 0xcd, 0xa6,
+	0xd1, 0x22, 0x4b, 0x96, 0xc2, 0x2b, 0x2b, 0xe7, 0x4f, 0xb3, 0xf3, 0x5c, 0xc5, 0x06, 0x79, 0x6a,
+	0xb0, 0x0f, 0x69, 0xfb, 0x27, 0xfa, 0xaa, 0xb2, 0x5f, 0xc2, 0xc7, 0x15, 0x12, 0x92, 0x24, 0x38,
+	0x6f, 0x24, 0xbc, 0xb7, 0xaa, 0x41, 0xcb, 0xdc, 0xe0, 0x2a, 0xe7, 0x95, 0x47, 0xab, 0x3f, 0xac,
+	0x4e, 0xde, 0x89, 0xfe, 0xd9, 0x7a, 0xeb, 0xdb, 0x9b, 0xad, 0x6f, 0x3f, 0x6d, 0x7d, 0xfb, 0x6e,
+	0xe7, 0x5b, 0x9b, 0x9d, 0x6f, 0x3d, 0xec, 0x7c, 0xeb, 0xba, 0x8b, 0x89, 0x5c, 0x64, 0x61, 0x10,
+	0x31, 0x0a, 0x26, 0x7f, 0x47, 0xc3, 0xc9, 0xc5, 0x68, 0x08, 0x8a, 0x4e, 0xbf, 0xa2, 0x05, 0x24,
+	0x09, 0xb8, 0x39, 0xfc, 0x07, 0xf2, 0x36, 0x45, 0x22, 0xac, 0xa8, 0xef, 0xff, 0xfb, 0x79, 0x00,
+	0x58, 0xa9, 0x2d, 0xab, 0x15, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +159,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingClaims) > 0 {
+		for iNdEx := len(m.VestingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MerkleClaimedChunks) > 0 {
+		for iNdEx := len(m.MerkleClaimedChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleClaimedChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -181,6 +253,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleClaimedChunks) > 0 {
+		for _, e := range m.MerkleClaimedChunks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingClaims) > 0 {
+		for _, e := range m.VestingClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -286,6 +376,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleClaimedChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleClaimedChunks = append(m.MerkleClaimedChunks, MerkleClaimedChunk{})
+			if err := m.MerkleClaimedChunks[len(m.MerkleClaimedChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingClaims = append(m.VestingClaims, VestingClaim{})
+			if err := m.VestingClaims[len(m.VestingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	genesis := DefaultGenesis()
+	require.NoError(t, genesis.Validate())
+
+	// the campaign total must add up its allocations
+	genesis.Campaigns[0].TotalAmount = sdk.NewInt(500000000)
+	require.Error(t, genesis.Validate())
+
+	// allocations belong to a campaign
+	genesis = DefaultGenesis()
+	genesis.Allocations[0].CampaignId = 1
+	require.Error(t, genesis.Validate())
+
+	// an allocation is set once per address and chain
+	genesis = DefaultGenesis()
+	genesis.Allocations = append(genesis.Allocations, genesis.Allocations[0])
+	genesis.Campaigns[0].TotalAmount = sdk.NewInt(700000000)
+	require.Error(t, genesis.Validate())
+
+	// the escrow account derives from the campaign id
+	genesis = DefaultGenesis()
+	genesis.Campaigns[0].EscrowAddress = CampaignEscrowAddress(1).String()
+	require.Error(t, genesis.Validate())
+}
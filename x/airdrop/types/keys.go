@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "airdrop"
@@ -12,8 +19,106 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// MaxAddressLength is the maximum length of a native chain address
+	MaxAddressLength = 255
 )
 
 var (
-	KeyPrefixAirdropAllocation = []byte{0x01}
+	KeyPrefixCampaign          = []byte{0x02}
+	KeyPrefixMerkleClaimed     = []byte{0x03}
+	KeyPrefixAirdropAllocation = []byte{0x04}
+	KeyPrefixSweepCursor       = []byte{0x05}
+	KeyPrefixVestingClaim      = []byte{0x06}
+	KeyPrefixRewardAddress     = []byte{0x07}
+	KeyPrefixCampaignDeadline  = []byte{0x08}
 )
+
+// GetCampaignKey returns the key of a campaign.
+func GetCampaignKey(id uint64) []byte {
+	return append(KeyPrefixCampaign, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCampaignAllocationsPrefix returns the key prefix of the allocations of a
+// campaign.
+func GetCampaignAllocationsPrefix(campaignID uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixAirdropAllocation)+8)
+	key = append(key, KeyPrefixAirdropAllocation...)
+	return append(key, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetAddressAllocationsPrefix returns the key prefix of the allocations of an
+// address in a campaign, one per chain.
+func GetAddressAllocationsPrefix(campaignID uint64, address string) []byte {
+	return append(GetCampaignAllocationsPrefix(campaignID), lengthPrefix(address)...)
+}
+
+// GetAllocationKey returns the key of the allocation of an address on a chain
+// in a campaign.
+func GetAllocationKey(campaignID uint64, chain, address string) []byte {
+	return append(GetAddressAllocationsPrefix(campaignID, address), chain...)
+}
+
+// GetSweepCursorKey returns the key of the allocation key from which the sweep
+// of an ended campaign resumes.
+func GetSweepCursorKey(campaignID uint64) []byte {
+	return append(KeyPrefixSweepCursor, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetCampaignDeadlinePrefix returns the key prefix of the index of the
+// campaigns to sweep at a deadline.
+func GetCampaignDeadlinePrefix(deadline time.Time) []byte {
+	return append(KeyPrefixCampaignDeadline, sdk.FormatTimeBytes(deadline)...)
+}
+
+// GetCampaignDeadlineKey returns the key indexing a campaign to sweep by its
+// deadline.
+func GetCampaignDeadlineKey(deadline time.Time, campaignID uint64) []byte {
+	return append(GetCampaignDeadlinePrefix(deadline), sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetAddressVestingClaimsPrefix returns the key prefix of the vesting claims of
+// a reward address.
+func GetAddressVestingClaimsPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixVestingClaim, address.MustLengthPrefix(addr)...)
+}
+
+// GetVestingClaimKey returns the key of the vesting claims of a reward address
+// in a campaign at a time.
+func GetVestingClaimKey(addr sdk.AccAddress, campaignID uint64, start time.Time) []byte {
+	key := append(GetAddressVestingClaimsPrefix(addr), sdk.Uint64ToBigEndian(campaignID)...)
+	return append(key, sdk.FormatTimeBytes(start)...)
+}
+
+// GetRewardAddressAllocationsPrefix returns the key prefix of the index of the
+// action gated allocations claimed by a reward address.
+func GetRewardAddressAllocationsPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixRewardAddress, address.MustLengthPrefix(addr)...)
+}
+
+// GetRewardAddressAllocationKey returns the key indexing an action gated
+// allocation by the reward address that claimed it.
+func GetRewardAddressAllocationKey(addr sdk.AccAddress, campaignID uint64, chain, address string) []byte {
+	allocationKey := GetAllocationKey(campaignID, chain, address)
+	return append(GetRewardAddressAllocationsPrefix(addr), allocationKey[len(KeyPrefixAirdropAllocation):]...)
+}
+
+// GetMerkleClaimedChunkKey returns the key of a chunk of the claimed bitmap of
+// a Merkle campaign.
+func GetMerkleClaimedChunkKey(campaignID, chunk uint64) []byte {
+	return append(GetMerkleClaimedPrefix(campaignID), sdk.Uint64ToBigEndian(chunk)...)
+}
+
+// GetMerkleClaimedPrefix returns the key prefix of the claimed bitmap of a
+// Merkle campaign.
+func GetMerkleClaimedPrefix(campaignID uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixMerkleClaimed)+16)
+	key = append(key, KeyPrefixMerkleClaimed...)
+	return append(key, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// lengthPrefix prefixes an address with its length, so that the addresses of
+// different lengths do not share prefixes.
+func lengthPrefix(address string) []byte {
+	return append([]byte{byte(len(address))}, address...)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Leaves and inner nodes of the allocation tree are hashed with distinct
+// prefixes, so that an inner node cannot be passed off as a leaf.
+const (
+	merkleLeafPrefix = byte(0x00)
+	merkleNodePrefix = byte(0x01)
+)
+
+// MerkleClaimedChunkBits is the number of leaves covered by a chunk of the
+// claimed bitmap of a Merkle campaign.
+const MerkleClaimedChunkBits = 256
+
+// MerkleLeaf returns the hash of the allocation of amount to address on chain,
+// at index in the allocation tree.
+func MerkleLeaf(index uint64, chain, address string, amount math.Int) []byte {
+	bz := []byte{merkleLeafPrefix}
+	bz = binary.BigEndian.AppendUint64(bz, index)
+	for _, field := range []string{chain, address, amount.String()} {
+		bz = binary.AppendUvarint(bz, uint64(len(field)))
+		bz = append(bz, field...)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// MerkleNode returns the hash of the inner node with the given children. The
+// children are sorted, so that proofs do not need to carry their positions.
+func MerkleNode(left, right []byte) []byte {
+	if bytes.Compare(left, right) > 0 {
+		left, right = right, left
+	}
+	bz := make([]byte, 0, 1+len(left)+len(right))
+	bz = append(bz, merkleNodePrefix)
+	bz = append(bz, left...)
+	bz = append(bz, right...)
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// VerifyMerkleProof returns whether proof leads from leaf to root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = MerkleNode(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+// BuildMerkleTree returns the root of the tree with the given leaves and the
+// proof of each leaf. A node without a sibling is carried to the next level.
+func BuildMerkleTree(leaves [][]byte) ([]byte, [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs := make([][][]byte, len(leaves))
+	// positions[i] is the position of the ancestor of leaf i in the level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		for i, position := range positions {
+			if sibling := position ^ 1; sibling < len(level) {
+				proofs[i] = append(proofs[i], level[sibling])
+			}
+			positions[i] = position / 2
+		}
+
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, MerkleNode(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// DecodeMerkleHash decodes a hex encoded hash of the allocation tree.
+func DecodeMerkleHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("expected %d bytes, got %d", sha256.Size, len(bz))
+	}
+	return bz, nil
+}
+
+// DecodeMerkleProof decodes a list of hex encoded hashes of the allocation tree.
+func DecodeMerkleProof(proof []string) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(proof))
+	for _, hash := range proof {
+		bz, err := DecodeMerkleHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, bz)
+	}
+	return hashes, nil
+}
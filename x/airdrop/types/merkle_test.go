@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMerkleTree(t *testing.T) {
+	for count := 1; count <= 9; count++ {
+		leaves := [][]byte{}
+		for i := 0; i < count; i++ {
+			leaves = append(leaves, MerkleLeaf(uint64(i), "cosmos", "cosmos1address", math.NewInt(int64(i+1))))
+		}
+
+		root, proofs := BuildMerkleTree(leaves)
+		require.Len(t, proofs, count)
+		for i, leaf := range leaves {
+			require.True(t, VerifyMerkleProof(root, leaf, proofs[i]), "leaf %d of %d", i, count)
+			// the leaf commits to its index and amount
+			require.False(t, VerifyMerkleProof(root, MerkleLeaf(uint64(i+1), "cosmos", "cosmos1address", math.NewInt(int64(i+1))), proofs[i]))
+			require.False(t, VerifyMerkleProof(root, MerkleLeaf(uint64(i), "cosmos", "cosmos1address", math.NewInt(int64(i+2))), proofs[i]))
+		}
+	}
+
+	root, proofs := BuildMerkleTree(nil)
+	require.Nil(t, root)
+	require.Nil(t, proofs)
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ sdk.Msg = &MsgClaimAllocation{}
@@ -9,11 +14,15 @@ var _ sdk.Msg = &MsgClaimAllocation{}
 var MsgTypeClaimAllocation = "claim_allocation"
 
 func NewMsgClaimAllocation(
+	campaignID uint64,
+	chain string,
 	address string,
 	rewardAddress sdk.AccAddress,
 	signature string,
 ) *MsgClaimAllocation {
 	return &MsgClaimAllocation{
+		CampaignId:    campaignID,
+		Chain:         chain,
 		Address:       address,
 		RewardAddress: rewardAddress.String(),
 		Signature:     signature,
@@ -185,11 +194,13 @@ var MsgTypeDepositTokens = "deposit_tokens"
 
 func NewMsgDepositTokens(
 	sender sdk.AccAddress,
+	campaignID uint64,
 	amount sdk.Coins,
 ) *MsgDepositTokens {
 	return &MsgDepositTokens{
-		Sender: sender.String(),
-		Amount: amount,
+		Sender:     sender.String(),
+		CampaignId: campaignID,
+		Amount:     amount,
 	}
 }
 
@@ -223,3 +234,260 @@ func (m *MsgDepositTokens) GetSigners() []sdk.AccAddress {
 		addr,
 	}
 }
+
+var _ sdk.Msg = &MsgCreateCampaign{}
+
+var MsgTypeCreateCampaign = "create_campaign"
+
+func NewMsgCreateCampaign(
+	sender sdk.AccAddress,
+	description string,
+	startTime time.Time,
+	endTime time.Time,
+	denom string,
+	merkleRoot string,
+	totalAmount math.Int,
+	vesting VestingPolicy,
+	actionGate ActionGate,
+) *MsgCreateCampaign {
+	return &MsgCreateCampaign{
+		Sender:      sender.String(),
+		Description: description,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Denom:       denom,
+		MerkleRoot:  merkleRoot,
+		TotalAmount: totalAmount,
+		Vesting:     vesting,
+		ActionGate:  actionGate,
+	}
+}
+
+func (m *MsgCreateCampaign) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCreateCampaign) Type() string {
+	return MsgTypeCreateCampaign
+}
+
+func (m *MsgCreateCampaign) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !m.EndTime.IsZero() && !m.EndTime.After(m.StartTime) {
+		return errors.Wrap(ErrInvalidClaimWindow, "end time must be after start time")
+	}
+
+	if err := m.Vesting.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := m.ActionGate.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if m.MerkleRoot == "" {
+		if !m.TotalAmount.IsNil() && !m.TotalAmount.IsZero() {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "the total amount of a campaign with on-chain allocations adds up its allocations")
+		}
+		return nil
+	}
+
+	if _, err := DecodeMerkleHash(m.MerkleRoot); err != nil {
+		return errors.Wrap(ErrInvalidMerkleRoot, err.Error())
+	}
+	if !m.ActionGate.IsZero() {
+		return errors.Wrap(ErrMerkleCampaign, "a merkle campaign cannot be action gated")
+	}
+	if m.TotalAmount.IsNil() || !m.TotalAmount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "total amount must be positive")
+	}
+
+	return nil
+}
+
+func (m *MsgCreateCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+var _ sdk.Msg = &MsgClaimWithProof{}
+
+var MsgTypeClaimWithProof = "claim_with_proof"
+
+func NewMsgClaimWithProof(
+	campaignID uint64,
+	index uint64,
+	chain string,
+	address string,
+	amount math.Int,
+	proof []string,
+	pubKey string,
+	rewardAddress sdk.AccAddress,
+	signature string,
+) *MsgClaimWithProof {
+	return &MsgClaimWithProof{
+		CampaignId:    campaignID,
+		Index:         index,
+		Chain:         chain,
+		Address:       address,
+		Amount:        amount,
+		Proof:         proof,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddress.String(),
+		Signature:     signature,
+	}
+}
+
+func (m *MsgClaimWithProof) Route() string {
+	return ModuleName
+}
+
+func (m *MsgClaimWithProof) Type() string {
+	return MsgTypeClaimWithProof
+}
+
+func (m *MsgClaimWithProof) ValidateBasic() error {
+	if m.RewardAddress == "" {
+		return ErrEmptyRewardAddress
+	}
+
+	if m.Address == "" {
+		return ErrEmptyOnChainAllocationAddress
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, err := DecodeMerkleProof(m.Proof); err != nil {
+		return errors.Wrap(ErrInvalidMerkleProof, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgClaimWithProof) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgClaimWithProof) GetSigners() []sdk.AccAddress {
+	rewardAddr, err := sdk.AccAddressFromBech32(m.RewardAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		rewardAddr,
+	}
+}
+
+var _ sdk.Msg = &MsgSetClaimDeadline{}
+
+var MsgTypeSetClaimDeadline = "set_claim_deadline"
+
+func NewMsgSetClaimDeadline(
+	sender sdk.AccAddress,
+	campaignID uint64,
+	deadline time.Time,
+) *MsgSetClaimDeadline {
+	return &MsgSetClaimDeadline{
+		Sender:     sender.String(),
+		CampaignId: campaignID,
+		Deadline:   deadline,
+	}
+}
+
+func (m *MsgSetClaimDeadline) Route() string {
+	return ModuleName
+}
+
+func (m *MsgSetClaimDeadline) Type() string {
+	return MsgTypeSetClaimDeadline
+}
+
+func (m *MsgSetClaimDeadline) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	if m.Deadline.IsZero() {
+		return errors.Wrap(ErrInvalidClaimWindow, "deadline must be set")
+	}
+
+	return nil
+}
+
+func (m *MsgSetClaimDeadline) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetClaimDeadline) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+var _ sdk.Msg = &MsgReleaseVested{}
+
+var MsgTypeReleaseVested = "release_vested"
+
+func NewMsgReleaseVested(
+	address sdk.AccAddress,
+) *MsgReleaseVested {
+	return &MsgReleaseVested{
+		Address: address.String(),
+	}
+}
+
+func (m *MsgReleaseVested) Route() string {
+	return ModuleName
+}
+
+func (m *MsgReleaseVested) Type() string {
+	return MsgTypeReleaseVested
+}
+
+func (m *MsgReleaseVested) ValidateBasic() error {
+	if m.Address == "" {
+		return ErrEmptyAddress
+	}
+
+	return nil
+}
+
+func (m *MsgReleaseVested) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgReleaseVested) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
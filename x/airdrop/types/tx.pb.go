@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -126,6 +131,10 @@ type MsgClaimAllocation struct {
 	PubKey        string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	RewardAddress string `protobuf:"bytes,3,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 	Signature     string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	CampaignId    uint64 `protobuf:"varint,5,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// chain is the chain of the address, the first allocation of the address in
+	// the campaign is claimed if empty.
+	Chain string `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
 }
 
 func (m *MsgClaimAllocation) Reset()         { *m = MsgClaimAllocation{} }
@@ -344,6 +353,8 @@ var xxx_messageInfo_MsgTransferModuleOwnershipResponse proto.InternalMessageInfo
 type MsgDepositTokens struct {
 	Sender string                                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Amount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// campaign_id is the campaign whose escrow account receives the tokens.
+	CampaignId uint64 `protobuf:"varint,3,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *MsgDepositTokens) Reset()         { *m = MsgDepositTokens{} }
@@ -386,6 +397,13 @@ func (m *MsgDepositTokens) GetSender() string {
 	return ""
 }
 
+func (m *MsgDepositTokens) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
 type MsgDepositTokensResponse struct {
 }
 
@@ -422,6 +440,437 @@ func (m *MsgDepositTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositTokensResponse proto.InternalMessageInfo
 
+// MsgCreateCampaign defines an sdk.Msg type that creates an airdrop campaign
+type MsgCreateCampaign struct {
+	Sender      string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Description string    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the end of the claim window, the window has no end if zero.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Denom   string    `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// merkle_root is the hex encoded root of the allocation tree, empty to set
+	// the allocations on-chain with MsgSetAllocation.
+	MerkleRoot string `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// total_amount is the sum of the allocations of the tree of a Merkle campaign.
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	// vesting is the vesting policy of the claims, paid right away if zero.
+	Vesting VestingPolicy `protobuf:"bytes,8,opt,name=vesting,proto3" json:"vesting"`
+	// action_gate unlocks the allocations progressively, paid in full on claim
+	// if it has no action.
+	ActionGate ActionGate `protobuf:"bytes,9,opt,name=action_gate,json=actionGate,proto3" json:"action_gate"`
+}
+
+func (m *MsgCreateCampaign) Reset()         { *m = MsgCreateCampaign{} }
+func (m *MsgCreateCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaign) ProtoMessage()    {}
+func (*MsgCreateCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{9}
+}
+func (m *MsgCreateCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaign.Merge(m, src)
+}
+func (m *MsgCreateCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCampaign proto.InternalMessageInfo
+
+func (m *MsgCreateCampaign) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateCampaign) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateCampaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetVesting() VestingPolicy {
+	if m != nil {
+		return m.Vesting
+	}
+	return VestingPolicy{}
+}
+
+func (m *MsgCreateCampaign) GetActionGate() ActionGate {
+	if m != nil {
+		return m.ActionGate
+	}
+	return ActionGate{}
+}
+
+// MsgCreateCampaignResponse defines the Msg/CreateCampaign response type.
+type MsgCreateCampaignResponse struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *MsgCreateCampaignResponse) Reset()         { *m = MsgCreateCampaignResponse{} }
+func (m *MsgCreateCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaignResponse) ProtoMessage()    {}
+func (*MsgCreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{10}
+}
+func (m *MsgCreateCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaignResponse.Merge(m, src)
+}
+func (m *MsgCreateCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgCreateCampaignResponse) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// MsgClaimWithProof defines an sdk.Msg type that claims the allocation of a
+// leaf of a Merkle campaign
+type MsgClaimWithProof struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// index is the index of the leaf in the allocation tree.
+	Index   uint64                                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Chain   string                                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address string                                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// proof is the list of hex encoded sibling hashes from the leaf to the root.
+	Proof         []string `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+	PubKey        string   `protobuf:"bytes,7,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	RewardAddress string   `protobuf:"bytes,8,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	Signature     string   `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgClaimWithProof) Reset()         { *m = MsgClaimWithProof{} }
+func (m *MsgClaimWithProof) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProof) ProtoMessage()    {}
+func (*MsgClaimWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{11}
+}
+func (m *MsgClaimWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProof.Merge(m, src)
+}
+func (m *MsgClaimWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProof proto.InternalMessageInfo
+
+// MsgClaimWithProofResponse defines the Msg/ClaimWithProof response type.
+type MsgClaimWithProofResponse struct {
+}
+
+func (m *MsgClaimWithProofResponse) Reset()         { *m = MsgClaimWithProofResponse{} }
+func (m *MsgClaimWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProofResponse) ProtoMessage()    {}
+func (*MsgClaimWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{12}
+}
+func (m *MsgClaimWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProofResponse.Merge(m, src)
+}
+func (m *MsgClaimWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProofResponse proto.InternalMessageInfo
+
+// MsgSetClaimDeadline defines an sdk.Msg type that sets the end of the claim
+// window of a campaign, after which its unclaimed funds are swept to the
+// community pool
+type MsgSetClaimDeadline struct {
+	Sender     string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CampaignId uint64    `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Deadline   time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *MsgSetClaimDeadline) Reset()         { *m = MsgSetClaimDeadline{} }
+func (m *MsgSetClaimDeadline) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimDeadline) ProtoMessage()    {}
+func (*MsgSetClaimDeadline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{13}
+}
+func (m *MsgSetClaimDeadline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimDeadline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimDeadline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimDeadline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimDeadline.Merge(m, src)
+}
+func (m *MsgSetClaimDeadline) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimDeadline) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimDeadline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimDeadline proto.InternalMessageInfo
+
+func (m *MsgSetClaimDeadline) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetClaimDeadline) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *MsgSetClaimDeadline) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// MsgSetClaimDeadlineResponse defines the Msg/SetClaimDeadline response type.
+type MsgSetClaimDeadlineResponse struct {
+}
+
+func (m *MsgSetClaimDeadlineResponse) Reset()         { *m = MsgSetClaimDeadlineResponse{} }
+func (m *MsgSetClaimDeadlineResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimDeadlineResponse) ProtoMessage()    {}
+func (*MsgSetClaimDeadlineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{14}
+}
+func (m *MsgSetClaimDeadlineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimDeadlineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimDeadlineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimDeadlineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimDeadlineResponse.Merge(m, src)
+}
+func (m *MsgSetClaimDeadlineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimDeadlineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimDeadlineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimDeadlineResponse proto.InternalMessageInfo
+
+// MsgReleaseVested defines an sdk.Msg type that pays the vested amount of the
+// claims of a reward address
+type MsgReleaseVested struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgReleaseVested) Reset()         { *m = MsgReleaseVested{} }
+func (m *MsgReleaseVested) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseVested) ProtoMessage()    {}
+func (*MsgReleaseVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{15}
+}
+func (m *MsgReleaseVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseVested.Merge(m, src)
+}
+func (m *MsgReleaseVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseVested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseVested proto.InternalMessageInfo
+
+func (m *MsgReleaseVested) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgReleaseVestedResponse defines the Msg/ReleaseVested response type.
+type MsgReleaseVestedResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgReleaseVestedResponse) Reset()         { *m = MsgReleaseVestedResponse{} }
+func (m *MsgReleaseVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseVestedResponse) ProtoMessage()    {}
+func (*MsgReleaseVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fbdab318d176f45, []int{16}
+}
+func (m *MsgReleaseVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseVestedResponse.Merge(m, src)
+}
+func (m *MsgReleaseVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseVestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseVestedResponse proto.InternalMessageInfo
+
+func (m *MsgReleaseVestedResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetAllocation)(nil), "teritori.airdrop.v1beta1.MsgSetAllocation")
 	proto.RegisterType((*MsgSetAllocationResponse)(nil), "teritori.airdrop.v1beta1.MsgSetAllocationResponse")
@@ -432,51 +881,92 @@ func init() {
 	proto.RegisterType((*MsgTransferModuleOwnershipResponse)(nil), "teritori.airdrop.v1beta1.MsgTransferModuleOwnershipResponse")
 	proto.RegisterType((*MsgDepositTokens)(nil), "teritori.airdrop.v1beta1.MsgDepositTokens")
 	proto.RegisterType((*MsgDepositTokensResponse)(nil), "teritori.airdrop.v1beta1.MsgDepositTokensResponse")
+	proto.RegisterType((*MsgCreateCampaign)(nil), "teritori.airdrop.v1beta1.MsgCreateCampaign")
+	proto.RegisterType((*MsgCreateCampaignResponse)(nil), "teritori.airdrop.v1beta1.MsgCreateCampaignResponse")
+	proto.RegisterType((*MsgClaimWithProof)(nil), "teritori.airdrop.v1beta1.MsgClaimWithProof")
+	proto.RegisterType((*MsgClaimWithProofResponse)(nil), "teritori.airdrop.v1beta1.MsgClaimWithProofResponse")
+	proto.RegisterType((*MsgSetClaimDeadline)(nil), "teritori.airdrop.v1beta1.MsgSetClaimDeadline")
+	proto.RegisterType((*MsgSetClaimDeadlineResponse)(nil), "teritori.airdrop.v1beta1.MsgSetClaimDeadlineResponse")
+	proto.RegisterType((*MsgReleaseVested)(nil), "teritori.airdrop.v1beta1.MsgReleaseVested")
+	proto.RegisterType((*MsgReleaseVestedResponse)(nil), "teritori.airdrop.v1beta1.MsgReleaseVestedResponse")
 }
 
 func init() { proto.RegisterFile("teritori/airdrop/v1beta1/tx.proto", fileDescriptor_2fbdab318d176f45) }
 
 var fileDescriptor_2fbdab318d176f45 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6f, 0xd3, 0x3e,
-	0x18, 0x4e, 0xd6, 0xa9, 0x5b, 0xbd, 0xdf, 0x7e, 0xa0, 0x08, 0xb1, 0x10, 0xa6, 0x64, 0x44, 0x20,
-	0xc6, 0xc7, 0x12, 0xad, 0x70, 0x42, 0x5c, 0xd6, 0x0d, 0xa1, 0x09, 0xaa, 0x69, 0xa1, 0x27, 0x2e,
-	0x93, 0xd3, 0x78, 0xa9, 0xd5, 0xd6, 0x8e, 0x6c, 0x87, 0xae, 0x07, 0xee, 0x5c, 0x90, 0x38, 0x71,
-	0xde, 0x9f, 0xb3, 0xe3, 0x8e, 0x88, 0x43, 0x85, 0xda, 0x0b, 0xda, 0x81, 0xbf, 0x01, 0xd5, 0x75,
-	0xfa, 0xb1, 0x91, 0xf2, 0x71, 0xa9, 0xfb, 0xda, 0xcf, 0xfb, 0x3e, 0x8f, 0xdf, 0xf7, 0x89, 0xc1,
-	0x1d, 0x81, 0x18, 0x16, 0x94, 0x61, 0x1f, 0x62, 0x16, 0x31, 0x9a, 0xf8, 0xef, 0xb6, 0x43, 0x24,
-	0xe0, 0xb6, 0x2f, 0x4e, 0xbc, 0x84, 0x51, 0x41, 0x0d, 0x33, 0x83, 0x78, 0x0a, 0xe2, 0x29, 0x88,
-	0x75, 0x23, 0xa6, 0x31, 0x95, 0x20, 0x7f, 0xf8, 0x6f, 0x84, 0xb7, 0x6e, 0xc5, 0x94, 0xc6, 0x2d,
-	0xe4, 0xcb, 0x28, 0x4c, 0x8f, 0x7d, 0x48, 0xba, 0xea, 0xe8, 0x41, 0x2e, 0x1b, 0x6c, 0xb5, 0x68,
-	0x1d, 0x0a, 0x4c, 0xc9, 0x08, 0xea, 0xbe, 0x07, 0xd7, 0xab, 0x3c, 0x7e, 0x83, 0xc4, 0xce, 0xf8,
-	0xc4, 0xb8, 0x09, 0x8a, 0x1c, 0x91, 0x08, 0x31, 0x53, 0xdf, 0xd0, 0x37, 0x4b, 0x81, 0x8a, 0x8c,
-	0x43, 0x00, 0x26, 0xf9, 0xe6, 0xc2, 0x86, 0xbe, 0xb9, 0x52, 0x7e, 0xe4, 0xe5, 0xc9, 0xf6, 0x76,
-	0x46, 0xf1, 0xa4, 0x70, 0x65, 0xf1, 0xac, 0xe7, 0x68, 0xc1, 0x54, 0x11, 0xd7, 0x02, 0xe6, 0x65,
-	0xfa, 0x00, 0xf1, 0x84, 0x12, 0x8e, 0xdc, 0xcf, 0x3a, 0x30, 0xaa, 0x3c, 0xde, 0x6d, 0x41, 0xdc,
-	0x9e, 0x52, 0x67, 0x82, 0x25, 0x18, 0x45, 0x0c, 0x71, 0xae, 0xe4, 0x65, 0xa1, 0xb1, 0x06, 0x96,
-	0x92, 0x34, 0x3c, 0x6a, 0xa2, 0xae, 0x14, 0x57, 0x0a, 0x8a, 0x49, 0x1a, 0xbe, 0x42, 0x5d, 0xe3,
-	0x1e, 0xf8, 0x9f, 0xa1, 0x0e, 0x64, 0xd1, 0x51, 0x96, 0x59, 0x90, 0xe7, 0xab, 0xa3, 0xdd, 0x1d,
-	0x95, 0xbf, 0x0e, 0x4a, 0x1c, 0xc7, 0x04, 0x8a, 0x94, 0x21, 0x73, 0x51, 0x22, 0x26, 0x1b, 0xcf,
-	0x96, 0x3f, 0x9c, 0x3a, 0xda, 0xf7, 0x53, 0x47, 0x73, 0xd7, 0x81, 0x75, 0x55, 0xd7, 0x58, 0xf6,
-	0x01, 0x58, 0x19, 0x5e, 0x09, 0xc7, 0x64, 0x0f, 0x0a, 0x68, 0xb8, 0xa0, 0x38, 0xac, 0x91, 0x35,
-	0xb3, 0x02, 0x2e, 0x7a, 0x8e, 0xda, 0x09, 0xd4, 0x6a, 0xac, 0x83, 0xc5, 0x08, 0x0a, 0x28, 0x55,
-	0xff, 0x57, 0x59, 0xbe, 0xe8, 0x39, 0x32, 0x0e, 0xe4, 0xaf, 0x7b, 0x28, 0xe9, 0x6a, 0x0c, 0x12,
-	0x7e, 0x8c, 0x58, 0x95, 0x46, 0x69, 0x0b, 0x1d, 0x74, 0x08, 0x62, 0xbc, 0x81, 0x93, 0xdc, 0x61,
-	0xdd, 0x06, 0x25, 0x82, 0x3a, 0x47, 0x74, 0x08, 0x54, 0xed, 0x58, 0x26, 0xa8, 0x23, 0x13, 0xdd,
-	0xbb, 0xc0, 0xcd, 0x2f, 0x39, 0xbe, 0x09, 0x97, 0xde, 0xd8, 0x43, 0x09, 0xe5, 0x58, 0xd4, 0x68,
-	0x13, 0x11, 0x9e, 0x4b, 0xf7, 0x12, 0x14, 0x61, 0x9b, 0xa6, 0x44, 0x98, 0x0b, 0x1b, 0x85, 0xcd,
-	0x52, 0xc5, 0x1f, 0x8e, 0xfa, 0x6b, 0xcf, 0xb9, 0x1f, 0x63, 0xd1, 0x48, 0x43, 0xaf, 0x4e, 0xdb,
-	0x7e, 0x9d, 0xf2, 0x36, 0xe5, 0x6a, 0xd9, 0xe2, 0x51, 0xd3, 0x17, 0xdd, 0x04, 0x71, 0x6f, 0x97,
-	0x62, 0x12, 0xa8, 0x74, 0xe5, 0x88, 0x19, 0xd2, 0x4c, 0x50, 0xf9, 0x47, 0x01, 0x14, 0xaa, 0x3c,
-	0x36, 0x52, 0x70, 0xed, 0xb2, 0x2b, 0x1e, 0xe7, 0xfb, 0xf0, 0xea, 0xac, 0xac, 0xa7, 0x7f, 0x83,
-	0xce, 0xe8, 0x0d, 0x0a, 0x56, 0x67, 0x3f, 0x94, 0x87, 0x73, 0xcb, 0xcc, 0x60, 0xad, 0xf2, 0x9f,
-	0x63, 0xc7, 0x84, 0x1f, 0x75, 0xb0, 0x96, 0x37, 0xf7, 0xf9, 0x57, 0xc8, 0xc9, 0xb2, 0x9e, 0xff,
-	0x4b, 0xd6, 0x74, 0x03, 0x66, 0xdd, 0x30, 0xbf, 0x01, 0x33, 0xd8, 0xdf, 0x34, 0xe0, 0x97, 0x03,
-	0xaf, 0xbc, 0x3e, 0xeb, 0xdb, 0xfa, 0x79, 0xdf, 0xd6, 0xbf, 0xf5, 0x6d, 0xfd, 0xd3, 0xc0, 0xd6,
-	0xce, 0x07, 0xb6, 0xf6, 0x65, 0x60, 0x6b, 0x6f, 0xcb, 0x53, 0xbe, 0xaa, 0xbd, 0x08, 0xf6, 0x6b,
-	0x07, 0xc1, 0xbe, 0x9f, 0x11, 0x6c, 0xd5, 0x1b, 0x10, 0x13, 0xff, 0x64, 0xfc, 0xfc, 0x49, 0x9f,
-	0x85, 0x45, 0xf9, 0xe4, 0x3d, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0x52, 0x43, 0x70, 0x4f, 0x8d,
-	0x05, 0x00, 0x00,
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x8e, 0xff, 0x3c, 0xb7, 0xa5, 0x5d, 0x22, 0xba, 0xd9, 0x16, 0xdb, 0xac, 0x0a,
+	0x31, 0xa4, 0xd9, 0xa5, 0x0e, 0x5c, 0x50, 0x25, 0x88, 0x13, 0x88, 0xa2, 0x12, 0xa5, 0x59, 0x22,
+	0x90, 0xb8, 0x58, 0x63, 0xef, 0x64, 0x33, 0x8a, 0xbd, 0xb3, 0xda, 0x19, 0x37, 0xc9, 0x01, 0x71,
+	0xe5, 0x82, 0x94, 0x03, 0x1f, 0xa0, 0x67, 0x3e, 0x05, 0xdc, 0x7a, 0x41, 0xea, 0x11, 0x71, 0x48,
+	0x51, 0x72, 0x41, 0xbd, 0xf2, 0x05, 0xd0, 0xce, 0xee, 0x4e, 0xbc, 0x36, 0xeb, 0xc4, 0xbd, 0xc4,
+	0x99, 0x99, 0xdf, 0xfb, 0x33, 0xef, 0xf7, 0xde, 0x6f, 0x6c, 0x78, 0x8f, 0xe3, 0x80, 0x70, 0x1a,
+	0x10, 0x0b, 0x91, 0xc0, 0x09, 0xa8, 0x6f, 0x3d, 0x7b, 0xd4, 0xc5, 0x1c, 0x3d, 0xb2, 0xf8, 0xb1,
+	0xe9, 0x07, 0x94, 0x53, 0x55, 0x4b, 0x20, 0x66, 0x0c, 0x31, 0x63, 0x88, 0xbe, 0xe0, 0x52, 0x97,
+	0x0a, 0x90, 0x15, 0xfe, 0x17, 0xe1, 0xf5, 0x45, 0x97, 0x52, 0xb7, 0x8f, 0x2d, 0xb1, 0xea, 0x0e,
+	0xf7, 0x2d, 0xe4, 0x9d, 0xc4, 0x47, 0xf5, 0xf1, 0x23, 0x4e, 0x06, 0x98, 0x71, 0x34, 0xf0, 0x63,
+	0x40, 0xad, 0x47, 0xd9, 0x80, 0x32, 0xab, 0x8b, 0x18, 0x96, 0x99, 0xf4, 0x28, 0xf1, 0xe2, 0xf3,
+	0x0f, 0x33, 0xd3, 0x45, 0xfd, 0x3e, 0xed, 0x21, 0x4e, 0x68, 0x02, 0x5d, 0xca, 0x84, 0xf6, 0xd0,
+	0xc0, 0x47, 0xc4, 0x8d, 0x81, 0xc6, 0x0f, 0x70, 0x7b, 0x9b, 0xb9, 0xdf, 0x60, 0xbe, 0x26, 0x5d,
+	0xa8, 0xef, 0x40, 0x91, 0x61, 0xcf, 0xc1, 0x81, 0xa6, 0x34, 0x94, 0x66, 0xc5, 0x8e, 0x57, 0xea,
+	0x2e, 0xc0, 0x65, 0x20, 0x6d, 0xae, 0xa1, 0x34, 0xab, 0xad, 0x65, 0x33, 0xab, 0x40, 0xe6, 0x5a,
+	0xb4, 0xbe, 0x74, 0xdc, 0x2e, 0xbc, 0x38, 0xab, 0xe7, 0xec, 0x11, 0x27, 0x86, 0x0e, 0xda, 0x78,
+	0x78, 0x1b, 0x33, 0x9f, 0x7a, 0x0c, 0x1b, 0x7f, 0x28, 0xa0, 0x6e, 0x33, 0x77, 0xbd, 0x8f, 0xc8,
+	0x60, 0x24, 0x3b, 0x0d, 0x4a, 0xc8, 0x71, 0x02, 0xcc, 0x58, 0x9c, 0x5e, 0xb2, 0x54, 0xef, 0x42,
+	0xc9, 0x1f, 0x76, 0x3b, 0x87, 0xf8, 0x44, 0x24, 0x57, 0xb1, 0x8b, 0xfe, 0xb0, 0xfb, 0x04, 0x9f,
+	0xa8, 0xef, 0xc3, 0xad, 0x00, 0x1f, 0xa1, 0xc0, 0xe9, 0x24, 0x96, 0x79, 0x71, 0x7e, 0x33, 0xda,
+	0x5d, 0x8b, 0xed, 0xef, 0x43, 0x85, 0x11, 0xd7, 0x43, 0x7c, 0x18, 0x60, 0xad, 0x20, 0x10, 0x97,
+	0x1b, 0x6a, 0x1d, 0xaa, 0x49, 0xed, 0x3a, 0xc4, 0xd1, 0xe6, 0x1b, 0x4a, 0xb3, 0x60, 0x43, 0xb2,
+	0xb5, 0xe5, 0xa8, 0x0b, 0x30, 0xdf, 0x3b, 0x40, 0xc4, 0xd3, 0x8a, 0xc2, 0x34, 0x5a, 0x7c, 0x56,
+	0xfe, 0xe9, 0x79, 0x3d, 0xf7, 0xcf, 0xf3, 0x7a, 0xce, 0xb8, 0x0f, 0xfa, 0xe4, 0x75, 0xe4, 0x6d,
+	0x77, 0xa0, 0x1a, 0x56, 0x82, 0xb8, 0xde, 0x06, 0xe2, 0x48, 0x35, 0xa0, 0x18, 0x86, 0x4e, 0x38,
+	0x68, 0xc3, 0xeb, 0xb3, 0x7a, 0xbc, 0x63, 0xc7, 0x9f, 0xea, 0x7d, 0x28, 0x38, 0x88, 0x23, 0x71,
+	0xd9, 0x1b, 0xed, 0xf2, 0xeb, 0xb3, 0xba, 0x58, 0xdb, 0xe2, 0xaf, 0xb1, 0x2b, 0xc2, 0xed, 0x05,
+	0xc8, 0x63, 0xfb, 0x38, 0xd8, 0xa6, 0xce, 0xb0, 0x8f, 0x77, 0x8e, 0x3c, 0x1c, 0xb0, 0x03, 0xe2,
+	0x67, 0x72, 0x7c, 0x0f, 0x2a, 0x1e, 0x3e, 0xea, 0xd0, 0x10, 0x18, 0x57, 0xb1, 0xec, 0xe1, 0x23,
+	0x61, 0x68, 0x3c, 0x00, 0x23, 0xdb, 0xa5, 0xbc, 0xc9, 0x2f, 0x8a, 0xe8, 0xa9, 0x0d, 0xec, 0x53,
+	0x46, 0xf8, 0x1e, 0x3d, 0xc4, 0x1e, 0xcb, 0x8c, 0xb7, 0x09, 0x45, 0x34, 0xa0, 0x43, 0x8f, 0x6b,
+	0x73, 0x8d, 0x7c, 0xb3, 0xd2, 0xb6, 0xc2, 0x16, 0xf9, 0xeb, 0xac, 0xbe, 0xe4, 0x12, 0x7e, 0x30,
+	0xec, 0x9a, 0x3d, 0x3a, 0xb0, 0xe2, 0xb1, 0x88, 0x3e, 0x56, 0x98, 0x73, 0x68, 0xf1, 0x13, 0x1f,
+	0x33, 0x73, 0x9d, 0x12, 0xcf, 0x8e, 0xcd, 0xc7, 0xe9, 0xc9, 0x8f, 0xd3, 0x13, 0xb7, 0x5a, 0x2a,
+	0x2b, 0x99, 0xf2, 0xbf, 0x79, 0xb8, 0x13, 0x72, 0x13, 0x60, 0xc4, 0xf1, 0x7a, 0x6c, 0x93, 0x99,
+	0x73, 0x03, 0xaa, 0x0e, 0x66, 0xbd, 0x80, 0xf8, 0x72, 0x10, 0x2a, 0xf6, 0xe8, 0x96, 0xba, 0x0e,
+	0xc0, 0x38, 0x0a, 0x78, 0x27, 0x1c, 0x71, 0x91, 0x4b, 0xb5, 0xa5, 0x9b, 0xd1, 0xfc, 0x9b, 0xc9,
+	0xfc, 0x9b, 0x7b, 0xc9, 0xfc, 0xb7, 0xcb, 0xe1, 0xad, 0x4f, 0x5f, 0xd5, 0x15, 0xbb, 0x22, 0xec,
+	0xc2, 0x13, 0xf5, 0x73, 0x28, 0x63, 0xcf, 0x89, 0x5c, 0x14, 0x66, 0x70, 0x51, 0xc2, 0x9e, 0x23,
+	0x1c, 0x2c, 0xc0, 0xbc, 0x83, 0x3d, 0x3a, 0x10, 0xbd, 0x5a, 0xb1, 0xa3, 0x45, 0x58, 0xa8, 0x01,
+	0x0e, 0x0e, 0xfb, 0xb8, 0x13, 0x50, 0xca, 0xe3, 0x66, 0x85, 0x68, 0xcb, 0xa6, 0x94, 0xab, 0xbb,
+	0x70, 0x83, 0x53, 0x8e, 0xfa, 0x9d, 0x98, 0x98, 0x92, 0x68, 0x40, 0x33, 0x26, 0xe6, 0x83, 0x6b,
+	0x10, 0xb3, 0xe5, 0x71, 0xbb, 0x2a, 0x7c, 0xac, 0x45, 0xe4, 0x6c, 0x42, 0xe9, 0x19, 0x66, 0x9c,
+	0x78, 0xae, 0x56, 0x16, 0x37, 0x59, 0xca, 0x96, 0x8d, 0x6f, 0x23, 0xe0, 0x53, 0xda, 0x27, 0xbd,
+	0x93, 0x58, 0x32, 0x12, 0x6b, 0xf5, 0x09, 0x54, 0x51, 0x2f, 0x2c, 0x71, 0xc7, 0x45, 0x1c, 0x6b,
+	0x15, 0xe1, 0xec, 0xc1, 0x14, 0x0d, 0x12, 0xe0, 0x4d, 0xc4, 0xb1, 0x14, 0x1f, 0xb9, 0x63, 0x3c,
+	0x86, 0xc5, 0x09, 0xd2, 0x93, 0x96, 0x18, 0xef, 0x27, 0x65, 0xa2, 0x9f, 0x7e, 0x9b, 0x83, 0x3b,
+	0xc9, 0x3c, 0x7f, 0x47, 0xf8, 0xc1, 0xd3, 0x80, 0xd2, 0xfd, 0x2b, 0xcd, 0x42, 0x52, 0x88, 0xe7,
+	0xe0, 0x63, 0xd1, 0x36, 0x05, 0x3b, 0x5a, 0x5c, 0x6a, 0x47, 0x7e, 0x44, 0x3b, 0x46, 0xa5, 0xae,
+	0x90, 0x96, 0xba, 0xaf, 0xe4, 0xd8, 0xcc, 0xbf, 0x11, 0x3b, 0xc9, 0xd4, 0x2c, 0xc0, 0xbc, 0x1f,
+	0xe6, 0xad, 0x15, 0xc3, 0xe9, 0xb3, 0xa3, 0xc5, 0xa8, 0x90, 0x96, 0xae, 0x10, 0xd2, 0xf2, 0x95,
+	0x42, 0x5a, 0x19, 0x13, 0xd2, 0x11, 0x45, 0xbc, 0x07, 0x8b, 0x13, 0x15, 0x94, 0x33, 0x79, 0xaa,
+	0xc0, 0xdb, 0xd1, 0xdb, 0x20, 0x00, 0x1b, 0x18, 0x39, 0x7d, 0xe2, 0xe1, 0xcc, 0xa9, 0x1c, 0xab,
+	0xfc, 0xdc, 0x44, 0xe5, 0xbf, 0x80, 0xb2, 0x13, 0x3b, 0x99, 0x69, 0x24, 0xa5, 0x95, 0xf1, 0x2e,
+	0xdc, 0xfb, 0x9f, 0x8c, 0x64, 0xc6, 0x0f, 0x85, 0xee, 0xd9, 0xb8, 0x8f, 0x11, 0xc3, 0x61, 0x1b,
+	0x63, 0x27, 0xfb, 0xb5, 0x32, 0x7e, 0x04, 0x6d, 0x1c, 0x2d, 0x9b, 0xaf, 0x27, 0xe9, 0x55, 0x1a,
+	0xf9, 0x66, 0xb5, 0xb5, 0x68, 0x46, 0x2c, 0x9a, 0xe1, 0x57, 0x03, 0xd9, 0xdc, 0xa1, 0xfe, 0xb5,
+	0x3f, 0x0e, 0xf3, 0xfc, 0xf5, 0x55, 0xbd, 0x79, 0x4d, 0xc1, 0x64, 0x09, 0xf7, 0xad, 0xdf, 0x4b,
+	0x90, 0xdf, 0x66, 0xae, 0x3a, 0x84, 0xb7, 0xc6, 0xdf, 0xd8, 0x87, 0xd9, 0x13, 0x35, 0xf9, 0x84,
+	0xe9, 0x9f, 0xcc, 0x82, 0x96, 0x77, 0xa4, 0x70, 0x33, 0xfd, 0xb5, 0xe3, 0xa3, 0xa9, 0x6e, 0x52,
+	0x58, 0xbd, 0x75, 0x7d, 0xac, 0x0c, 0xf8, 0xb3, 0x02, 0x77, 0xb3, 0x9e, 0xc3, 0xe9, 0x57, 0xc8,
+	0xb0, 0xd2, 0x1f, 0xbf, 0x89, 0xd5, 0x68, 0x01, 0xd2, 0x6f, 0xe4, 0xf4, 0x02, 0xa4, 0xb0, 0x7a,
+	0xeb, 0xfa, 0x58, 0x19, 0x30, 0x80, 0x5b, 0x63, 0x2f, 0xdc, 0xf2, 0x74, 0xe6, 0x52, 0x60, 0x7d,
+	0x75, 0x06, 0x70, 0x2a, 0x66, 0x5a, 0x21, 0x97, 0xaf, 0xee, 0x16, 0x09, 0xd6, 0x57, 0x67, 0x00,
+	0xcb, 0x98, 0xc7, 0x70, 0x7b, 0x42, 0x35, 0x56, 0xae, 0x6a, 0x98, 0x14, 0x5c, 0xff, 0x74, 0x26,
+	0xf8, 0x28, 0xa5, 0xe9, 0xf1, 0x9f, 0x4e, 0x69, 0x0a, 0xab, 0xb7, 0xae, 0x8f, 0x4d, 0x02, 0xb6,
+	0xbf, 0x7e, 0x71, 0x5e, 0x53, 0x5e, 0x9e, 0xd7, 0x94, 0xbf, 0xcf, 0x6b, 0xca, 0xe9, 0x45, 0x2d,
+	0xf7, 0xf2, 0xa2, 0x96, 0xfb, 0xf3, 0xa2, 0x96, 0xfb, 0xbe, 0x35, 0xa2, 0x07, 0x7b, 0x5f, 0xda,
+	0x5b, 0x7b, 0x3b, 0xf6, 0x96, 0x95, 0x04, 0x58, 0x11, 0xef, 0x8b, 0x75, 0x2c, 0x7f, 0x1d, 0x08,
+	0x7d, 0xe8, 0x16, 0x85, 0x0e, 0xae, 0xfe, 0x37, 0x00, 0x73, 0x42, 0xaf, 0x84, 0x18, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -499,6 +989,14 @@ type MsgClient interface {
 	TransferModuleOwnership(ctx context.Context, in *MsgTransferModuleOwnership, opts ...grpc.CallOption) (*MsgTransferModuleOwnershipResponse, error)
 	// DepositTokens defines a method to deposit tokens to the module
 	DepositTokens(ctx context.Context, in *MsgDepositTokens, opts ...grpc.CallOption) (*MsgDepositTokensResponse, error)
+	// CreateCampaign defines a method to create an airdrop campaign
+	CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error)
+	// ClaimWithProof defines a method to claim an allocation of a Merkle campaign
+	ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error)
+	// SetClaimDeadline defines a method to set the claim deadline of a campaign
+	SetClaimDeadline(ctx context.Context, in *MsgSetClaimDeadline, opts ...grpc.CallOption) (*MsgSetClaimDeadlineResponse, error)
+	// ReleaseVested defines a method to release the vested claims of an address
+	ReleaseVested(ctx context.Context, in *MsgReleaseVested, opts ...grpc.CallOption) (*MsgReleaseVestedResponse, error)
 }
 
 type msgClient struct {
@@ -545,23 +1043,67 @@ func (c *msgClient) DepositTokens(ctx context.Context, in *MsgDepositTokens, opt
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ClaimAllocation defines a method to claim allocation
-	ClaimAllocation(context.Context, *MsgClaimAllocation) (*MsgClaimAllocationResponse, error)
-	// SetAllocation defines a method to set allocation
-	SetAllocation(context.Context, *MsgSetAllocation) (*MsgSetAllocationResponse, error)
-	// TransferModuleOwnership defines a method to transfer module ownership to other address
-	TransferModuleOwnership(context.Context, *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error)
-	// DepositTokens defines a method to deposit tokens to the module
-	DepositTokens(context.Context, *MsgDepositTokens) (*MsgDepositTokensResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (c *msgClient) CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error) {
+	out := new(MsgCreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/teritori.airdrop.v1beta1.Msg/CreateCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedMsgServer) ClaimAllocation(ctx context.Context, req *MsgClaimAllocation) (*MsgClaimAllocationResponse, error) {
+func (c *msgClient) ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error) {
+	out := new(MsgClaimWithProofResponse)
+	err := c.cc.Invoke(ctx, "/teritori.airdrop.v1beta1.Msg/ClaimWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClaimDeadline(ctx context.Context, in *MsgSetClaimDeadline, opts ...grpc.CallOption) (*MsgSetClaimDeadlineResponse, error) {
+	out := new(MsgSetClaimDeadlineResponse)
+	err := c.cc.Invoke(ctx, "/teritori.airdrop.v1beta1.Msg/SetClaimDeadline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseVested(ctx context.Context, in *MsgReleaseVested, opts ...grpc.CallOption) (*MsgReleaseVestedResponse, error) {
+	out := new(MsgReleaseVestedResponse)
+	err := c.cc.Invoke(ctx, "/teritori.airdrop.v1beta1.Msg/ReleaseVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ClaimAllocation defines a method to claim allocation
+	ClaimAllocation(context.Context, *MsgClaimAllocation) (*MsgClaimAllocationResponse, error)
+	// SetAllocation defines a method to set allocation
+	SetAllocation(context.Context, *MsgSetAllocation) (*MsgSetAllocationResponse, error)
+	// TransferModuleOwnership defines a method to transfer module ownership to other address
+	TransferModuleOwnership(context.Context, *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error)
+	// DepositTokens defines a method to deposit tokens to the module
+	DepositTokens(context.Context, *MsgDepositTokens) (*MsgDepositTokensResponse, error)
+	// CreateCampaign defines a method to create an airdrop campaign
+	CreateCampaign(context.Context, *MsgCreateCampaign) (*MsgCreateCampaignResponse, error)
+	// ClaimWithProof defines a method to claim an allocation of a Merkle campaign
+	ClaimWithProof(context.Context, *MsgClaimWithProof) (*MsgClaimWithProofResponse, error)
+	// SetClaimDeadline defines a method to set the claim deadline of a campaign
+	SetClaimDeadline(context.Context, *MsgSetClaimDeadline) (*MsgSetClaimDeadlineResponse, error)
+	// ReleaseVested defines a method to release the vested claims of an address
+	ReleaseVested(context.Context, *MsgReleaseVested) (*MsgReleaseVestedResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ClaimAllocation(ctx context.Context, req *MsgClaimAllocation) (*MsgClaimAllocationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllocation not implemented")
 }
 func (*UnimplementedMsgServer) SetAllocation(ctx context.Context, req *MsgSetAllocation) (*MsgSetAllocationResponse, error) {
@@ -573,6 +1115,18 @@ func (*UnimplementedMsgServer) TransferModuleOwnership(ctx context.Context, req
 func (*UnimplementedMsgServer) DepositTokens(ctx context.Context, req *MsgDepositTokens) (*MsgDepositTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositTokens not implemented")
 }
+func (*UnimplementedMsgServer) CreateCampaign(ctx context.Context, req *MsgCreateCampaign) (*MsgCreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (*UnimplementedMsgServer) ClaimWithProof(ctx context.Context, req *MsgClaimWithProof) (*MsgClaimWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimWithProof not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimDeadline(ctx context.Context, req *MsgSetClaimDeadline) (*MsgSetClaimDeadlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimDeadline not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseVested(ctx context.Context, req *MsgReleaseVested) (*MsgReleaseVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseVested not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -650,6 +1204,78 @@ func _Msg_DepositTokens_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.airdrop.v1beta1.Msg/CreateCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCampaign(ctx, req.(*MsgCreateCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimWithProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.airdrop.v1beta1.Msg/ClaimWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimWithProof(ctx, req.(*MsgClaimWithProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimDeadline)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimDeadline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.airdrop.v1beta1.Msg/SetClaimDeadline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimDeadline(ctx, req.(*MsgSetClaimDeadline))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.airdrop.v1beta1.Msg/ReleaseVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseVested(ctx, req.(*MsgReleaseVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "teritori.airdrop.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -670,6 +1296,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositTokens",
 			Handler:    _Msg_DepositTokens_Handler,
 		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _Msg_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "ClaimWithProof",
+			Handler:    _Msg_ClaimWithProof_Handler,
+		},
+		{
+			MethodName: "SetClaimDeadline",
+			Handler:    _Msg_SetClaimDeadline_Handler,
+		},
+		{
+			MethodName: "ReleaseVested",
+			Handler:    _Msg_ReleaseVested_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "teritori/airdrop/v1beta1/tx.proto",
@@ -758,6 +1400,18 @@ func (m *MsgClaimAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -929,6 +1583,11 @@ func (m *MsgDepositTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -976,184 +1635,1821 @@ func (m *MsgDepositTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSetAllocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Allocation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimAllocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ActionGate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *MsgClaimAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSignData) Size() (n int) {
-	if m == nil {
-		return 0
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferModuleOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTransferModuleOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimDeadline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimDeadline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimDeadline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimDeadlineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimDeadlineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimDeadlineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositTokensResponse) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAllocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgClaimAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgClaimAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferModuleOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferModuleOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgDepositTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActionGate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgClaimWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetClaimDeadline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetClaimDeadlineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1181,11 +3477,13 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1212,7 +3510,40 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionGate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionGate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1237,7 +3568,7 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1260,12 +3591,31 @@ func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1275,50 +3625,186 @@ func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,9 +3832,9 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
@@ -1380,7 +3866,7 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
 			}
@@ -1412,7 +3898,7 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 			}
 			m.RewardAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
@@ -1465,7 +3951,7 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimWithProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,10 +3974,10 @@ func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1515,7 +4001,7 @@ func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+func (m *MsgSetClaimDeadline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,15 +4024,15 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetClaimDeadline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetClaimDeadline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1574,13 +4060,13 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
 			}
-			var byteLen int
+			m.CampaignId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1590,81 +4076,16 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CampaignId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnership: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1674,55 +4095,24 @@ func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1745,7 +4135,7 @@ func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetClaimDeadlineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,10 +4158,10 @@ func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetClaimDeadlineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetClaimDeadlineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1795,7 +4185,7 @@ func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseVested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1818,47 +4208,15 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseVested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseVested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1886,11 +4244,7 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Coin
-			m.Amount = append(m.Amount, v)
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1913,7 +4267,7 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseVestedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,12 +4290,46 @@ func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseVestedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MaxVestingPeriods is the maximum number of periods of a vesting policy.
+const MaxVestingPeriods = 1000
+
+// VestingEscrowAddress returns the address of the account holding the vesting
+// claims of all campaigns until they are released, derived from the airdrop
+// module account.
+func VestingEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("vesting"))
+}
+
+// IsZero returns whether the claims are paid right away.
+func (p VestingPolicy) IsZero() bool {
+	return p.Cliff == 0 && p.Duration == 0
+}
+
+// Validate performs a basic validation of the vesting policy.
+func (p VestingPolicy) Validate() error {
+	if p.Cliff < 0 || p.Duration < 0 {
+		return fmt.Errorf("vesting cliff %s and duration %s must not be negative", p.Cliff, p.Duration)
+	}
+	if p.Periods > MaxVestingPeriods {
+		return fmt.Errorf("vesting periods %d exceed %d", p.Periods, MaxVestingPeriods)
+	}
+	if p.Periods > 0 && p.Duration < time.Duration(p.Periods) {
+		return fmt.Errorf("vesting duration %s is shorter than %d periods", p.Duration, p.Periods)
+	}
+	return nil
+}
+
+// VestedAmount returns the part of amount claimed at start that is vested at
+// the given time.
+func (p VestingPolicy) VestedAmount(amount math.Int, start, t time.Time) math.Int {
+	vestingStart := start.Add(p.Cliff)
+	if t.Before(vestingStart) {
+		return math.ZeroInt()
+	}
+	elapsed := t.Sub(vestingStart)
+	if elapsed >= p.Duration {
+		return amount
+	}
+	if p.Periods == 0 {
+		return amount.MulRaw(int64(elapsed)).QuoRaw(int64(p.Duration))
+	}
+	periods := math.NewInt(int64(elapsed)).MulRaw(int64(p.Periods)).QuoRaw(int64(p.Duration))
+	return amount.Mul(periods).QuoRaw(int64(p.Periods))
+}
+
+// Validate performs a basic validation of the vesting claim.
+func (c VestingClaim) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return fmt.Errorf("vesting claim of campaign %d: %w", c.CampaignId, err)
+	}
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("vesting claim of %s: %w", c.Address, err)
+	}
+	if c.TotalAmount.IsNil() || !c.TotalAmount.IsPositive() {
+		return fmt.Errorf("vesting claim of %s: invalid total amount %s", c.Address, c.TotalAmount)
+	}
+	if c.ReleasedAmount.IsNil() || c.ReleasedAmount.IsNegative() || c.ReleasedAmount.GT(c.TotalAmount) {
+		return fmt.Errorf("vesting claim of %s: invalid released amount %s", c.Address, c.ReleasedAmount)
+	}
+	return nil
+}
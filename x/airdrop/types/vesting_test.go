@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestedAmount(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	month := 30 * 24 * time.Hour
+	amount := sdk.NewInt(1200)
+
+	for _, tc := range []struct {
+		name    string
+		policy  VestingPolicy
+		elapsed time.Duration
+		vested  int64
+	}{
+		{"no vesting", VestingPolicy{}, 0, 1200},
+		{"before cliff", VestingPolicy{Cliff: month, Duration: 12 * month}, month - 1, 0},
+		{"at cliff", VestingPolicy{Cliff: month, Duration: 12 * month}, month, 0},
+		{"linear", VestingPolicy{Cliff: month, Duration: 12 * month}, 4 * month, 300},
+		{"linear end", VestingPolicy{Cliff: month, Duration: 12 * month}, 13 * month, 1200},
+		{"cliff only", VestingPolicy{Cliff: month}, month, 1200},
+		{"within first period", VestingPolicy{Duration: 12 * month, Periods: 4}, 3*month - 1, 0},
+		{"first period", VestingPolicy{Duration: 12 * month, Periods: 4}, 3 * month, 300},
+		{"within third period", VestingPolicy{Duration: 12 * month, Periods: 4}, 8 * month, 600},
+		{"after end", VestingPolicy{Duration: 12 * month, Periods: 4}, 24 * month, 1200},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.policy.Validate())
+			vested := tc.policy.VestedAmount(amount, start, start.Add(tc.elapsed))
+			require.Equal(t, sdk.NewInt(tc.vested), vested)
+		})
+	}
+
+	require.Error(t, VestingPolicy{Cliff: -month}.Validate())
+	require.Error(t, VestingPolicy{Duration: month, Periods: MaxVestingPeriods + 1}.Validate())
+}
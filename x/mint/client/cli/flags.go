@@ -0,0 +1,32 @@
+package cli
+
+const (
+	// The first reduction period of the emission schedule
+	FlagFromPeriod = "from-period"
+	// The number of reduction periods of the emission schedule
+	FlagCount = "count"
+)
+
+const (
+	// The lowest height of the inflation snapshots
+	FlagFromHeight = "from-height"
+	// The highest height of the inflation snapshots
+	FlagToHeight = "to-height"
+)
+
+const (
+	// Add the trailing average of the transaction fees to the minted rewards
+	FlagIncludeFees = "include-fees"
+)
+
+const (
+	// The exponent converting the vesting schedule amounts to base units
+	FlagExponent = "exponent"
+	// Report the vesting schedule changes without writing the genesis file
+	FlagDryRun = "dry-run"
+)
+
+const (
+	// Delay the reduction schedule and the vesting month by the pause on resume
+	FlagExtendSchedule = "extend-schedule"
+)
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -30,6 +31,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryBlockProvisions(),
 		GetCmdQueryInflation(),
 		GetCmdQueryStakingAPR(),
+		GetCmdQueryValidatorAPR(),
+		GetCmdQueryTotalBurnt(),
+		GetCmdQueryBurntByAddress(),
+		GetCmdQueryBurnHistory(),
+		GetCmdQueryFeeBurnStats(),
+		GetCmdQueryTotalSupply(),
+		GetCmdQueryCirculatingSupply(),
+		GetCmdQueryBurntSupply(),
+		GetCmdQueryEmissionSchedule(),
+		GetCmdQueryDistributionTotals(),
+		GetCmdQueryPendingDistribution(),
+		GetCmdQueryVestingRewards(),
+		GetCmdQueryAllVestingRewards(),
+		GetCmdQueryVestingReceiverRotations(),
+		GetCmdQueryInflationHistory(),
+		GetCmdQueryLastMintFault(),
+		GetCmdQueryMintingPause(),
 		GetConsensusParamsCmd(),
 	)
 
@@ -134,7 +152,12 @@ func GetCmdQueryStakingAPR() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryStakingAPRRequest{}
+			includeFees, err := cmd.Flags().GetBool(FlagIncludeFees)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryStakingAPRRequest{IncludeFees: includeFees}
 			res, err := queryClient.StakingAPR(context.Background(), params)
 			if err != nil {
 				return err
@@ -144,7 +167,570 @@ func GetCmdQueryStakingAPR() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagIncludeFees, false, "Include the trailing average of the transaction fees")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorAPR implements a command to return the staking APR of
+// the delegators of a validator.
+func GetCmdQueryValidatorAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-apr [validator-address]",
+		Short: "Query the staking APR of the delegators of a validator, net of its commission",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			includeFees, err := cmd.Flags().GetBool(FlagIncludeFees)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorAPRRequest{ValidatorAddress: args[0], IncludeFees: includeFees}
+			res, err := queryClient.ValidatorAPR(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Apr))
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeFees, false, "Include the trailing average of the transaction fees")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalBurnt implements a command to return the cumulative amount
+// of burnt tokens.
+func GetCmdQueryTotalBurnt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-burnt",
+		Short: "Query the cumulative amount of burnt tokens per denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TotalBurnt(cmd.Context(), &types.QueryTotalBurntRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "total-burnt")
+	return cmd
+}
+
+// GetCmdQueryBurntByAddress implements a command to return the cumulative
+// amount of tokens burnt by an account.
+func GetCmdQueryBurntByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burnt-by-address [address]",
+		Short: "Query the cumulative amount of tokens burnt by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurntByAddress(cmd.Context(), &types.QueryBurntByAddressRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burnt-by-address")
+	return cmd
+}
+
+// GetCmdQueryBurnHistory implements a command to return the history of burns.
+func GetCmdQueryBurnHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-history",
+		Short: "Query the history of burns ordered by height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnHistory(cmd.Context(), &types.QueryBurnHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn-history")
+	return cmd
+}
+
+// GetCmdQueryFeeBurnStats implements a command to return the transaction fees
+// collected and burnt per epoch.
+func GetCmdQueryFeeBurnStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-burn-stats",
+		Short: "Query the transaction fees collected and burnt per epoch, ordered by height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeBurnStats(cmd.Context(), &types.QueryFeeBurnStatsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee-burn-stats")
+	return cmd
+}
+
+// GetCmdQueryInflationHistory implements a command to return the inflation
+// snapshots.
+func GetCmdQueryInflationHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-history",
+		Short: "Query the inflation snapshots ordered by height",
+		Example: fmt.Sprintf(
+			"$ %s query %s inflation-history --%s 100000 --%s 200000",
+			version.AppName, types.ModuleName, FlagFromHeight, FlagToHeight,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InflationHistory(cmd.Context(), &types.QueryInflationHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "Lowest height of the snapshots")
+	cmd.Flags().Int64(FlagToHeight, 0, "Highest height of the snapshots, 0 for no limit")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inflation-history")
+	return cmd
+}
+
+// GetCmdQueryLastMintFault implements a command to return the last failure to
+// mint or distribute the minted coins.
+func GetCmdQueryLastMintFault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-mint-fault",
+		Short: "Query the last failure to mint or distribute the minted coins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLastMintFaultRequest{}
+			res, err := queryClient.LastMintFault(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMintingPause implements a command to return the state of the
+// minting circuit breaker.
+func GetCmdQueryMintingPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minting-pause",
+		Short: "Query whether minting is paused and how long it was paused for",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryMintingPauseRequest{}
+			res, err := queryClient.MintingPause(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Pause)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalSupply implements a command to return the total supply of the mint denom.
+func GetCmdQueryTotalSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-supply",
+		Short: "Query the total supply of the mint denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalSupplyRequest{}
+			res, err := queryClient.TotalSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Amount))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCirculatingSupply implements a command to return the supply of the mint denom that is not locked.
+func GetCmdQueryCirculatingSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circulating-supply",
+		Short: "Query the supply of the mint denom that is not locked",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCirculatingSupplyRequest{}
+			res, err := queryClient.CirculatingSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Amount))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBurntSupply implements a command to return the cumulative amount of the mint denom burnt.
+func GetCmdQueryBurntSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burnt-supply",
+		Short: "Query the cumulative amount of the mint denom burnt",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBurntSupplyRequest{}
+			res, err := queryClient.BurntSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Amount))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEmissionSchedule implements a command to return the projected
+// emissions of the upcoming reduction periods.
+func GetCmdQueryEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-schedule",
+		Short: "Query the projected emissions of the upcoming reduction periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the projected emissions of the upcoming reduction periods, computed
+from the current parameters. The current reduction period is the period 0.
+
+Example:
+  $ %s query %s emission-schedule --%s=1 --%s=5
+`,
+				version.AppName, types.ModuleName, FlagFromPeriod, FlagCount,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromPeriod, err := cmd.Flags().GetUint64(FlagFromPeriod)
+			if err != nil {
+				return err
+			}
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EmissionSchedule(cmd.Context(), &types.QueryEmissionScheduleRequest{
+				FromPeriod: fromPeriod,
+				Count:      count,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromPeriod, 0, "First reduction period to project, the current period being 0")
+	cmd.Flags().Uint64(FlagCount, 10, "Number of reduction periods to project")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDistributionTotals implements a command to return the cumulative
+// amounts minted and sent to each distribution bucket.
+func GetCmdQueryDistributionTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-totals",
+		Short: "Query the cumulative amounts minted and sent to each distribution bucket",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionTotals(cmd.Context(), &types.QueryDistributionTotalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Totals)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingDistribution implements a command to return the amounts
+// allocated in the current distribution epoch and not distributed yet.
+func GetCmdQueryPendingDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-distribution",
+		Short: "Query the amounts allocated in the current distribution epoch and not distributed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingDistribution(cmd.Context(), &types.QueryPendingDistributionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Pending)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVestingRewards implements a command to return the claimable and
+// claimed developer rewards of a receiver.
+func GetCmdQueryVestingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-rewards [address]",
+		Short: "Query the claimable and claimed developer rewards of a receiver",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingRewards(cmd.Context(), &types.QueryVestingRewardsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Rewards)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllVestingRewards implements a command to return the claimable
+// and claimed developer rewards of all receivers.
+func GetCmdQueryAllVestingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-vesting-rewards",
+		Short: "Query the claimable and claimed developer rewards of all receivers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllVestingRewards(cmd.Context(), &types.QueryAllVestingRewardsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-vesting-rewards")
+	return cmd
+}
+
+// GetCmdQueryVestingReceiverRotations implements a command to return the
+// rotation history of a developer rewards receiver slot.
+func GetCmdQueryVestingReceiverRotations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-receiver-rotations [slot]",
+		Short: "Query the rotation history of a developer rewards receiver slot",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			slot, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingReceiverRotations(cmd.Context(), &types.QueryVestingReceiverRotationsRequest{
+				Slot:       slot,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting-receiver-rotations")
 	return cmd
 }
 
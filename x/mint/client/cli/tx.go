@@ -2,12 +2,20 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetTxBurnTokensCmd(),
+		GetTxUpdateParamsProposalCmd(),
+		GetTxClaimVestedRewardsCmd(),
+		GetTxRotateVestingReceiverCmd(),
+		GetTxSetMintingPausedCmd(),
 	)
 
 	return txCmd
@@ -64,3 +76,217 @@ func GetTxBurnTokensCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetTxUpdateParamsProposalCmd implement cli command for submitting a
+// governance proposal that executes MsgUpdateParams
+func GetTxUpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params-proposal [params-file]",
+		Short: "Submit a governance proposal to update the minting parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to update the minting parameters.
+The params file must contain the full set of parameters, e.g. the output of the params query.
+
+Example:
+  $ %s query %s params --output json > params.json
+  $ %s tx %s update-params-proposal params.json --title="..." --summary="..." --deposit=1000000utori --from=mykey
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				params,
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg},
+				deposit,
+				clientCtx.GetFromAddress().String(),
+				metadata,
+				title,
+				summary,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagSummary, "", "The proposal summary")
+	cmd.Flags().String(govcli.FlagMetadata, "", "The proposal metadata")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxClaimVestedRewardsCmd implement cli command for MsgClaimVestedRewards
+func GetTxClaimVestedRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested-rewards",
+		Short: "Claim the vested developer rewards of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimVestedRewards(
+				clientCtx.GetFromAddress().String(),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxRotateVestingReceiverCmd implement cli command for MsgRotateVestingReceiver
+func GetTxRotateVestingReceiverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-vesting-receiver [new-receiver]",
+		Short: "Move the future developer rewards monthly amounts of the sender to a new address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateVestingReceiver(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// GetTxSetMintingPausedCmd implement cli command for MsgSetMintingPaused,
+// signed by the guardian
+func GetTxSetMintingPausedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minting-paused [true|false]",
+		Short: "Pause or resume minting and distribution as the guardian",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause or resume minting and distribution. The sender must be the guardian set in the
+minting parameters, the governance account pausing minting through a proposal executing
+MsgSetMintingPaused.
+
+Example:
+  $ %s tx %s set-minting-paused true --from=guardian
+  $ %s tx %s set-minting-paused false --%s --from=guardian
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, FlagExtendSchedule,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			extendSchedule, err := cmd.Flags().GetBool(FlagExtendSchedule)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintingPaused(
+				clientCtx.GetFromAddress().String(),
+				paused,
+				extendSchedule,
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExtendSchedule, false, "On resume, delay the reduction schedule and the vesting month by the pause")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
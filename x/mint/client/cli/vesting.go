@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// defaultVestingExponent converts the vesting schedule amounts from TORI to utori.
+const defaultVestingExponent = 6
+
+// GetVestingCmd returns the commands managing the team vesting schedule of the
+// genesis file.
+func GetVestingCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "vesting",
+		Short:                      "Import and export the team vesting schedule of the genesis file",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetVestingImportCmd(defaultNodeHome),
+		GetVestingExportCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// GetVestingImportCmd implements a command to import a team vesting schedule
+// CSV into the genesis file.
+func GetVestingImportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [csv-file]",
+		Short: "Import a team vesting schedule CSV into the genesis file",
+		Long: `Import a team vesting schedule CSV into the developer rewards receivers of the genesis file.
+
+The CSV has one column per receiver, headed by the receiver address, and one row per month,
+holding the monthly amounts in display units. The schedule is checked against the developer
+rewards budget and the changes to the current schedule are reported.`,
+		Example: fmt.Sprintf(`$ %s mint vesting import team_vesting.csv --dry-run`, "teritorid"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			genFile := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+
+			exponent, err := cmd.Flags().GetUint32(FlagExponent)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			receivers, err := types.ParseVestingScheduleCSV(f, exponent)
+			if err != nil {
+				return fmt.Errorf("failed to parse vesting schedule: %w", err)
+			}
+
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			var mintGenState types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &mintGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal mint genesis state: %w", err)
+			}
+
+			changes := types.DiffVestingSchedules(mintGenState.Params.WeightedDeveloperRewardsReceivers, receivers)
+			mintGenState.Params.WeightedDeveloperRewardsReceivers = receivers
+			if err := types.ValidateGenesis(mintGenState); err != nil {
+				return fmt.Errorf("invalid mint genesis state: %w", err)
+			}
+			// the minter provisions are reset to the genesis block provisions at chain start
+			minter := mintGenState.Minter
+			minter.BlockProvisions = mintGenState.Params.GenesisBlockProvisions
+			if err := types.ValidateDeveloperRewardsBudget(
+				mintGenState.Params, minter, mintGenState.ReductionStartedBlock, mintGenState.MonthInfo,
+			); err != nil {
+				return fmt.Errorf("vesting schedule exceeds the developer rewards budget: %w", err)
+			}
+
+			printVestingScheduleChanges(cmd.OutOrStdout(), changes, exponent)
+			if dryRun {
+				return nil
+			}
+
+			mintGenStateBz, err := clientCtx.Codec.MarshalJSON(&mintGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal mint genesis state: %w", err)
+			}
+			appState[types.ModuleName] = mintGenStateBz
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint32(FlagExponent, defaultVestingExponent, "The exponent converting the amounts to base units")
+	cmd.Flags().Bool(FlagDryRun, false, "Report the changes without writing the genesis file")
+
+	return cmd
+}
+
+// GetVestingExportCmd implements a command to export the team vesting schedule
+// of the genesis file as CSV.
+func GetVestingExportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [csv-file]",
+		Short: "Export the team vesting schedule of the genesis file as CSV",
+		Long: `Export the developer rewards receivers of the genesis file as a team vesting schedule CSV,
+written to the given file or to the standard output.`,
+		Example: fmt.Sprintf(`$ %s mint vesting export team_vesting.csv`, "teritorid"),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			genFile := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+
+			exponent, err := cmd.Flags().GetUint32(FlagExponent)
+			if err != nil {
+				return err
+			}
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			var mintGenState types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &mintGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal mint genesis state: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			if len(args) > 0 {
+				f, err := os.Create(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			return types.WriteVestingScheduleCSV(out, mintGenState.Params.WeightedDeveloperRewardsReceivers, exponent)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint32(FlagExponent, defaultVestingExponent, "The exponent converting the amounts from base units")
+
+	return cmd
+}
+
+func printVestingScheduleChanges(w io.Writer, changes []types.VestingScheduleChange, exponent uint32) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "vesting schedule unchanged")
+		return
+	}
+
+	for _, change := range changes {
+		fmt.Fprintf(w, "slot %d:", change.Slot)
+		if change.OldAddress != change.NewAddress {
+			fmt.Fprintf(w, " address %q -> %q,", change.OldAddress, change.NewAddress)
+		}
+		fmt.Fprintf(w, " total %s -> %s, %d months changed\n",
+			types.FormatVestingAmount(change.OldTotal, exponent), types.FormatVestingAmount(change.NewTotal, exponent), change.ChangedMonths)
+	}
+}
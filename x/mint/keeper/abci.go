@@ -3,34 +3,85 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// maxReductionsPerBlock bounds the number of reduction periods applied in a
+// single block in time mode.
+const maxReductionsPerBlock = 100
+
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	blockNumber := ctx.BlockHeight()
 
+	// the fee collector only holds the fees of the block before the minted coins are distributed
+	k.BurnFees(ctx, params)
+	k.TrackFeeInflow(ctx, params)
+	// the snapshots keep being taken before the start block and while minting is paused,
+	// showing the state of the block before its coins are minted
+	k.RecordInflationSnapshot(ctx, params)
+
 	// not distribute rewards if it's not time yet for rewards distribution
 	if blockNumber < params.MintingRewardsDistributionStartBlock {
 		return
 	} else if blockNumber == params.MintingRewardsDistributionStartBlock {
 		k.SetLastReductionBlockNum(ctx, blockNumber)
+		k.SetLastReductionTime(ctx, ctx.BlockTime())
+	}
+
+	// nothing is minted nor distributed while the circuit breaker is on
+	if k.IsMintingPaused(ctx) {
+		return
 	}
+
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 
-	// Check if we have hit an block where we update the inflation parameter.
-	// We measure time between reductions in number of blocks.
-	// This avoids issues with measuring in block numbers, as blocks have fixed intervals, with very
-	// low variance at the relevant sizes. As a result, it is safe to store the block number
-	// of the last reduction to be later retrieved for comparison.
-	if blockNumber >= params.ReductionPeriodInBlocks+k.GetLastReductionBlockNum(ctx) {
-		// Reduce the reward per reduction period
-		minter.BlockProvisions = minter.NextBlockProvisions(params)
-		k.SetMinter(ctx, minter)
-		k.SetLastReductionBlockNum(ctx, blockNumber)
+	switch params.ReductionMode {
+	case types.ReductionModeTime:
+		// We measure time between reductions in consensus time, so the emission curve
+		// follows the published schedule even when the block time drifts. Every period
+		// elapsed since the last reduction reduces the provisions, and the next period
+		// starts where the previous one ended rather than at the current block time.
+		// A period not started yet, as when the module starts in time mode with no start
+		// block to initialize it, starts at the current block.
+		lastReductionTime := k.GetLastReductionTime(ctx)
+		if lastReductionTime.IsZero() {
+			lastReductionTime = ctx.BlockTime()
+			k.SetLastReductionTime(ctx, lastReductionTime)
+		}
+		// The periods overdue beyond the cap are caught up in the following blocks.
+		reduced, periods := minter, 0
+		for periods < maxReductionsPerBlock && !ctx.BlockTime().Before(lastReductionTime.Add(params.ReductionPeriodDuration)) {
+			reduced.BlockProvisions = reduced.NextBlockProvisions(params)
+			lastReductionTime = lastReductionTime.Add(params.ReductionPeriodDuration)
+			periods++
+		}
+		if periods > 0 {
+			// the hooks see the periods elapsed since the previous block as a single reduction
+			k.reduceBlockProvisions(ctx, minter, reduced)
+			minter = reduced
+			k.SetLastReductionTime(ctx, lastReductionTime)
+			k.SetLastReductionBlockNum(ctx, blockNumber)
+		}
+	default:
+		// Check if we have hit an block where we update the inflation parameter.
+		// We measure time between reductions in number of blocks.
+		// This avoids issues with measuring in block numbers, as blocks have fixed intervals, with very
+		// low variance at the relevant sizes. As a result, it is safe to store the block number
+		// of the last reduction to be later retrieved for comparison.
+		if blockNumber >= params.ReductionPeriodInBlocks+k.GetLastReductionBlockNum(ctx) {
+			// Reduce the reward per reduction period
+			reduced := minter
+			reduced.BlockProvisions = minter.NextBlockProvisions(params)
+			k.reduceBlockProvisions(ctx, minter, reduced)
+			minter = reduced
+			k.SetLastReductionBlockNum(ctx, blockNumber)
+		}
 	}
 
 	// implement automatic monthInfo updates
@@ -43,22 +94,49 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		monthInfo.MonthsSinceGenesis++
 		monthInfo.MonthStartedBlock = ctx.BlockHeight()
 		k.SetTeamVestingMonthInfo(ctx, monthInfo)
+		if k.hooks != nil {
+			k.hooks.AfterVestingMonthAdvanced(ctx, monthInfo.MonthsSinceGenesis)
+		}
 	}
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
-	mintedCoins := sdk.NewCoins(mintedCoin)
+	if params.ReductionMode == types.ReductionModeTime {
+		// the first block after the start has no previous block time to scale by
+		if lastBlockTime := k.GetLastBlockTime(ctx); !lastBlockTime.IsZero() {
+			mintedCoin = minter.ScaledBlockProvision(params, ctx.BlockTime().Sub(lastBlockTime))
+		}
+	}
+	k.SetLastBlockTime(ctx, ctx.BlockTime())
 
-	// We over-allocate by the developer vesting portion, and burn this later
-	err := k.MintCoins(ctx, mintedCoins)
-	if err != nil {
-		panic(err)
+	// the supply does not exceed the max supply, the allocations being clipped proportionally
+	provision := mintedCoin
+	mintedCoin.Amount = k.ClipToMaxSupply(ctx, params, provision.Amount)
+	clipped := provision.Amount.Sub(mintedCoin.Amount)
+	if clipped.IsPositive() {
+		k.AddClippedProvisions(ctx, clipped)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.DistributeMintedCoin(ctx, mintedCoin)
-	if err != nil {
-		panic(err)
+	// the minted coins are distributed in a cached context, so that a failing bucket does not halt
+	// the chain: the coins of the block, and the allocations pending from the previous blocks of the
+	// distribution epoch, are then sent to the community pool instead
+	if err := k.mintAndDistribute(ctx, provision, mintedCoin); err != nil {
+		redirected := mintedCoin.Amount
+		cacheCtx, write := ctx.CacheContext()
+		pendingRedirected, fallbackErr := k.redirectPendingDistribution(cacheCtx, params)
+		if fallbackErr == nil {
+			fallbackErr = k.mintToCommunityPool(cacheCtx, params, redirected)
+		}
+		if fallbackErr != nil {
+			k.Logger(ctx).Error("failed to mint to the community pool", "err", fallbackErr)
+			// the coins of the block are not minted at all
+			k.AddClippedProvisions(ctx, redirected)
+			redirected = math.ZeroInt()
+		} else {
+			write()
+			redirected = redirected.Add(pendingRedirected)
+		}
+		k.recordMintFault(ctx, err, redirected)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -67,6 +145,37 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 			sdk.NewAttribute(types.AttributeBlockNumber, fmt.Sprintf("%d", blockNumber)),
 			sdk.NewAttribute(types.AttributeKeyBlockProvisions, minter.BlockProvisions.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyClipped, clipped.String()),
 		),
 	)
 }
+
+// mintAndDistribute mints the coins of the block and distributes them, writing
+// the state changes only if both succeed.
+func (k Keeper) mintAndDistribute(ctx sdk.Context, provision, mintedCoin sdk.Coin) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.MintCoins(cacheCtx, sdk.NewCoins(mintedCoin)); err != nil {
+		return distributionError{types.BucketMint, err}
+	}
+
+	// send the minted coins to the fee collector account
+	if err := k.distributeProvision(cacheCtx, provision, mintedCoin.Amount); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// reduceBlockProvisions stores the reduced minter, calling the reduction hooks
+// around it.
+func (k Keeper) reduceBlockProvisions(ctx sdk.Context, minter, reduced types.Minter) {
+	if k.hooks != nil {
+		k.hooks.BeforeReduction(ctx, minter.BlockProvisions, reduced.BlockProvisions)
+	}
+	k.SetMinter(ctx, reduced)
+	if k.hooks != nil {
+		k.hooks.AfterReduction(ctx, minter.BlockProvisions, reduced.BlockProvisions)
+	}
+}
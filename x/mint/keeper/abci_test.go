@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"os"
+	"time"
+
 	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,13 +24,7 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 		GenesisBlockProvisions:  defaultParams.GenesisBlockProvisions,
 		ReductionPeriodInBlocks: 4000,
 		ReductionFactor:         sdk.NewDecWithPrec(5, 1),
-		DistributionProportions: types.DistributionProportions{
-			GrantsProgram:    sdk.NewDecWithPrec(2, 1),
-			CommunityPool:    sdk.NewDecWithPrec(2, 1),
-			UsageIncentive:   sdk.NewDecWithPrec(2, 1),
-			Staking:          sdk.NewDecWithPrec(2, 1),
-			DeveloperRewards: sdk.NewDecWithPrec(2, 1),
-		},
+		DistributionRecipients:  equalDistributionRecipients(grantsAddr, usageIncentiveAddr),
 		WeightedDeveloperRewardsReceivers: []types.MonthlyVestingAddress{
 			{
 				Address:        dev1Addr.String(),
@@ -37,8 +35,6 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 				MonthlyAmounts: []math.Int{sdk.NewInt(4000), sdk.NewInt(4000), sdk.NewInt(4000)},
 			},
 		},
-		UsageIncentiveAddress:                usageIncentiveAddr.String(),
-		GrantsProgramAddress:                 grantsAddr.String(),
 		TeamReserveAddress:                   teamReserveAddr.String(),
 		MintingRewardsDistributionStartBlock: 10,
 		BlocksPerYear:                        5733818,
@@ -119,13 +115,20 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	suite.Require().Equal(monthInfo.MonthsSinceGenesis, int64(2))
 }
 
-func (suite *KeeperTestSuite) TestEndBlocker90MonthsCheckWithDefaultGenesis() {
+func (suite *KeeperTestSuite) TestEndBlocker90MonthsCheckWithMainnetSchedule() {
 	monthInfo := suite.app.MintKeeper.GetTeamVestingMonthInfo(suite.ctx)
 	monthInfo.OneMonthPeriodInBlocks = 10
 	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, monthInfo)
 
+	f, err := os.Open("../../../mainnet/teritori-1/team_vesting.csv")
+	suite.Require().NoError(err)
+	defer f.Close()
+	teamVesting, err := types.ParseVestingScheduleCSV(f, 6)
+	suite.Require().NoError(err)
+
 	params := suite.app.MintKeeper.GetParams(suite.ctx)
 	params.MintingRewardsDistributionStartBlock = 10
+	params.WeightedDeveloperRewardsReceivers = teamVesting
 	dev1Addr, _ := sdk.AccAddressFromBech32(params.WeightedDeveloperRewardsReceivers[0].Address)
 	dev2Addr, _ := sdk.AccAddressFromBech32(params.WeightedDeveloperRewardsReceivers[1].Address)
 	suite.app.MintKeeper.SetParams(suite.ctx, params)
@@ -143,10 +146,16 @@ func (suite *KeeperTestSuite) TestEndBlocker90MonthsCheckWithDefaultGenesis() {
 		}
 	}
 
+	dev1Rewards := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev1Addr)
+	dev2Rewards := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev2Addr)
+	suite.Require().Equal(dev1Rewards.Claimable.String(), sdk.NewInt(3000003280000).String())
+	suite.Require().Equal(dev2Rewards.Claimable.String(), sdk.NewInt(6000006530000).String())
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	_, err = msgServer.ClaimVestedRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimVestedRewards(dev1Addr.String()))
+	suite.Require().NoError(err)
 	dev1Balance := suite.app.BankKeeper.GetBalance(suite.ctx, dev1Addr, params.MintDenom)
-	dev2Balance := suite.app.BankKeeper.GetBalance(suite.ctx, dev2Addr, params.MintDenom)
 	suite.Require().Equal(dev1Balance.String(), sdk.NewCoin(params.MintDenom, sdk.NewInt(3000003280000)).String())
-	suite.Require().Equal(dev2Balance.String(), sdk.NewCoin(params.MintDenom, sdk.NewInt(6000006530000)).String())
 }
 
 func (suite *KeeperTestSuite) TestEndBlocker90MonthsCheck() {
@@ -178,8 +187,140 @@ func (suite *KeeperTestSuite) TestEndBlocker90MonthsCheck() {
 		}
 	}
 
-	dev1Balance := suite.app.BankKeeper.GetBalance(suite.ctx, dev1Addr, params.MintDenom)
-	dev2Balance := suite.app.BankKeeper.GetBalance(suite.ctx, dev2Addr, params.MintDenom)
-	suite.Require().Equal(dev1Balance, sdk.NewCoin(params.MintDenom, sdk.NewInt(18000)))
-	suite.Require().Equal(dev2Balance, sdk.NewCoin(params.MintDenom, sdk.NewInt(12000)))
+	dev1Claimable := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev1Addr).Claimable
+	dev2Claimable := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev2Addr).Claimable
+	suite.Require().Equal(dev1Claimable.String(), sdk.NewInt(18000).String())
+	suite.Require().Equal(dev2Claimable.String(), sdk.NewInt(12000).String())
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerTimeMode() {
+	suite.SetupTest()
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 10
+	params.ReductionMode = types.ReductionModeTime
+	params.ReductionPeriodInBlocks = 720
+	params.ReductionPeriodDuration = time.Hour // nominal block time of 5s
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	grantsAddr := sdk.MustAccAddressFromBech32(params.DistributionRecipients[0].Target)
+	grantsBalance := func() math.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, grantsAddr, params.MintDenom).Amount
+	}
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(startTime)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(startTime, suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+	suite.Require().Equal(startTime, suite.app.MintKeeper.GetLastBlockTime(suite.ctx))
+
+	// a block twice as long as the nominal block time mints twice the provisions
+	balance := grantsBalance()
+	suite.ctx = suite.ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(10 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(9400000), grantsBalance().Sub(balance)) // 10% of 2 x 47
+
+	// the reduction happens once the period duration has elapsed, whatever the
+	// number of blocks produced in between
+	suite.ctx = suite.ctx.WithBlockHeight(12).WithBlockTime(startTime.Add(time.Hour))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewDec(23500000), suite.app.MintKeeper.GetMinter(suite.ctx).BlockProvisions)
+	suite.Require().Equal(startTime.Add(time.Hour), suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+
+	// the next period starts where the previous one ended
+	suite.ctx = suite.ctx.WithBlockHeight(13).WithBlockTime(startTime.Add(2*time.Hour - 5*time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewDec(23500000), suite.app.MintKeeper.GetMinter(suite.ctx).BlockProvisions)
+
+	suite.ctx = suite.ctx.WithBlockHeight(14).WithBlockTime(startTime.Add(2 * time.Hour))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewDec(11750000), suite.app.MintKeeper.GetMinter(suite.ctx).BlockProvisions)
+	suite.Require().Equal(startTime.Add(2*time.Hour), suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerTimeModeDeveloperVesting() {
+	suite.SetupTest()
+
+	// the receiver vests all the developer rewards of a nominal block
+	monthInfo := suite.app.MintKeeper.GetTeamVestingMonthInfo(suite.ctx)
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 10
+	params.ReductionMode = types.ReductionModeTime
+	params.ReductionPeriodInBlocks = 720
+	params.ReductionPeriodDuration = time.Hour // nominal block time of 5s
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: devAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(7050000).MulRaw(monthInfo.OneMonthPeriodInBlocks)}},
+	}
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+	claimable := func() math.Int {
+		return suite.app.MintKeeper.GetVestingRewards(suite.ctx, devAddr).Claimable
+	}
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(startTime)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(7050000), claimable()) // 15% of 47
+
+	// a block shorter than the nominal block time vests a proportionally smaller amount
+	suite.ctx = suite.ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(2 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	_, found := suite.app.MintKeeper.GetLastMintFault(suite.ctx)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(7050000+2820000), claimable()) // 15% of 2/5 x 47
+
+	// and a longer block a proportionally larger amount
+	suite.ctx = suite.ctx.WithBlockHeight(12).WithBlockTime(startTime.Add(12 * time.Second))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.MintKeeper.GetLastMintFault(suite.ctx)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(7050000+2820000+14100000), claimable()) // 15% of 2 x 47
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerTimeModeUnstartedPeriod() {
+	suite.SetupTest()
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 0
+	params.ReductionMode = types.ReductionModeTime
+	params.ReductionPeriodInBlocks = 720
+	params.ReductionPeriodDuration = time.Hour // nominal block time of 5s
+	params.ReductionFactor = sdk.NewDecWithPrec(9, 1)
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	// the start block is never reached, so the period was never started
+	suite.app.MintKeeper.SetLastReductionTime(suite.ctx, time.Time{})
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(5).WithBlockTime(startTime)
+	minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(minter.BlockProvisions, suite.app.MintKeeper.GetMinter(suite.ctx).BlockProvisions)
+	suite.Require().Equal(startTime, suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+
+	// a long halt reduces the provisions by a bounded number of periods per block
+	suite.ctx = suite.ctx.WithBlockHeight(6).WithBlockTime(startTime.Add(1000 * time.Hour))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(startTime.Add(100*time.Hour), suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+
+	// the provisions of a block are scaled by at most ten nominal block times
+	minted := suite.app.MintKeeper.GetDistributionTotals(suite.ctx).Minted
+	minter = suite.app.MintKeeper.GetMinter(suite.ctx)
+	suite.ctx = suite.ctx.WithBlockHeight(7).WithBlockTime(startTime.Add(1000*time.Hour + 10*time.Minute))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	reduced := suite.app.MintKeeper.GetMinter(suite.ctx)
+	suite.Require().True(reduced.BlockProvisions.LT(minter.BlockProvisions))
+	suite.Require().True(suite.app.MintKeeper.GetDistributionTotals(suite.ctx).Minted.Sub(minted).LTE(
+		reduced.BlockProvisions.MulInt64(types.MaxScaledBlockTimeFactor).TruncateInt()))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisStartsReductionPeriod() {
+	suite.SetupTest()
+
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(genesisTime)
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	genesis.ReductionStartedTime = time.Time{}
+	suite.app.MintKeeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(genesisTime, suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
 }
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingAPR returns the yearly rewards of the bonded tokens net of the
+// community tax: the minted coins sent to the fee collector and, if
+// includeFees is set, the trailing average of the transaction fees, over the
+// total bonded tokens. It is zero when no tokens are bonded.
+func (k Keeper) StakingAPR(ctx sdk.Context, includeFees bool) sdk.Dec {
+	totalStaked := k.stakingKeeper.TotalBondedTokens(ctx)
+	if !totalStaked.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	blockRewards := minter.BlockProvisions.Mul(params.ModuleWeight(k.feeCollectorName))
+	if includeFees {
+		blockRewards = blockRewards.Add(k.GetAverageBlockFees(ctx))
+	}
+
+	return blockRewards.
+		Mul(sdk.NewDec(int64(params.BlocksPerYear))).
+		Mul(sdk.OneDec().Sub(k.communityPoolKeeper.GetCommunityTax(ctx))).
+		QuoInt(totalStaked)
+}
+
+// ValidatorAPR returns the staking APR of the delegators of a validator, net of
+// its commission. It is zero for a validator that is not bonded, as it earns no
+// rewards.
+func (k Keeper) ValidatorAPR(ctx sdk.Context, valAddr sdk.ValAddress, includeFees bool) (sdk.Dec, bool) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	if !validator.IsBonded() || validator.IsJailed() {
+		return sdk.ZeroDec(), true
+	}
+
+	commission := validator.GetCommission()
+	return k.StakingAPR(ctx, includeFees).Mul(sdk.OneDec().Sub(commission)), true
+}
+
+// GetAverageBlockFees returns the average amount of the mint denom collected as
+// transaction fees per block over the fee APR window, or over the blocks since
+// the fees are tracked if fewer.
+func (k Keeper) GetAverageBlockFees(ctx sdk.Context) sdk.Dec {
+	window, found := k.GetFeeInflowWindow(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	blocks := ctx.BlockHeight() - window.StartHeight + 1
+	if windowBlocks := int64(k.GetParams(ctx).FeeAprWindowBlocks); blocks > windowBlocks {
+		blocks = windowBlocks
+	}
+	if blocks <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(window.Total).QuoInt64(blocks)
+}
+
+// GetFeeInflowWindow returns the sum of the fees of the fee APR window.
+func (k Keeper) GetFeeInflowWindow(ctx sdk.Context) (types.FeeInflowWindow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeInflowWindowKey)
+	if bz == nil {
+		return types.FeeInflowWindow{}, false
+	}
+
+	var window types.FeeInflowWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window, true
+}
+
+// SetFeeInflowWindow sets the sum of the fees of the fee APR window.
+func (k Keeper) SetFeeInflowWindow(ctx sdk.Context, window types.FeeInflowWindow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeInflowWindowKey, k.cdc.MustMarshal(&window))
+}
+
+// TrackFeeInflow records the transaction fees of the block, the mint denom
+// balance of the fee collector after the fee burn and before the minted coins
+// are distributed, and drops the blocks that left the fee APR window. The fees
+// are not tracked when the window is 0.
+func (k Keeper) TrackFeeInflow(ctx sdk.Context, params types.Params) {
+	if params.FeeAprWindowBlocks == 0 {
+		k.clearFeeInflows(ctx)
+		return
+	}
+
+	window, found := k.GetFeeInflowWindow(ctx)
+	if !found {
+		window = types.FeeInflowWindow{Total: math.ZeroInt(), StartHeight: ctx.BlockHeight()}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	fees := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(k.feeCollectorName), params.MintDenom).Amount
+	if fees.IsPositive() {
+		bz, err := fees.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetFeeInflowKey(ctx.BlockHeight()), bz)
+		window.Total = window.Total.Add(fees)
+	}
+
+	// the window holds the fees of the last fee_apr_window_blocks blocks
+	end := ctx.BlockHeight() - int64(params.FeeAprWindowBlocks) + 1
+	if end > 0 {
+		iterator := prefix.NewStore(store, types.FeeInflowKeyPrefix).Iterator(nil, sdk.Uint64ToBigEndian(uint64(end)))
+		var expired []int64
+		for ; iterator.Valid(); iterator.Next() {
+			var amount math.Int
+			if err := amount.Unmarshal(iterator.Value()); err != nil {
+				panic(err)
+			}
+			window.Total = window.Total.Sub(amount)
+			expired = append(expired, int64(sdk.BigEndianToUint64(iterator.Key())))
+		}
+		iterator.Close()
+		for _, height := range expired {
+			store.Delete(types.GetFeeInflowKey(height))
+		}
+	}
+
+	k.SetFeeInflowWindow(ctx, window)
+}
+
+// clearFeeInflows deletes the tracked fees.
+func (k Keeper) clearFeeInflows(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.FeeInflowWindowKey) {
+		return
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeInflowKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.FeeInflowWindowKey)
+}
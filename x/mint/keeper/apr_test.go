@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestStakingAPR() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	querier := keeper.NewQuerier(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+
+	// the minted staking rewards net of the community tax
+	communityTax := suite.app.DistrKeeper.GetCommunityTax(suite.ctx)
+	suite.Require().True(communityTax.IsPositive())
+	expected := mintKeeper.GetMinter(suite.ctx).BlockProvisions.
+		Mul(params.ModuleWeight(authtypes.FeeCollectorName)).
+		Mul(sdk.NewDec(int64(params.BlocksPerYear))).
+		Mul(sdk.OneDec().Sub(communityTax)).
+		QuoInt(suite.app.StakingKeeper.TotalBondedTokens(suite.ctx))
+	res, err := querier.StakingAPR(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.Apr)
+
+	// the fees are not tracked by default
+	res, err = querier.StakingAPR(sdk.WrapSDKContext(suite.ctx), &types.QueryStakingAPRRequest{IncludeFees: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.Apr)
+
+	// the delegators of a validator earn the staking APR net of its commission
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valRes, err := querier.ValidatorAPR(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorAPRRequest{
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected.Mul(sdk.OneDec().Sub(validator.GetCommission())), valRes.Apr)
+
+	_, err = querier.ValidatorAPR(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorAPRRequest{
+		ValidatorAddress: sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+	})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = querier.ValidatorAPR(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorAPRRequest{ValidatorAddress: "invalid"})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestFeeInflow() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	params := mintKeeper.GetParams(suite.ctx)
+	params.FeeAprWindowBlocks = 3
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	// collect sends the fees of a block to the fee collector, tracks them and
+	// empties the fee collector as the distribution module does
+	collect := func(height, amount int64) {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		if amount > 0 {
+			coins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, amount))
+			suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+		}
+		mintKeeper.TrackFeeInflow(suite.ctx, params)
+
+		feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		fees := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, disttypes.ModuleName, fees))
+	}
+
+	// the average covers the blocks since the fees are tracked until the window is full
+	collect(10, 300)
+	suite.Require().Equal(sdk.NewDec(300), mintKeeper.GetAverageBlockFees(suite.ctx))
+	collect(11, 0)
+	suite.Require().Equal(sdk.NewDec(150), mintKeeper.GetAverageBlockFees(suite.ctx))
+	collect(12, 600)
+	suite.Require().Equal(sdk.NewDec(300), mintKeeper.GetAverageBlockFees(suite.ctx))
+
+	// the blocks leaving the window are dropped
+	collect(13, 900)
+	suite.Require().Equal(sdk.NewDec(500), mintKeeper.GetAverageBlockFees(suite.ctx))
+	window, found := mintKeeper.GetFeeInflowWindow(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1500), window.Total)
+	collect(20, 0)
+	suite.Require().Equal(sdk.ZeroDec(), mintKeeper.GetAverageBlockFees(suite.ctx))
+
+	// the average fees are added to the staking rewards
+	collect(21, 3000)
+	withoutFees := mintKeeper.StakingAPR(suite.ctx, false)
+	withFees := mintKeeper.StakingAPR(suite.ctx, true)
+	communityTax := suite.app.DistrKeeper.GetCommunityTax(suite.ctx)
+	suite.Require().Equal(
+		sdk.NewDec(1000).
+			Mul(sdk.NewDec(int64(params.BlocksPerYear))).
+			Mul(sdk.OneDec().Sub(communityTax)).
+			QuoInt(suite.app.StakingKeeper.TotalBondedTokens(suite.ctx)),
+		withFees.Sub(withoutFees),
+	)
+
+	// disabling the window deletes the tracked fees
+	params.FeeAprWindowBlocks = 0
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	collect(22, 300)
+	_, found = mintKeeper.GetFeeInflowWindow(suite.ctx)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.ZeroDec(), mintKeeper.GetAverageBlockFees(suite.ctx))
+}
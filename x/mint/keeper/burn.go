@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnTokens burns the given amount from the burner account and records it in
+// the burn ledger.
+func (k Keeper) BurnTokens(ctx sdk.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burner, types.ModuleName, amount); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	k.RecordBurn(ctx, burner, amount)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Burner: burner.String(),
+		Amount: amount,
+	})
+}
+
+// RecordBurn adds a burn to the per-denom totals, the burner totals and the
+// burn history, and calls the AfterBurn hook.
+func (k Keeper) RecordBurn(ctx sdk.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	for _, coin := range amount {
+		k.setTotalBurnt(ctx, k.GetTotalBurntByDenom(ctx, coin.Denom).Add(coin))
+		k.setBurntByAddress(ctx, burner, k.GetBurntByAddressAndDenom(ctx, burner, coin.Denom).Add(coin))
+	}
+
+	k.SetBurnRecord(ctx, types.BurnRecord{
+		Id:     k.nextBurnID(ctx),
+		Burner: burner.String(),
+		Amount: amount,
+		Height: ctx.BlockHeight(),
+	})
+
+	if k.hooks != nil {
+		k.hooks.AfterBurn(ctx, burner, amount)
+	}
+}
+
+// GetTotalBurntByDenom returns the cumulative burnt amount of a denom.
+func (k Keeper) GetTotalBurntByDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTotalBurntKey(denom))
+	if bz == nil {
+		return sdk.NewInt64Coin(denom, 0)
+	}
+
+	var coin sdk.Coin
+	k.cdc.MustUnmarshal(bz, &coin)
+	return coin
+}
+
+// GetTotalBurnt returns the cumulative burnt amount of all denoms.
+func (k Keeper) GetTotalBurnt(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalBurntKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	total := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		total = total.Add(coin)
+	}
+	return total
+}
+
+// AddTotalBurnt adds to the cumulative burnt amounts without attributing the
+// burn to an account.
+func (k Keeper) AddTotalBurnt(ctx sdk.Context, amount sdk.Coins) {
+	for _, coin := range amount {
+		k.setTotalBurnt(ctx, k.GetTotalBurntByDenom(ctx, coin.Denom).Add(coin))
+	}
+}
+
+func (k Keeper) setTotalBurnt(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTotalBurntKey(coin.Denom), k.cdc.MustMarshal(&coin))
+}
+
+// GetBurntByAddressAndDenom returns the cumulative amount of a denom burnt by
+// an address.
+func (k Keeper) GetBurntByAddressAndDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBurntByAddressKey(addr, denom))
+	if bz == nil {
+		return sdk.NewInt64Coin(denom, 0)
+	}
+
+	var coin sdk.Coin
+	k.cdc.MustUnmarshal(bz, &coin)
+	return coin
+}
+
+// GetBurntByAddress returns the cumulative amount burnt by an address.
+func (k Keeper) GetBurntByAddress(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBurntByAddressPrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	total := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		total = total.Add(coin)
+	}
+	return total
+}
+
+func (k Keeper) setBurntByAddress(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBurntByAddressKey(addr, coin.Denom), k.cdc.MustMarshal(&coin))
+}
+
+// GetAllBurntByAddress returns the cumulative burnt amounts of all burners.
+func (k Keeper) GetAllBurntByAddress(ctx sdk.Context) []types.BurnerTotal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurntByAddressKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	totals := []types.BurnerTotal{}
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+
+		// key is a length-prefixed address followed by the denom
+		key := iterator.Key()
+		addr := sdk.AccAddress(key[1 : 1+key[0]]).String()
+		if n := len(totals); n > 0 && totals[n-1].Address == addr {
+			totals[n-1].Amount = append(totals[n-1].Amount, coin)
+			continue
+		}
+		totals = append(totals, types.BurnerTotal{Address: addr, Amount: []sdk.Coin{coin}})
+	}
+	return totals
+}
+
+// SetBurnRecord stores a burn record in the burn history.
+func (k Keeper) SetBurnRecord(ctx sdk.Context, record types.BurnRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBurnHistoryKey(record.Height, record.Id), k.cdc.MustMarshal(&record))
+}
+
+// GetBurnHistory returns all burn records ordered by height.
+func (k Keeper) GetBurnHistory(ctx sdk.Context) []types.BurnRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnHistoryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.BurnRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BurnRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetBurnSequence returns the id the next burn record will get.
+func (k Keeper) GetBurnSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BurnSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBurnSequence sets the id the next burn record will get.
+func (k Keeper) SetBurnSequence(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BurnSequenceKey, sdk.Uint64ToBigEndian(seq))
+}
+
+func (k Keeper) nextBurnID(ctx sdk.Context) uint64 {
+	id := k.GetBurnSequence(ctx)
+	k.SetBurnSequence(ctx, id+1)
+	return id
+}
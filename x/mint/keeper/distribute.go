@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,128 +20,322 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 }
 
 // DistributeMintedCoins implements distribution of minted coins from mint to external modules.
+// When the distribution epoch is longer than a block, only the module account recipients are paid
+// right away, the other allocations being accumulated in the mint module account and paid at the
+// end of the epoch.
 func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
-	params := k.GetParams(ctx)
-	proportions := params.DistributionProportions
+	return k.distributeProvision(ctx, mintedCoin, mintedCoin.Amount)
+}
 
-	grantsAmount, err := k.distributeToAddress(ctx, params.GrantsProgramAddress, mintedCoin, proportions.GrantsProgram)
+// distributeProvision distributes the minted part of the block provision, the allocations of the
+// provision being clipped proportionally when less than the provision is minted.
+func (k Keeper) distributeProvision(ctx sdk.Context, provision sdk.Coin, minted math.Int) error {
+	params := k.GetParams(ctx)
+	allocation, err := k.allocateMintedCoin(ctx, params, provision)
 	if err != nil {
 		return err
 	}
-
-	usageIncentiveAmount, err := k.distributeToAddress(ctx, params.UsageIncentiveAddress, mintedCoin, proportions.UsageIncentive)
-	if err != nil {
-		return err
+	if minted.LT(provision.Amount) {
+		allocation = clipAllocation(params, allocation, minted)
 	}
 
-	// allocate staking incentives into fee collector account to be moved to on next begin blocker by staking module account.
-	stakingIncentivesAmount, err := k.distributeToModule(ctx, k.feeCollectorName, mintedCoin, proportions.Staking)
-	if err != nil {
-		return err
+	if params.DistributionEpochBlocks <= 1 {
+		if err := k.payDistribution(ctx, params, allocation); err != nil {
+			return err
+		}
+		k.afterEpochMint(ctx, params.MintDenom, allocation.Minted)
+	} else {
+		immediate, deferred := splitAllocation(params, allocation)
+		if err := k.payDistribution(ctx, params, immediate); err != nil {
+			return err
+		}
+
+		pending := k.GetPendingDistribution(ctx)
+		pending.Add(deferred)
+		k.SetPendingDistribution(ctx, pending)
+		if ctx.BlockHeight()%int64(params.DistributionEpochBlocks) == 0 {
+			return k.FlushPendingDistribution(ctx)
+		}
 	}
 
-	// allocate dev rewards to respective accounts from developer vesting module account.
-	devRewardAmount, err := k.distributeDeveloperRewards(ctx, mintedCoin, proportions.DeveloperRewards, params.WeightedDeveloperRewardsReceivers)
-	if err != nil {
+	return nil
+}
+
+// FlushPendingDistribution pays the allocations accumulated in the current distribution epoch.
+// It must be called before the distribution recipients change, as the pending allocations are
+// paid to the recipients of the current parameters.
+func (k Keeper) FlushPendingDistribution(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	pending := k.GetPendingDistribution(ctx)
+
+	// the minted amount is recorded in the distribution totals when minted
+	minted := pending.Minted
+	pending.Minted = math.ZeroInt()
+	if err := k.payDistribution(ctx, params, pending); err != nil {
 		return err
 	}
+	k.DeletePendingDistribution(ctx)
 
-	// subtract from original provision to ensure no coins left over after the allocations
-	communityPoolAmount := mintedCoin.Amount.Sub(grantsAmount).Sub(usageIncentiveAmount).Sub(stakingIncentivesAmount).Sub(devRewardAmount)
-	err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
-	if err != nil {
-		return err
+	k.afterEpochMint(ctx, params.MintDenom, minted)
+	return nil
+}
+
+// afterEpochMint calls the AfterEpochMint hook, if any coins were minted.
+func (k Keeper) afterEpochMint(ctx sdk.Context, denom string, minted math.Int) {
+	if k.hooks == nil || minted.IsNil() || !minted.IsPositive() {
+		return
 	}
+	k.hooks.AfterEpochMint(ctx, sdk.NewCoin(denom, minted))
+}
+
+// allocateMintedCoin computes the amounts of mintedCoin allocated to each recipient, without
+// sending them. The community pool receives whatever is not allocated to the other recipients, so
+// that no coins are left over after the allocations.
+func (k Keeper) allocateMintedCoin(ctx sdk.Context, params types.Params, mintedCoin sdk.Coin) (types.DistributionTotals, error) {
+	allocation := types.DistributionTotals{Minted: mintedCoin.Amount}
 
-	// call an hook after the minting and distribution of new coins
-	if k.hooks != nil {
-		k.hooks.AfterDistributeMintedCoin(ctx)
+	communityPoolAmount := mintedCoin.Amount
+	for _, recipient := range params.DistributionRecipients {
+		amount, err := getProportions(mintedCoin, recipient.Weight)
+		if err != nil {
+			return types.DistributionTotals{}, err
+		}
+
+		switch recipient.TargetType {
+		case types.DistributionTargetAccount, types.DistributionTargetModule:
+		case types.DistributionTargetDeveloperVesting:
+			if err := k.allocateDeveloperRewards(ctx, params, mintedCoin.Amount, amount.Amount, &allocation); err != nil {
+				return types.DistributionTotals{}, err
+			}
+		case types.DistributionTargetCommunityPool:
+			allocation.AddRecipient(recipient.Name, amount.Amount)
+			continue
+		default:
+			return types.DistributionTotals{}, fmt.Errorf("invalid distribution target type: %d", recipient.TargetType)
+		}
+
+		allocation.AddRecipient(recipient.Name, amount.Amount)
+		communityPoolAmount = communityPoolAmount.Sub(amount.Amount)
 	}
+	allocation.CommunityPool = communityPoolAmount
 
-	return err
+	return allocation, nil
 }
 
-// distributeToAddress distributes mintedCoin multiplied by proportion to the recepient account.
-func (k Keeper) distributeToAddress(ctx sdk.Context, recipientAddr string, mintedCoin sdk.Coin, proportion sdk.Dec) (math.Int, error) {
-	distributionCoin, err := getProportions(mintedCoin, proportion)
-	if err != nil {
-		return math.Int{}, err
+// allocateDeveloperRewards allocates the monthly amounts of the developer rewards receivers out of the
+// developer rewards, and the remaining developer rewards to the team reserve. A monthly amount is
+// spread over the blocks of the month so that the allocations of the month sum up to it exactly.
+// In time mode, the vested amounts are scaled like the block provision, by the duration of the block.
+func (k Keeper) allocateDeveloperRewards(ctx sdk.Context, params types.Params, provision, devRewards math.Int, allocation *types.DistributionTotals) error {
+	monthInfo := k.GetTeamVestingMonthInfo(ctx)
+	nominal := provision
+	if params.ReductionMode == types.ReductionModeTime {
+		nominal = k.GetMinter(ctx).BlockProvision(params).Amount
 	}
 
-	recipient, err := sdk.AccAddressFromBech32(recipientAddr)
-	if err != nil {
-		return math.Int{}, err
+	// index of the block in the month, the first month starting at the distribution start block
+	monthStartedBlock := monthInfo.MonthStartedBlock
+	if monthStartedBlock < params.MintingRewardsDistributionStartBlock {
+		monthStartedBlock = params.MintingRewardsDistributionStartBlock
+	}
+	blockInMonth := ctx.BlockHeight() - monthStartedBlock
+	if blockInMonth < 0 {
+		blockInMonth = 0
+	} else if blockInMonth >= monthInfo.OneMonthPeriodInBlocks {
+		blockInMonth = monthInfo.OneMonthPeriodInBlocks - 1
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(distributionCoin)); err != nil {
-		return math.Int{}, err
+	vestedAmount := sdk.ZeroInt()
+	// allocate developer rewards to addresses by weight
+	for _, w := range params.WeightedDeveloperRewardsReceivers {
+		if len(w.MonthlyAmounts) <= int(monthInfo.MonthsSinceGenesis) {
+			continue
+		}
+		if monthInfo.OneMonthPeriodInBlocks <= 0 {
+			return fmt.Errorf("invalid one month period in blocks: %d", monthInfo.OneMonthPeriodInBlocks)
+		}
+		devPortionAmount := types.VestedInBlock(w.MonthlyAmounts[monthInfo.MonthsSinceGenesis], monthInfo.OneMonthPeriodInBlocks, blockInMonth)
+		if !nominal.Equal(provision) {
+			// the scaled amounts are truncated, so they are covered by the scaled developer rewards
+			if !nominal.IsPositive() {
+				continue
+			}
+			devPortionAmount = devPortionAmount.Mul(provision).Quo(nominal)
+		}
+		if devPortionAmount.IsZero() {
+			continue
+		}
+		// the portion of an empty rewards address goes to the team reserve
+		if w.Address != emptyAddressReceiver {
+			vestedAmount = vestedAmount.Add(devPortionAmount)
+			allocation.AddDeveloperVesting(w.Address, devPortionAmount)
+		}
+	}
+
+	if vestedAmount.GT(devRewards) {
+		return fmt.Errorf("developer vesting %s exceeds the developer rewards %s", vestedAmount, devRewards)
 	}
-	return distributionCoin.Amount, nil
+	allocation.TeamReserve = devRewards.Sub(vestedAmount)
+
+	return nil
 }
 
-// distributeToModule distributes mintedCoin multiplied by proportion to the recepientModule account.
-func (k Keeper) distributeToModule(ctx sdk.Context, recipientModule string, mintedCoin sdk.Coin, proportion sdk.Dec) (math.Int, error) {
-	distributionCoin, err := getProportions(mintedCoin, proportion)
-	if err != nil {
-		return math.Int{}, err
+// clipAllocation scales an allocation of the block provision down to the minted amount, so that
+// the recipients, the developer rewards receivers and the team reserve all bear the same share of
+// the clipping. The community pool receives the truncation remainders, as in allocateMintedCoin.
+func clipAllocation(params types.Params, allocation types.DistributionTotals, minted math.Int) types.DistributionTotals {
+	clipped := types.DistributionTotals{Minted: minted, CommunityPool: minted}
+	if !allocation.Minted.IsPositive() {
+		return clipped
 	}
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, sdk.NewCoins(distributionCoin)); err != nil {
-		return math.Int{}, err
+	scale := func(amount math.Int) math.Int {
+		if amount.IsNil() {
+			return math.ZeroInt()
+		}
+		return amount.Mul(minted).Quo(allocation.Minted)
 	}
-	return distributionCoin.Amount, nil
-}
 
-func (k Keeper) distributeDeveloperRewards(ctx sdk.Context, totalMintedCoin sdk.Coin, developerRewardsProportion sdk.Dec, developerRewardsReceivers []types.MonthlyVestingAddress) (math.Int, error) {
+	developerRewards := math.ZeroInt()
+	hasDeveloperVesting := false
+	for _, v := range allocation.Recipients {
+		amount := scale(v.Amount)
+		clipped.AddRecipient(v.Name, amount)
 
-	params := k.GetParams(ctx)
-	totalDevRewards, err := getProportions(totalMintedCoin, developerRewardsProportion)
-	if err != nil {
-		return math.Int{}, err
+		recipient, _ := params.DistributionRecipient(v.Name)
+		switch recipient.TargetType {
+		case types.DistributionTargetCommunityPool:
+			continue
+		case types.DistributionTargetDeveloperVesting:
+			developerRewards = developerRewards.Add(amount)
+			hasDeveloperVesting = true
+		}
+		clipped.CommunityPool = clipped.CommunityPool.Sub(amount)
 	}
 
-	vestedAmount := sdk.ZeroInt()
-	// allocate developer rewards to addresses by weight
-	for _, w := range developerRewardsReceivers {
-		monthInfo := k.GetTeamVestingMonthInfo(ctx)
-		if len(w.MonthlyAmounts) <= int(monthInfo.MonthsSinceGenesis) {
+	// the vested amounts are truncated, so they never exceed the developer rewards
+	vested := math.ZeroInt()
+	for _, v := range allocation.DeveloperVesting {
+		amount := scale(v.Amount)
+		if amount.IsZero() {
 			continue
 		}
-		devPortionAmount := w.MonthlyAmounts[monthInfo.MonthsSinceGenesis].Quo(sdk.NewInt(monthInfo.OneMonthPeriodInBlocks))
-		if devPortionAmount.IsZero() {
+		clipped.AddDeveloperVesting(v.Address, amount)
+		vested = vested.Add(amount)
+	}
+	if hasDeveloperVesting {
+		clipped.TeamReserve = developerRewards.Sub(vested)
+	}
+
+	return clipped
+}
+
+// splitAllocation splits an allocation between the module account recipients, paid every block, and
+// the other recipients, paid at the end of the distribution epoch.
+func splitAllocation(params types.Params, allocation types.DistributionTotals) (immediate, deferred types.DistributionTotals) {
+	immediate = types.DistributionTotals{Minted: allocation.Minted}
+	// the minted amount is also accumulated until the end of the epoch, to be
+	// reported to the AfterEpochMint hook
+	deferred = types.DistributionTotals{
+		Minted:           allocation.Minted,
+		DeveloperVesting: allocation.DeveloperVesting,
+		TeamReserve:      allocation.TeamReserve,
+		CommunityPool:    allocation.CommunityPool,
+	}
+	for _, v := range allocation.Recipients {
+		if recipient, found := params.DistributionRecipient(v.Name); found && recipient.TargetType == types.DistributionTargetModule {
+			immediate.AddRecipient(v.Name, v.Amount)
+		} else {
+			deferred.AddRecipient(v.Name, v.Amount)
+		}
+	}
+	return immediate, deferred
+}
+
+// payDistribution sends the amounts of a distribution to the recipients and records them in the
+// distribution totals. The community pool and developer vesting recipients are paid through the
+// community pool, developer vesting and team reserve amounts, the developer vesting being credited
+// to the receivers to claim.
+func (k Keeper) payDistribution(ctx sdk.Context, params types.Params, distribution types.DistributionTotals) error {
+	for _, v := range distribution.Recipients {
+		recipient, found := params.DistributionRecipient(v.Name)
+		if !found {
+			return distributionError{v.Name, fmt.Errorf("unknown distribution recipient %s", v.Name)}
+		}
+		if v.Amount.IsNil() || v.Amount.IsZero() {
 			continue
 		}
-		devRewardPortionCoins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, devPortionAmount))
-		// fund community pool when rewards address is empty.
-		if w.Address != emptyAddressReceiver {
-			devRewardsAddr, err := sdk.AccAddressFromBech32(w.Address)
+
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, v.Amount))
+		switch recipient.TargetType {
+		case types.DistributionTargetAccount:
+			addr, err := sdk.AccAddressFromBech32(recipient.Target)
 			if err != nil {
-				return math.Int{}, err
+				return distributionError{v.Name, err}
 			}
-			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, devRewardsAddr, devRewardPortionCoins)
-			if err != nil {
-				return math.Int{}, err
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+				return distributionError{v.Name, err}
 			}
+		case types.DistributionTargetModule:
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Target, coins); err != nil {
+				return distributionError{v.Name, err}
+			}
+		}
+	}
 
-			vestedAmount = vestedAmount.Add(devPortionAmount)
+	// the vested developer rewards stay in the module account until claimed
+	for _, v := range distribution.DeveloperVesting {
+		addr, err := sdk.AccAddressFromBech32(v.Address)
+		if err != nil {
+			return distributionError{types.BucketDeveloperVesting, err}
 		}
+		k.AddVestedRewards(ctx, addr, v.Amount)
 	}
-	// send remaining tokens to team reserve
-	vestedTokens := sdk.NewCoin(params.MintDenom, vestedAmount)
-	remainingCoins := totalDevRewards.Sub(vestedTokens)
-	if remainingCoins.IsPositive() {
+
+	if !distribution.TeamReserve.IsNil() && distribution.TeamReserve.IsPositive() {
 		reserve, err := sdk.AccAddressFromBech32(params.TeamReserveAddress)
 		if err != nil {
-			panic(err)
+			return distributionError{types.BucketTeamReserve, err}
 		}
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, distribution.TeamReserve))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reserve, coins); err != nil {
+			return distributionError{types.BucketTeamReserve, err}
+		}
+	}
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx, types.ModuleName, reserve, sdk.Coins{remainingCoins})
-		if err != nil {
-			return math.Int{}, err
+	if !distribution.CommunityPool.IsNil() && distribution.CommunityPool.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, distribution.CommunityPool))
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return distributionError{types.BucketCommunityPool, err}
 		}
 	}
 
-	return totalDevRewards.Amount, nil
+	totals := k.GetDistributionTotals(ctx)
+	totals.Add(distribution)
+	k.SetDistributionTotals(ctx, totals)
+
+	return nil
+}
+
+// pendingAmount returns the amount the mint module account holds for a pending distribution. The
+// community pool and developer vesting recipients are paid through the community pool, developer
+// vesting and team reserve amounts.
+func pendingAmount(params types.Params, pending types.DistributionTotals) math.Int {
+	amount := math.ZeroInt()
+	for _, v := range []math.Int{pending.TeamReserve, pending.CommunityPool} {
+		if !v.IsNil() {
+			amount = amount.Add(v)
+		}
+	}
+	for _, v := range pending.Recipients {
+		if recipient, found := params.DistributionRecipient(v.Name); found &&
+			(recipient.TargetType == types.DistributionTargetAccount || recipient.TargetType == types.DistributionTargetModule) {
+			amount = amount.Add(v.Amount)
+		}
+	}
+	for _, v := range pending.DeveloperVesting {
+		amount = amount.Add(v.Amount)
+	}
+	return amount
 }
 
 func getProportions(mintedCoin sdk.Coin, ratio sdk.Dec) (sdk.Coin, error) {
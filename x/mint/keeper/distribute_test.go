@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,13 +20,7 @@ func (suite *KeeperTestSuite) TestDistributeMintedCoin() {
 		GenesisBlockProvisions:  sdk.NewDec(1000),
 		ReductionPeriodInBlocks: 86400,
 		ReductionFactor:         sdk.NewDecWithPrec(5, 1),
-		DistributionProportions: types.DistributionProportions{
-			GrantsProgram:    sdk.NewDecWithPrec(2, 1),
-			CommunityPool:    sdk.NewDecWithPrec(2, 1),
-			UsageIncentive:   sdk.NewDecWithPrec(2, 1),
-			Staking:          sdk.NewDecWithPrec(2, 1),
-			DeveloperRewards: sdk.NewDecWithPrec(2, 1),
-		},
+		DistributionRecipients:  equalDistributionRecipients(grantsAddr, usageIncentiveAddr),
 		WeightedDeveloperRewardsReceivers: []types.MonthlyVestingAddress{
 			{
 				Address:        dev1Addr.String(),
@@ -36,8 +31,6 @@ func (suite *KeeperTestSuite) TestDistributeMintedCoin() {
 				MonthlyAmounts: []math.Int{sdk.NewInt(3000000), sdk.NewInt(3000000), sdk.NewInt(3000000)},
 			},
 		},
-		UsageIncentiveAddress:                usageIncentiveAddr.String(),
-		GrantsProgramAddress:                 grantsAddr.String(),
 		TeamReserveAddress:                   teamReserveAddr.String(),
 		MintingRewardsDistributionStartBlock: 1,
 		BlocksPerYear:                        5733818,
@@ -102,12 +95,15 @@ func (suite *KeeperTestSuite) TestDistributeMintedCoin() {
 			suite.Require().Equal(communityPoolCoins, sdk.DecCoins{sdk.NewInt64DecCoin(params.MintDenom, 200000)})
 
 			// check developer reward amount is distributed correctly per month: each address registered on weighted
-			dev1AddrCoins := suite.app.BankKeeper.GetBalance(suite.ctx, dev1Addr, params.MintDenom)
+			dev1Claimable := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev1Addr).Claimable
 			dev1Expected := params.WeightedDeveloperRewardsReceivers[0].MonthlyAmounts[tc.monthIndex].Quo(sdk.NewInt(newMonthInfo.OneMonthPeriodInBlocks))
-			suite.Require().Equal(dev1AddrCoins, sdk.NewCoin(params.MintDenom, dev1Expected))
+			suite.Require().Equal(dev1Expected.String(), dev1Claimable.String())
 			dev2Expected := params.WeightedDeveloperRewardsReceivers[1].MonthlyAmounts[tc.monthIndex].Quo(sdk.NewInt(newMonthInfo.OneMonthPeriodInBlocks))
-			dev2AddrCoins := suite.app.BankKeeper.GetBalance(suite.ctx, dev2Addr, params.MintDenom)
-			suite.Require().Equal(dev2AddrCoins, sdk.NewCoin(params.MintDenom, dev2Expected))
+			dev2Claimable := suite.app.MintKeeper.GetVestingRewards(suite.ctx, dev2Addr).Claimable
+			suite.Require().Equal(dev2Expected.String(), dev2Claimable.String())
+
+			// the vested rewards stay in the mint module account until claimed
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, dev1Addr, params.MintDenom).IsZero())
 
 			// check team reserve balance
 			teamReserveAddrCoins := suite.app.BankKeeper.GetBalance(suite.ctx, teamReserveAddr, params.MintDenom)
@@ -118,3 +114,155 @@ func (suite *KeeperTestSuite) TestDistributeMintedCoin() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestDistributionTotals() {
+	suite.SetupTest()
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	monthInfo := suite.app.MintKeeper.GetTeamVestingMonthInfo(suite.ctx)
+	mintedCoin := sdk.NewInt64Coin(params.MintDenom, 100_000_000)
+
+	grantsAddr := sdk.MustAccAddressFromBech32(params.DistributionRecipients[0].Target)
+	teamReserveAddr := sdk.MustAccAddressFromBech32(params.TeamReserveAddress)
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin)))
+		suite.Require().NoError(suite.app.MintKeeper.DistributeMintedCoin(suite.ctx, mintedCoin))
+	}
+
+	totals := suite.app.MintKeeper.GetDistributionTotals(suite.ctx)
+	suite.Require().Equal(mintedCoin.Amount.MulRaw(2).String(), totals.Minted.String())
+	suite.Require().Equal(suite.app.BankKeeper.GetBalance(suite.ctx, grantsAddr, params.MintDenom).Amount.String(), totals.RecipientTotal(types.RecipientGrantsProgram).String())
+	suite.Require().Equal(suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, params.MintDenom).Amount.String(), totals.RecipientTotal(types.RecipientStaking).String())
+	suite.Require().Equal(suite.app.BankKeeper.GetBalance(suite.ctx, teamReserveAddr, params.MintDenom).Amount.String(), totals.TeamReserve.String())
+	suite.Require().Equal(
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt().String(),
+		totals.CommunityPool.String(),
+	)
+
+	vested := math.ZeroInt()
+	for _, receiver := range params.WeightedDeveloperRewardsReceivers {
+		amount := receiver.MonthlyAmounts[monthInfo.MonthsSinceGenesis].Quo(sdk.NewInt(monthInfo.OneMonthPeriodInBlocks)).MulRaw(2)
+		if amount.IsZero() {
+			continue
+		}
+		vested = vested.Add(amount)
+		suite.Require().Contains(totals.DeveloperVesting, types.DeveloperVestingTotal{Address: receiver.Address, Amount: amount})
+	}
+
+	// the developer rewards allocation is split between the vesting and the team reserve, and the
+	// community pool receives its allocation plus the truncation remainders
+	suite.Require().Equal(
+		vested.Add(totals.TeamReserve).String(),
+		totals.RecipientTotal(types.RecipientDeveloperRewards).String(),
+	)
+	sum := totals.RecipientTotal(types.RecipientGrantsProgram).
+		Add(totals.RecipientTotal(types.RecipientUsageIncentive)).
+		Add(totals.RecipientTotal(types.RecipientStaking)).
+		Add(vested).Add(totals.TeamReserve).Add(totals.CommunityPool)
+	suite.Require().Equal(totals.Minted.String(), sum.String())
+
+	// totals survive a genesis export and import
+	genesis := suite.app.MintKeeper.ExportGenesis(suite.ctx)
+	suite.SetupTest()
+	suite.app.MintKeeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(totals, suite.app.MintKeeper.GetDistributionTotals(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDistributionEpoch() {
+	type snapshot struct {
+		totals   types.DistributionTotals
+		balances map[string]math.Int
+	}
+
+	// run mints and distributes for blocks 1 to 20 with the given distribution epoch
+	run := func(epochBlocks uint64, checkBlock func(height int64, params types.Params)) snapshot {
+		suite.SetupTest()
+		params := suite.app.MintKeeper.GetParams(suite.ctx)
+		params.DistributionEpochBlocks = epochBlocks
+		suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+		for height := int64(1); height <= 20; height++ {
+			suite.ctx = suite.ctx.WithBlockHeight(height)
+			suite.app.MintKeeper.EndBlocker(suite.ctx)
+			checkBlock(height, params)
+		}
+
+		balances := make(map[string]math.Int)
+		for _, r := range params.DistributionRecipients {
+			if r.TargetType == types.DistributionTargetAccount {
+				balances[r.Name] = suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(r.Target), params.MintDenom).Amount
+			}
+		}
+		balances[types.RecipientStaking] = suite.app.BankKeeper.GetBalance(suite.ctx, suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), params.MintDenom).Amount
+		balances[types.RecipientCommunityPool] = suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt()
+		balances["team_reserve"] = suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(params.TeamReserveAddress), params.MintDenom).Amount
+		return snapshot{totals: suite.app.MintKeeper.GetDistributionTotals(suite.ctx), balances: balances}
+	}
+
+	perBlock := run(0, func(int64, types.Params) {
+		suite.Require().Equal(types.NewDistributionTotals(), suite.app.MintKeeper.GetPendingDistribution(suite.ctx))
+	})
+
+	perEpoch := run(10, func(height int64, params types.Params) {
+		mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+		mintBalance := suite.app.BankKeeper.GetBalance(suite.ctx, mintAddr, params.MintDenom).Amount
+		pending := suite.app.MintKeeper.GetPendingDistribution(suite.ctx)
+		claimable := math.ZeroInt()
+		for _, rewards := range suite.app.MintKeeper.GetAllVestingRewards(suite.ctx) {
+			claimable = claimable.Add(rewards.Claimable)
+		}
+		if height%10 == 0 {
+			// only the vested developer rewards are left in the mint module account
+			suite.Require().Equal(claimable.String(), mintBalance.String())
+			suite.Require().Equal(types.NewDistributionTotals(), pending)
+			return
+		}
+
+		// the staking share is paid every block, the rest stays in the mint module account
+		suite.Require().True(pending.RecipientTotal(types.RecipientStaking).IsZero())
+		suite.Require().True(pending.RecipientTotal(types.RecipientGrantsProgram).IsPositive())
+		staking := suite.app.MintKeeper.GetDistributionTotals(suite.ctx).RecipientTotal(types.RecipientStaking)
+		feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		suite.Require().Equal(staking.String(), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, params.MintDenom).Amount.String())
+		suite.Require().True(mintBalance.GT(claimable))
+	})
+
+	// recipients are recorded in payment order, which differs between the modes
+	suite.Require().Equal(perBlock.totals.Minted, perEpoch.totals.Minted)
+	suite.Require().Equal(perBlock.totals.TeamReserve, perEpoch.totals.TeamReserve)
+	suite.Require().Equal(perBlock.totals.CommunityPool, perEpoch.totals.CommunityPool)
+	suite.Require().ElementsMatch(perBlock.totals.Recipients, perEpoch.totals.Recipients)
+	suite.Require().ElementsMatch(perBlock.totals.DeveloperVesting, perEpoch.totals.DeveloperVesting)
+	suite.Require().Equal(len(perBlock.balances), len(perEpoch.balances))
+	for name, balance := range perBlock.balances {
+		suite.Require().Equal(balance.String(), perEpoch.balances[name].String(), name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributionEpochFlushedOnParamsUpdate() {
+	suite.SetupTest()
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.DistributionEpochBlocks = 10
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+	}
+	pending := suite.app.MintKeeper.GetPendingDistribution(suite.ctx)
+	grants := pending.RecipientTotal(types.RecipientGrantsProgram)
+	suite.Require().True(grants.IsPositive())
+
+	// removing the grants program pays its pending allocation before the update
+	newParams := params
+	newParams.DistributionRecipients = append([]types.DistributionRecipient{}, params.DistributionRecipients[1:]...)
+	newParams.DistributionRecipients[len(newParams.DistributionRecipients)-1].Weight = sdk.NewDecWithPrec(20, 2)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(suite.app.MintKeeper.GetAuthority(), newParams))
+	suite.Require().NoError(err)
+
+	grantsAddr := sdk.MustAccAddressFromBech32(params.DistributionRecipients[0].Target)
+	suite.Require().Equal(grants.String(), suite.app.BankKeeper.GetBalance(suite.ctx, grantsAddr, params.MintDenom).Amount.String())
+	suite.Require().Equal(types.NewDistributionTotals(), suite.app.MintKeeper.GetPendingDistribution(suite.ctx))
+}
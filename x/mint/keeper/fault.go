@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// distributionError is the failure to pay a distribution bucket.
+type distributionError struct {
+	bucket string
+	err    error
+}
+
+func (e distributionError) Error() string {
+	return fmt.Sprintf("failed to pay %s: %s", e.bucket, e.err)
+}
+
+func (e distributionError) Unwrap() error {
+	return e.err
+}
+
+// faultyBucket returns the bucket a minting or distribution error comes from.
+func faultyBucket(err error) string {
+	var distrErr distributionError
+	if errors.As(err, &distrErr) {
+		return distrErr.bucket
+	}
+	return types.BucketMint
+}
+
+// GetLastMintFault returns the last failure to mint or distribute the minted
+// coins, if any.
+func (k Keeper) GetLastMintFault(ctx sdk.Context) (types.MintFault, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastMintFaultKey)
+	if bz == nil {
+		return types.MintFault{}, false
+	}
+
+	var fault types.MintFault
+	k.cdc.MustUnmarshal(bz, &fault)
+	return fault, true
+}
+
+// SetLastMintFault sets the last failure to mint or distribute the minted
+// coins.
+func (k Keeper) SetLastMintFault(ctx sdk.Context, fault types.MintFault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastMintFaultKey, k.cdc.MustMarshal(&fault))
+}
+
+// recordMintFault stores a mint fault and emits the corresponding event.
+func (k Keeper) recordMintFault(ctx sdk.Context, cause error, redirected math.Int) {
+	fault := types.MintFault{
+		Height:     ctx.BlockHeight(),
+		Bucket:     faultyBucket(cause),
+		Error:      cause.Error(),
+		Redirected: redirected,
+	}
+	k.SetLastMintFault(ctx, fault)
+	k.Logger(ctx).Error("failed to distribute the minted coins, redirecting them to the community pool",
+		"bucket", fault.Bucket, "redirected", redirected, "err", cause)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintFault{
+		Bucket:     fault.Bucket,
+		Error:      fault.Error,
+		Redirected: redirected,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit the mint fault event", "err", err)
+	}
+}
+
+// mintToCommunityPool mints the coins of a block whose distribution failed
+// and sends them all to the community pool.
+func (k Keeper) mintToCommunityPool(ctx sdk.Context, params types.Params, minted math.Int) error {
+	if !minted.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, minted))
+	if err := k.MintCoins(ctx, coins); err != nil {
+		return err
+	}
+	if err := k.payDistribution(ctx, params, types.DistributionTotals{Minted: minted, CommunityPool: minted}); err != nil {
+		return err
+	}
+	k.afterEpochMint(ctx, params.MintDenom, minted)
+	return nil
+}
+
+// redirectPendingDistribution sends the coins held for the pending distribution
+// to the community pool, when they cannot be paid to their recipients.
+func (k Keeper) redirectPendingDistribution(ctx sdk.Context, params types.Params) (math.Int, error) {
+	pending := k.GetPendingDistribution(ctx)
+	amount := pendingAmount(params, pending)
+	// the minted amount was recorded in the distribution totals when minted
+	if err := k.payDistribution(ctx, params, types.DistributionTotals{CommunityPool: amount}); err != nil {
+		return math.Int{}, err
+	}
+	k.DeletePendingDistribution(ctx)
+
+	k.afterEpochMint(ctx, params.MintDenom, pending.Minted)
+	return amount, nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestMintFault() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	querier := keeper.NewQuerier(mintKeeper)
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	invariant := keeper.AllInvariants(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	denom := params.MintDenom
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	communityPool := func() math.Int {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).TruncateInt()
+	}
+
+	// the team reserve cannot receive funds, as the parameters predate the validation
+	validReserve := params.TeamReserveAddress
+	params.TeamReserveAddress = suite.app.AccountKeeper.GetModuleAddress(disttypes.ModuleName).String()
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	// the coins of the block go to the community pool
+	poolBefore := communityPool()
+	stakingBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+	mintedBefore := mintKeeper.GetDistributionTotals(suite.ctx).Minted
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() { mintKeeper.EndBlocker(suite.ctx) })
+	msg, broken := invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	minted := mintKeeper.GetDistributionTotals(suite.ctx).Minted.Sub(mintedBefore)
+	suite.Require().True(minted.IsPositive())
+	suite.Require().Equal(minted.String(), communityPool().Sub(poolBefore).String())
+	suite.Require().Equal(stakingBefore.String(), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.String())
+
+	// the fault is recorded and reported
+	fault, found := mintKeeper.GetLastMintFault(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), fault.Height)
+	suite.Require().Equal(types.BucketTeamReserve, fault.Bucket)
+	suite.Require().Equal(minted.String(), fault.Redirected.String())
+	res, err := querier.LastMintFault(sdk.WrapSDKContext(suite.ctx), &types.QueryLastMintFaultRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&fault, res.Fault)
+
+	emitted := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventMintFault{}) {
+			emitted = true
+		}
+	}
+	suite.Require().True(emitted)
+
+	// the parameters are validated at update time
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().ErrorIs(err, types.ErrInvalidDistributionRecipient)
+	invalidDeveloper := mintKeeper.GetParams(suite.ctx)
+	invalidDeveloper.TeamReserveAddress = validReserve
+	invalidDeveloper.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: feeCollector.String(), MonthlyAmounts: []math.Int{sdk.NewInt(1000)}},
+	}
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), invalidDeveloper))
+	suite.Require().ErrorIs(err, types.ErrInvalidDistributionRecipient)
+
+	// the distribution resumes once the team reserve is fixed
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	params.TeamReserveAddress = validReserve
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	mintKeeper.EndBlocker(suite.ctx)
+	msg, broken = invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.GT(stakingBefore))
+	last, found := mintKeeper.GetLastMintFault(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(fault, last)
+}
+
+func (suite *KeeperTestSuite) TestMintFaultOnPendingDistribution() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	invariant := keeper.AllInvariants(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	params.DistributionEpochBlocks = 10
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		mintKeeper.EndBlocker(suite.ctx)
+	}
+	pending := mintKeeper.GetPendingDistribution(suite.ctx)
+	suite.Require().True(pending.RecipientTotal(types.RecipientGrantsProgram).IsPositive())
+
+	// the grants program cannot receive funds any more
+	params.DistributionRecipients = append([]types.DistributionRecipient{}, params.DistributionRecipients...)
+	suite.Require().Equal(types.RecipientGrantsProgram, params.DistributionRecipients[0].Name)
+	params.DistributionRecipients[0].Target = suite.app.AccountKeeper.GetModuleAddress(disttypes.ModuleName).String()
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	// the update pays the pending distribution, held by the mint module account
+	// besides the vested developer rewards, to the community pool
+	mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	expected := suite.app.BankKeeper.GetBalance(suite.ctx, mintAddr, params.MintDenom).Amount
+	for _, rewards := range mintKeeper.GetAllVestingRewards(suite.ctx) {
+		expected = expected.Sub(rewards.Claimable)
+	}
+	suite.Require().True(expected.IsPositive())
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt()
+	suite.ctx = suite.ctx.WithBlockHeight(6)
+	newParams := suite.app.MintKeeper.GetParams(suite.ctx)
+	newParams.DistributionRecipients = append([]types.DistributionRecipient{}, newParams.DistributionRecipients...)
+	newParams.DistributionRecipients[0].Target = mintKeeper.GetParams(suite.ctx).TeamReserveAddress
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), newParams))
+	suite.Require().NoError(err)
+
+	poolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt()
+	suite.Require().Equal(expected.String(), poolAfter.Sub(poolBefore).String())
+	suite.Require().Equal(types.NewDistributionTotals(), mintKeeper.GetPendingDistribution(suite.ctx))
+	fault, found := mintKeeper.GetLastMintFault(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(types.RecipientGrantsProgram, fault.Bucket)
+	suite.Require().Equal(expected.String(), fault.Redirected.String())
+	mintKeeper.EndBlocker(suite.ctx)
+	msg, broken := invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestMintFaultOverEpochs() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	invariant := keeper.AllInvariants(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	params.DistributionEpochBlocks = 10
+	// the grants program cannot receive funds, failing the flush at every epoch boundary
+	params.DistributionRecipients = append([]types.DistributionRecipient{}, params.DistributionRecipients...)
+	suite.Require().Equal(types.RecipientGrantsProgram, params.DistributionRecipients[0].Name)
+	params.DistributionRecipients[0].Target = suite.app.AccountKeeper.GetModuleAddress(disttypes.ModuleName).String()
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	held := func() math.Int {
+		amount := suite.app.BankKeeper.GetBalance(suite.ctx, mintAddr, params.MintDenom).Amount
+		for _, rewards := range mintKeeper.GetAllVestingRewards(suite.ctx) {
+			amount = amount.Sub(rewards.Claimable)
+		}
+		return amount
+	}
+	communityPool := func() math.Int {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt()
+	}
+
+	for height := int64(1); height <= 35; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		poolBefore := communityPool()
+		mintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+		if height%10 != 0 {
+			continue
+		}
+
+		// the allocations pending from the previous blocks of the epoch are redirected
+		// along with the coins of the block, so the mint module account holds nothing
+		suite.Require().Equal(types.NewDistributionTotals(), mintKeeper.GetPendingDistribution(suite.ctx))
+		suite.Require().True(held().IsZero(), held().String())
+		fault, found := mintKeeper.GetLastMintFault(suite.ctx)
+		suite.Require().True(found)
+		suite.Require().Equal(height, fault.Height)
+		suite.Require().Equal(types.RecipientGrantsProgram, fault.Bucket)
+		suite.Require().Equal(fault.Redirected.String(), communityPool().Sub(poolBefore).String())
+	}
+
+	// only the allocations of the current epoch are pending
+	pending := mintKeeper.GetPendingDistribution(suite.ctx)
+	suite.Require().True(pending.RecipientTotal(types.RecipientGrantsProgram).IsPositive())
+	suite.Require().True(held().IsPositive())
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnFees burns the fee_burn_ratio share of the transaction fees of the mint
+// denom held by the fee collector, recording it in the burn ledger as burnt by
+// the fee collector, and adds the fees of the block to the fee burn statistics. It returns the
+// burnt amount. A failing burn is logged and skipped, so that it does not halt
+// the chain.
+func (k Keeper) BurnFees(ctx sdk.Context, params types.Params) math.Int {
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	fees := k.bankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+
+	burnt := math.ZeroInt()
+	if !params.FeeBurnRatio.IsNil() && fees.IsPositive() {
+		burnt = params.FeeBurnRatio.MulInt(fees).TruncateInt()
+	}
+	if burnt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.MintDenom, burnt))
+		if err := k.burnFromFeeCollector(ctx, coins); err != nil {
+			k.Logger(ctx).Error("failed to burn the transaction fees", "amount", coins, "err", err)
+			burnt = math.ZeroInt()
+		} else {
+			k.RecordBurn(ctx, feeCollector, coins)
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnFees{Collected: fees, Burnt: burnt}); err != nil {
+				k.Logger(ctx).Error("failed to emit the fee burn event", "err", err)
+			}
+		}
+	}
+
+	k.recordFeeBurn(ctx, params, fees, burnt)
+	return burnt
+}
+
+// burnFromFeeCollector burns coins of the fee collector through the mint module
+// account, writing the state changes only if both steps succeed.
+func (k Keeper) burnFromFeeCollector(ctx sdk.Context, coins sdk.Coins) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// recordFeeBurn adds the fees collected and burnt in the block to the current
+// fee burn statistics epoch, starting a new epoch every fee_burn_epoch_blocks
+// blocks and pruning the epochs beyond the fee_burn_retention_epochs latest
+// ones when it does. Nothing is recorded when the epoch length is 0.
+func (k Keeper) recordFeeBurn(ctx sdk.Context, params types.Params, collected, burnt math.Int) {
+	if params.FeeBurnEpochBlocks == 0 {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	epoch, found := k.GetLastFeeBurnEpoch(ctx)
+	newEpoch := !found || height >= epoch.StartHeight+int64(params.FeeBurnEpochBlocks)
+	if newEpoch {
+		epoch = types.FeeBurnEpoch{
+			StartHeight: height,
+			Collected:   math.ZeroInt(),
+			Burnt:       math.ZeroInt(),
+		}
+	}
+	epoch.EndHeight = height
+	epoch.Collected = epoch.Collected.Add(collected)
+	epoch.Burnt = epoch.Burnt.Add(burnt)
+	k.SetFeeBurnEpoch(ctx, epoch)
+
+	if newEpoch && params.FeeBurnRetentionEpochs > 0 {
+		k.pruneFeeBurnEpochs(ctx, params.FeeBurnRetentionEpochs)
+	}
+}
+
+// pruneFeeBurnEpochs deletes the fee burn statistics epochs older than the
+// retained latest ones.
+func (k Keeper) pruneFeeBurnEpochs(ctx sdk.Context, retained uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeBurnEpochKeyPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	var expired [][]byte
+	for kept := uint64(0); iterator.Valid(); iterator.Next() {
+		if kept < retained {
+			kept++
+			continue
+		}
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// GetLastFeeBurnEpoch returns the latest fee burn statistics epoch.
+func (k Keeper) GetLastFeeBurnEpoch(ctx sdk.Context) (types.FeeBurnEpoch, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeBurnEpochKeyPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.FeeBurnEpoch{}, false
+	}
+
+	var epoch types.FeeBurnEpoch
+	k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+	return epoch, true
+}
+
+// SetFeeBurnEpoch stores a fee burn statistics epoch.
+func (k Keeper) SetFeeBurnEpoch(ctx sdk.Context, epoch types.FeeBurnEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeBurnEpochKey(epoch.StartHeight), k.cdc.MustMarshal(&epoch))
+}
+
+// GetAllFeeBurnEpochs returns all fee burn statistics epochs ordered by start
+// height.
+func (k Keeper) GetAllFeeBurnEpochs(ctx sdk.Context) []types.FeeBurnEpoch {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeBurnEpochKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	epochs := []types.FeeBurnEpoch{}
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.FeeBurnEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, epoch)
+	}
+	return epochs
+}
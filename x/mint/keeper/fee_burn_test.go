@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnFees() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	querier := keeper.NewQuerier(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	denom := params.MintDenom
+	params.FeeBurnRatio = sdk.NewDecWithPrec(25, 2)
+	params.FeeBurnEpochBlocks = 3
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// collect sends the fees of a block to the fee collector
+	collect := func(height, amount int64) {
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		if amount > 0 {
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+			suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+		}
+	}
+	// allocate empties the fee collector as the distribution module does
+	allocate := func() {
+		fees := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, disttypes.ModuleName, fees))
+	}
+
+	// the share of the fees is burnt and recorded as burnt by the fee collector
+	collect(10, 1000)
+	supply := mintKeeper.GetTotalSupply(suite.ctx)
+	suite.Require().Equal("250", mintKeeper.BurnFees(suite.ctx, params).String())
+	suite.Require().Equal("750", suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.String())
+	suite.Require().Equal(supply.SubRaw(250).String(), mintKeeper.GetTotalSupply(suite.ctx).String())
+	suite.Require().Equal("250", mintKeeper.GetTotalBurntByDenom(suite.ctx, denom).Amount.String())
+	suite.Require().Equal("250", mintKeeper.GetBurntByAddressAndDenom(suite.ctx, feeCollector, denom).Amount.String())
+	history := mintKeeper.GetBurnHistory(suite.ctx)
+	suite.Require().Len(history, 1)
+	suite.Require().Equal(feeCollector.String(), history[0].Burner)
+	suite.Require().Equal(int64(10), history[0].Height)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().NotEmpty(events)
+	suite.Require().Equal("teritori.mint.v1beta1.EventBurnFees", events[len(events)-1].Type)
+	allocate()
+
+	collect(11, 400)
+	suite.Require().Equal("100", mintKeeper.BurnFees(suite.ctx, params).String())
+	allocate()
+	collect(12, 0)
+	suite.Require().True(mintKeeper.BurnFees(suite.ctx, params).IsZero())
+
+	// the fees are burnt before the minted coins are distributed
+	collect(13, 2000)
+	mintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal("850", mintKeeper.GetTotalBurntByDenom(suite.ctx, denom).Amount.String())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.GT(sdk.NewInt(1500)))
+
+	// the statistics are grouped by epoch
+	expected := []types.FeeBurnEpoch{
+		{StartHeight: 10, EndHeight: 12, Collected: sdk.NewInt(1400), Burnt: sdk.NewInt(350)},
+		{StartHeight: 13, EndHeight: 13, Collected: sdk.NewInt(2000), Burnt: sdk.NewInt(500)},
+	}
+	suite.Require().Equal(expected, mintKeeper.GetAllFeeBurnEpochs(suite.ctx))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := querier.FeeBurnStats(ctx, &types.QueryFeeBurnStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.Epochs)
+	res, err = querier.FeeBurnStats(ctx, &types.QueryFeeBurnStatsRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected[1:], res.Epochs)
+
+	// the statistics are exported in genesis
+	genesis := mintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(expected, genesis.FeeBurnEpochs)
+
+	// no fees are burnt without a ratio, and no statistics are recorded without epochs
+	allocate()
+	params.FeeBurnRatio = sdk.ZeroDec()
+	params.FeeBurnEpochBlocks = 0
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	collect(20, 1000)
+	suite.Require().True(mintKeeper.BurnFees(suite.ctx, params).IsZero())
+	suite.Require().Equal("1000", suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.String())
+	suite.Require().Equal(expected, mintKeeper.GetAllFeeBurnEpochs(suite.ctx))
+
+	// only the latest epochs are kept with a retention
+	allocate()
+	params.FeeBurnEpochBlocks = 3
+	params.FeeBurnRetentionEpochs = 2
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	collect(21, 100)
+	mintKeeper.BurnFees(suite.ctx, params)
+	suite.Require().Equal([]types.FeeBurnEpoch{
+		expected[1],
+		{StartHeight: 21, EndHeight: 21, Collected: sdk.NewInt(100), Burnt: sdk.ZeroInt()},
+	}, mintKeeper.GetAllFeeBurnEpochs(suite.ctx))
+	allocate()
+	collect(22, 100)
+	mintKeeper.BurnFees(suite.ctx, params)
+	suite.Require().Len(mintKeeper.GetAllFeeBurnEpochs(suite.ctx), 2)
+	allocate()
+	collect(24, 100)
+	mintKeeper.BurnFees(suite.ctx, params)
+	epochs := mintKeeper.GetAllFeeBurnEpochs(suite.ctx)
+	suite.Require().Len(epochs, 2)
+	suite.Require().Equal(int64(21), epochs[0].StartHeight)
+	suite.Require().Equal(int64(24), epochs[1].StartHeight)
+
+	// the ratio is at most 1
+	params.FeeBurnRatio = sdk.NewDecWithPrec(11, 1)
+	suite.Require().Error(mintKeeper.SetParams(suite.ctx, params))
+}
@@ -14,13 +14,68 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 
 	data.Minter.BlockProvisions = data.Params.GenesisBlockProvisions
 	k.SetMinter(ctx, data.Minter)
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 
 	// The call to GetModuleAccount creates a module account if it does not exist.
 	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 
 	k.SetLastReductionBlockNum(ctx, data.ReductionStartedBlock)
+	// a chain starting in time mode starts its reduction period at genesis
+	reductionStartedTime := data.ReductionStartedTime
+	if reductionStartedTime.IsZero() {
+		reductionStartedTime = ctx.BlockTime()
+	}
+	k.SetLastReductionTime(ctx, reductionStartedTime)
 	k.SetTeamVestingMonthInfo(ctx, data.MonthInfo)
+
+	k.AddTotalBurnt(ctx, data.TotalBurnt)
+	for _, total := range data.BurntByAddress {
+		burner := sdk.MustAccAddressFromBech32(total.Address)
+		for _, coin := range total.Amount {
+			k.setBurntByAddress(ctx, burner, coin)
+		}
+	}
+	var burnSeq uint64
+	for _, record := range data.BurnHistory {
+		k.SetBurnRecord(ctx, record)
+		if record.Id >= burnSeq {
+			burnSeq = record.Id + 1
+		}
+	}
+	k.SetBurnSequence(ctx, burnSeq)
+
+	totals := types.NewDistributionTotals()
+	totals.Add(data.DistributionTotals)
+	k.SetDistributionTotals(ctx, totals)
+
+	pending := types.NewDistributionTotals()
+	pending.Add(data.PendingDistribution)
+	k.SetPendingDistribution(ctx, pending)
+
+	for _, rewards := range data.VestingRewards {
+		k.SetVestingRewards(ctx, rewards)
+	}
+	var rotationSeq uint64
+	for _, rotation := range data.VestingReceiverRotations {
+		k.SetVestingReceiverRotation(ctx, rotation)
+		if rotation.Id >= rotationSeq {
+			rotationSeq = rotation.Id + 1
+		}
+	}
+	k.SetVestingReceiverRotationSequence(ctx, rotationSeq)
+
+	for _, snapshot := range data.InflationSnapshots {
+		k.SetInflationSnapshot(ctx, snapshot)
+	}
+	for _, epoch := range data.FeeBurnEpochs {
+		k.SetFeeBurnEpoch(ctx, epoch)
+	}
+	k.SetMintingPause(ctx, data.MintingPause)
+
+	// the minter starts again from the genesis block provisions
+	k.ResetEmissionCheckpoint(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -34,5 +89,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	lastReductionBlock := k.GetLastReductionBlockNum(ctx)
 	monthInfo := k.GetTeamVestingMonthInfo(ctx)
-	return types.NewGenesisState(minter, params, lastReductionBlock, monthInfo)
+	genesis := types.NewGenesisState(minter, params, lastReductionBlock, monthInfo)
+	genesis.ReductionStartedTime = k.GetLastReductionTime(ctx)
+	genesis.TotalBurnt = k.GetTotalBurnt(ctx)
+	genesis.BurntByAddress = k.GetAllBurntByAddress(ctx)
+	genesis.BurnHistory = k.GetBurnHistory(ctx)
+	genesis.DistributionTotals = k.GetDistributionTotals(ctx)
+	genesis.PendingDistribution = k.GetPendingDistribution(ctx)
+	genesis.VestingRewards = k.GetAllVestingRewards(ctx)
+	genesis.VestingReceiverRotations = k.GetAllVestingReceiverRotations(ctx)
+	genesis.InflationSnapshots = k.GetAllInflationSnapshots(ctx)
+	genesis.FeeBurnEpochs = k.GetAllFeeBurnEpochs(ctx)
+	genesis.MintingPause = k.GetMintingPause(ctx)
+	return genesis
 }
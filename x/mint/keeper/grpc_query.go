@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/math"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Querier{}
@@ -39,28 +42,336 @@ func (q Querier) BlockProvisions(c context.Context, _ *types.QueryBlockProvision
 // Inflation returns minter.Inflation of the mint module.
 func (q Querier) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	minter := q.Keeper.GetMinter(ctx)
-	params := q.Keeper.GetParams(ctx)
-	mintDenomSupply := q.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
 
-	inflation := minter.BlockProvisions.
-		Mul(math.LegacyNewDec(int64(params.BlocksPerYear))).
-		Quo(math.LegacyNewDecFromInt(mintDenomSupply))
+	return &types.QueryInflationResponse{Inflation: q.Keeper.Inflation(ctx)}, nil
+}
+
+// StakingAPR returns the current staking APR, net of the community tax.
+func (q Querier) StakingAPR(c context.Context, req *types.QueryStakingAPRRequest) (*types.QueryStakingAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryInflationResponse{Inflation: inflation}, nil
+	return &types.QueryStakingAPRResponse{Apr: q.Keeper.StakingAPR(ctx, req.IncludeFees)}, nil
 }
 
-// StakingAPR returns the current staking APR value.
-func (q Querier) StakingAPR(c context.Context, _ *types.QueryStakingAPRRequest) (*types.QueryStakingAPRResponse, error) {
+// ValidatorAPR returns the current staking APR of the delegators of a
+// validator, net of the community tax and of the validator commission.
+func (q Querier) ValidatorAPR(c context.Context, req *types.QueryValidatorAPRRequest) (*types.QueryValidatorAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	minter := q.Keeper.GetMinter(ctx)
-	params := q.Keeper.GetParams(ctx)
-	totalStaked := q.stakingKeeper.TotalBondedTokens(ctx)
+	apr, found := q.Keeper.ValidatorAPR(ctx, valAddr, req.IncludeFees)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	return &types.QueryValidatorAPRResponse{Apr: apr}, nil
+}
+
+// TotalBurnt returns the cumulative amount of burnt tokens per denom.
+func (q Querier) TotalBurnt(c context.Context, req *types.QueryTotalBurntRequest) (*types.QueryTotalBurntResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.TotalBurntKeyPrefix)
+
+	amount := []sdk.Coin{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var coin sdk.Coin
+		if err := q.cdc.Unmarshal(value, &coin); err != nil {
+			return err
+		}
+		amount = append(amount, coin)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalBurntResponse{Amount: amount, Pagination: pageRes}, nil
+}
+
+// BurntByAddress returns the cumulative amount of tokens burnt by an account.
+func (q Querier) BurntByAddress(c context.Context, req *types.QueryBurntByAddressRequest) (*types.QueryBurntByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetBurntByAddressPrefix(addr))
+
+	amount := []sdk.Coin{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var coin sdk.Coin
+		if err := q.cdc.Unmarshal(value, &coin); err != nil {
+			return err
+		}
+		amount = append(amount, coin)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurntByAddressResponse{Amount: amount, Pagination: pageRes}, nil
+}
+
+// BurnHistory returns the history of burns ordered by height.
+func (q Querier) BurnHistory(c context.Context, req *types.QueryBurnHistoryRequest) (*types.QueryBurnHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.BurnHistoryKeyPrefix)
+
+	records := []types.BurnRecord{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.BurnRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalSupply returns the total supply of the mint denom.
+func (q Querier) TotalSupply(c context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalSupplyResponse{Amount: q.Keeper.GetTotalSupply(ctx)}, nil
+}
+
+// CirculatingSupply returns the supply of the mint denom that is not locked.
+func (q Querier) CirculatingSupply(c context.Context, _ *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCirculatingSupplyResponse{Amount: q.Keeper.GetCirculatingSupply(ctx)}, nil
+}
+
+// BurntSupply returns the cumulative amount of the mint denom burnt.
+func (q Querier) BurntSupply(c context.Context, _ *types.QueryBurntSupplyRequest) (*types.QueryBurntSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurntSupplyResponse{Amount: q.Keeper.GetBurntSupply(ctx)}, nil
+}
+
+// EmissionSchedule returns the projected emissions of the upcoming reduction
+// periods.
+func (q Querier) EmissionSchedule(c context.Context, req *types.QueryEmissionScheduleRequest) (*types.QueryEmissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "count must be positive")
+	}
+	if req.FromPeriod >= types.MaxEmissionSchedulePeriods || req.Count > types.MaxEmissionSchedulePeriods-req.FromPeriod {
+		return nil, status.Errorf(codes.InvalidArgument, "emission schedule is limited to %d periods", types.MaxEmissionSchedulePeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	periods := types.EmissionSchedule(
+		q.Keeper.GetParams(ctx),
+		q.Keeper.GetMinter(ctx),
+		q.Keeper.GetLastReductionBlockNum(ctx),
+		q.Keeper.GetTeamVestingMonthInfo(ctx),
+		req.FromPeriod,
+		req.Count,
+	)
 
-	stakingApr := minter.BlockProvisions.
-		Mul(math.LegacyNewDec(int64(params.BlocksPerYear))).
-		Mul(params.DistributionProportions.Staking).
-		Quo(math.LegacyNewDecFromInt(totalStaked))
+	return &types.QueryEmissionScheduleResponse{Periods: periods}, nil
+}
+
+// DistributionTotals returns the cumulative amounts minted and sent to each
+// distribution bucket.
+func (q Querier) DistributionTotals(c context.Context, _ *types.QueryDistributionTotalsRequest) (*types.QueryDistributionTotalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	totals := q.Keeper.GetDistributionTotals(ctx)
+
+	return &types.QueryDistributionTotalsResponse{Totals: totals}, nil
+}
+
+// PendingDistribution returns the amounts allocated in the current distribution
+// epoch and not distributed yet.
+func (q Querier) PendingDistribution(c context.Context, _ *types.QueryPendingDistributionRequest) (*types.QueryPendingDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	pending := q.Keeper.GetPendingDistribution(ctx)
+
+	return &types.QueryPendingDistributionResponse{Pending: pending}, nil
+}
+
+// VestingRewards returns the claimable and claimed developer rewards of a
+// receiver.
+func (q Querier) VestingRewards(c context.Context, req *types.QueryVestingRewardsRequest) (*types.QueryVestingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards := q.Keeper.GetVestingRewards(ctx, addr)
+
+	return &types.QueryVestingRewardsResponse{Rewards: rewards}, nil
+}
+
+// AllVestingRewards returns the claimable and claimed developer rewards of all
+// receivers.
+func (q Querier) AllVestingRewards(c context.Context, req *types.QueryAllVestingRewardsRequest) (*types.QueryAllVestingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.VestingRewardsKeyPrefix)
+
+	all := []types.VestingRewards{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rewards types.VestingRewards
+		if err := q.cdc.Unmarshal(value, &rewards); err != nil {
+			return err
+		}
+		all = append(all, rewards)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllVestingRewardsResponse{Rewards: all, Pagination: pageRes}, nil
+}
+
+// VestingReceiverRotations returns the rotation history of a developer rewards
+// receiver slot.
+func (q Querier) VestingReceiverRotations(c context.Context, req *types.QueryVestingReceiverRotationsRequest) (*types.QueryVestingReceiverRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetVestingReceiverRotationPrefix(req.Slot))
+
+	rotations := []types.VestingReceiverRotation{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rotation types.VestingReceiverRotation
+		if err := q.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingReceiverRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// InflationHistory returns the inflation snapshots between two heights ordered
+// by height.
+func (q Querier) InflationHistory(c context.Context, req *types.QueryInflationHistoryRequest) (*types.QueryInflationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.ToHeight != 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "to height %d is lower than from height %d", req.ToHeight, req.FromHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.InflationSnapshotKeyPrefix)
+
+	snapshots := []types.InflationSnapshot{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		height := int64(sdk.BigEndianToUint64(key))
+		if height < req.FromHeight || (req.ToHeight != 0 && height > req.ToHeight) {
+			return false, nil
+		}
+		if accumulate {
+			var snapshot types.InflationSnapshot
+			if err := q.cdc.Unmarshal(value, &snapshot); err != nil {
+				return false, err
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInflationHistoryResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// LastMintFault returns the last failure to mint or distribute the minted
+// coins, if any.
+func (q Querier) LastMintFault(c context.Context, _ *types.QueryLastMintFaultRequest) (*types.QueryLastMintFaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	fault, found := q.Keeper.GetLastMintFault(ctx)
+	if !found {
+		return &types.QueryLastMintFaultResponse{}, nil
+	}
+
+	return &types.QueryLastMintFaultResponse{Fault: &fault}, nil
+}
+
+// FeeBurnStats returns the transaction fees collected and burnt in every fee
+// burn statistics epoch, ordered by start height.
+func (q Querier) FeeBurnStats(c context.Context, req *types.QueryFeeBurnStatsRequest) (*types.QueryFeeBurnStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.FeeBurnEpochKeyPrefix)
+
+	epochs := []types.FeeBurnEpoch{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var epoch types.FeeBurnEpoch
+		if err := q.cdc.Unmarshal(value, &epoch); err != nil {
+			return err
+		}
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeBurnStatsResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
+
+// MintingPause returns the state of the minting circuit breaker.
+func (q Querier) MintingPause(c context.Context, _ *types.QueryMintingPauseRequest) (*types.QueryMintingPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryStakingAPRResponse{Apr: stakingApr}, nil
+	return &types.QueryMintingPauseResponse{Pause: q.Keeper.GetMintingPause(ctx)}, nil
 }
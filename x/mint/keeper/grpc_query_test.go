@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestEmissionSchedule() {
+	grantsAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	usageIncentiveAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	dev1Addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	dev2Addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	teamReserveAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	suite.SetupTest()
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInBlocks = 100
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	params.DistributionRecipients = equalDistributionRecipients(grantsAddr, usageIncentiveAddr)
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{
+			Address:        dev1Addr.String(),
+			MonthlyAmounts: []math.Int{sdk.NewInt(3000), sdk.NewInt(6000), sdk.NewInt(9000)},
+		},
+		{
+			Address:        dev2Addr.String(),
+			MonthlyAmounts: []math.Int{sdk.NewInt(300), sdk.NewInt(600)},
+		},
+	}
+	params.TeamReserveAddress = teamReserveAddr.String()
+	params.MintingRewardsDistributionStartBlock = 10
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{OneMonthPeriodInBlocks: 30})
+
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	res, err := querier.EmissionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionScheduleRequest{Count: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Periods, 2)
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, addr, params.MintDenom).Amount
+	}
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	claimable := func(addr sdk.AccAddress) math.Int {
+		return suite.app.MintKeeper.GetVestingRewards(suite.ctx, addr).Claimable
+	}
+	communityPool := func() math.Int {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(params.MintDenom).TruncateInt()
+	}
+
+	for i, period := range res.Periods {
+		suite.Require().Equal(uint64(i), period.Period)
+		suite.Require().Equal(int64(10+100*i), period.StartHeight)
+
+		supply := suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom).Amount
+		grants, usageIncentive, staking := balance(grantsAddr), balance(usageIncentiveAddr), balance(feeCollector)
+		developerVesting, teamReserve := claimable(dev1Addr).Add(claimable(dev2Addr)), balance(teamReserveAddr)
+		community := communityPool()
+
+		for height := period.StartHeight; height < period.StartHeight+params.ReductionPeriodInBlocks; height++ {
+			suite.ctx = suite.ctx.WithBlockHeight(height)
+			suite.app.MintKeeper.EndBlocker(suite.ctx)
+		}
+
+		suite.Require().Equal(period.BlockProvisions, suite.app.MintKeeper.GetMinter(suite.ctx).BlockProvisions)
+		suite.Require().Equal(period.TotalMinted.String(), suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom).Amount.Sub(supply).String())
+		suite.Require().Len(period.Recipients, len(params.DistributionRecipients))
+		suite.Require().Equal(period.Recipients[0].Amount.String(), balance(grantsAddr).Sub(grants).String())
+		suite.Require().Equal(period.Recipients[1].Amount.String(), balance(usageIncentiveAddr).Sub(usageIncentive).String())
+		suite.Require().Equal(period.Recipients[2].Amount.String(), balance(feeCollector).Sub(staking).String())
+		suite.Require().Equal(period.DeveloperVesting.String(), claimable(dev1Addr).Add(claimable(dev2Addr)).Sub(developerVesting).String())
+		suite.Require().Equal(period.TeamReserve.String(), balance(teamReserveAddr).Sub(teamReserve).String())
+		suite.Require().Equal(period.CommunityPool.String(), communityPool().Sub(community).String())
+	}
+
+	// the schedule is bounded
+	_, err = querier.EmissionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionScheduleRequest{Count: 0})
+	suite.Require().Error(err)
+	_, err = querier.EmissionSchedule(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionScheduleRequest{
+		FromPeriod: types.MaxEmissionSchedulePeriods - 1,
+		Count:      2,
+	})
+	suite.Require().Error(err)
+}
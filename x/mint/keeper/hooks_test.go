@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+// recordingHooks records the hook calls as strings.
+type recordingHooks struct {
+	calls *[]string
+}
+
+func (h recordingHooks) AfterEpochMint(_ sdk.Context, minted sdk.Coin) {
+	*h.calls = append(*h.calls, fmt.Sprintf("epoch mint %s", minted))
+}
+
+func (h recordingHooks) BeforeReduction(_ sdk.Context, oldProvisions, newProvisions sdk.Dec) {
+	*h.calls = append(*h.calls, fmt.Sprintf("before reduction %s %s", oldProvisions, newProvisions))
+}
+
+func (h recordingHooks) AfterReduction(_ sdk.Context, oldProvisions, newProvisions sdk.Dec) {
+	*h.calls = append(*h.calls, fmt.Sprintf("after reduction %s %s", oldProvisions, newProvisions))
+}
+
+func (h recordingHooks) AfterVestingMonthAdvanced(_ sdk.Context, month int64) {
+	*h.calls = append(*h.calls, fmt.Sprintf("month %d", month))
+}
+
+func (h recordingHooks) AfterBurn(_ sdk.Context, burner sdk.AccAddress, coins sdk.Coins) {
+	*h.calls = append(*h.calls, fmt.Sprintf("burn %s %s", burner, coins))
+}
+
+// mockContractKeeper records the sudo messages of the contracts that succeed.
+type mockContractKeeper struct {
+	failing, outOfGas sdk.AccAddress
+	msgs              *[]string
+}
+
+func (k mockContractKeeper) Sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	switch {
+	case contract.Equals(k.failing):
+		return nil, errors.New("contract error")
+	case contract.Equals(k.outOfGas):
+		ctx.GasMeter().ConsumeGas(keeper.HookContractGasLimit+1, "contract loop")
+	}
+	*k.msgs = append(*k.msgs, string(msg))
+	return nil, nil
+}
+
+// burningContractKeeper burns from every AfterBurn notification, notifying
+// the hooks again.
+type burningContractKeeper struct {
+	hooks *types.MintHooks
+	calls *int
+}
+
+func (k burningContractKeeper) Sudo(ctx sdk.Context, contract sdk.AccAddress, _ []byte) ([]byte, error) {
+	*k.calls++
+	ctx.GasMeter().ConsumeGas(keeper.HookContractGasLimit/10, "contract burn")
+	(*k.hooks).AfterBurn(ctx, contract, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	return nil, nil
+}
+
+func (suite *KeeperTestSuite) TestWasmHooksGasLimit() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	params := mintKeeper.GetParams(suite.ctx)
+	params.HookContracts = []string{contract.String()}
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	var hooks types.MintHooks
+	calls := 0
+	hooks = keeper.NewWasmHooks(mintKeeper, burningContractKeeper{hooks: &hooks, calls: &calls})
+
+	// the nested notifications share the gas of the first call
+	hooks.AfterBurn(suite.ctx, contract, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	suite.Require().Equal(10, calls)
+
+	// and cannot use more than the gas left to the caller
+	calls = 0
+	gasMeter := sdk.NewGasMeter(keeper.HookContractGasLimit / 2)
+	gasMeter.ConsumeGas(keeper.HookContractGasLimit/4, "tx")
+	suite.Require().NotPanics(func() {
+		hooks.AfterBurn(suite.ctx.WithGasMeter(gasMeter), contract, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	})
+	suite.Require().Equal(3, calls)
+	suite.Require().Equal(gasMeter.Limit(), gasMeter.GasConsumed())
+}
+
+func (suite *KeeperTestSuite) TestMintHooks() {
+	suite.SetupTest()
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	failingContract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	outOfGasContract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	burner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	calls, msgs := []string{}, []string{}
+	mintKeeper := keeper.NewKeeper(
+		suite.app.AppCodec(),
+		suite.app.GetKey(types.StoreKey),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.app.StakingKeeper,
+		suite.app.DistrKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	mintKeeper.SetHooks(types.NewMultiMintHooks(
+		recordingHooks{calls: &calls},
+		keeper.NewWasmHooks(mintKeeper, mockContractKeeper{failing: failingContract, outOfGas: outOfGasContract, msgs: &msgs}),
+	))
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 1
+	params.ReductionPeriodInBlocks = 4
+	params.DistributionEpochBlocks = 2
+	params.HookContracts = []string{failingContract.String(), outOfGasContract.String(), contract.String()}
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	mintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{
+		MonthStartedBlock:      1,
+		OneMonthPeriodInBlocks: 3,
+	})
+
+	for height := int64(1); height <= 5; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		mintKeeper.EndBlocker(suite.ctx)
+	}
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, funds))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, burner, funds))
+	// the burner pays for the gas used by the hook contracts
+	burnCtx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10 * keeper.HookContractGasLimit))
+	suite.Require().NoError(mintKeeper.BurnTokens(burnCtx, burner, funds))
+	suite.Require().Greater(burnCtx.GasMeter().GasConsumed(), uint64(keeper.HookContractGasLimit))
+
+	// the burnt fees are notified as burnt by the fee collector
+	fees := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100))
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+	params.FeeBurnRatio = sdk.NewDecWithPrec(25, 2)
+	burntFees := mintKeeper.BurnFees(suite.ctx, params)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	suite.Require().Equal([]string{
+		"epoch mint 94000000stake",
+		"month 1",
+		"epoch mint 94000000stake",
+		"before reduction 47000000.000000000000000000 31330200.000000000000000000",
+		"after reduction 47000000.000000000000000000 31330200.000000000000000000",
+		fmt.Sprintf("burn %s 100stake", burner),
+		fmt.Sprintf("burn %s %sstake", feeCollector, burntFees),
+	}, calls)
+
+	// the failing contracts do not prevent the other contracts from being notified
+	suite.Require().Equal([]string{
+		`{"mint_hook":{"after_epoch_mint":{"minted":{"denom":"stake","amount":"94000000"}}}}`,
+		`{"mint_hook":{"after_vesting_month_advanced":{"month":1}}}`,
+		`{"mint_hook":{"after_epoch_mint":{"minted":{"denom":"stake","amount":"94000000"}}}}`,
+		`{"mint_hook":{"after_reduction":{"old_provisions":"47000000.000000000000000000","new_provisions":"31330200.000000000000000000"}}}`,
+		fmt.Sprintf(`{"mint_hook":{"after_burn":{"burner":"%s","coins":[{"denom":"stake","amount":"100"}]}}}`, burner),
+		fmt.Sprintf(`{"mint_hook":{"after_burn":{"burner":"%s","coins":[{"denom":"stake","amount":"%s"}]}}}`, feeCollector, burntFees),
+	}, msgs)
+}
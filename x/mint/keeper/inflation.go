@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Inflation returns the yearly provisions over the total supply of the mint
+// denom. It is zero when there is no supply.
+func (k Keeper) Inflation(ctx sdk.Context) sdk.Dec {
+	supply := k.GetTotalSupply(ctx)
+	if !supply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return k.GetMinter(ctx).BlockProvisions.
+		Mul(sdk.NewDec(int64(k.GetParams(ctx).BlocksPerYear))).
+		QuoInt(supply)
+}
+
+// GetInflationSnapshot returns the inflation snapshot taken at a height.
+func (k Keeper) GetInflationSnapshot(ctx sdk.Context, height int64) (types.InflationSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInflationSnapshotKey(height))
+	if bz == nil {
+		return types.InflationSnapshot{}, false
+	}
+
+	var snapshot types.InflationSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetInflationSnapshot stores an inflation snapshot.
+func (k Keeper) SetInflationSnapshot(ctx sdk.Context, snapshot types.InflationSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInflationSnapshotKey(snapshot.Height), k.cdc.MustMarshal(&snapshot))
+}
+
+// GetAllInflationSnapshots returns all inflation snapshots ordered by height.
+func (k Keeper) GetAllInflationSnapshots(ctx sdk.Context) []types.InflationSnapshot {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InflationSnapshotKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	snapshots := []types.InflationSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.InflationSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// TakeInflationSnapshot returns the emission and staking figures of the
+// current block.
+func (k Keeper) TakeInflationSnapshot(ctx sdk.Context) types.InflationSnapshot {
+	return types.InflationSnapshot{
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+		BlockProvisions: k.GetMinter(ctx).BlockProvisions,
+		TotalSupply:     k.GetTotalSupply(ctx),
+		BondedTokens:    k.stakingKeeper.TotalBondedTokens(ctx),
+		Inflation:       k.Inflation(ctx),
+		StakingApr:      k.StakingAPR(ctx, true),
+	}
+}
+
+// RecordInflationSnapshot stores a snapshot of the block every
+// inflation_snapshot_interval_blocks blocks, and prunes the snapshots older
+// than inflation_snapshot_retention_blocks.
+func (k Keeper) RecordInflationSnapshot(ctx sdk.Context, params types.Params) {
+	height := ctx.BlockHeight()
+	if interval := int64(params.InflationSnapshotIntervalBlocks); interval > 0 && height%interval == 0 {
+		k.SetInflationSnapshot(ctx, k.TakeInflationSnapshot(ctx))
+	}
+
+	if params.InflationSnapshotRetentionBlocks == 0 {
+		return
+	}
+	end := height - int64(params.InflationSnapshotRetentionBlocks)
+	if end <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InflationSnapshotKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(end)))
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestInflationHistory() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	querier := keeper.NewQuerier(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	params.InflationSnapshotIntervalBlocks = 5
+	params.InflationSnapshotRetentionBlocks = 10
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 30; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(time.Unix(1_000_000+6*height, 0).UTC())
+		expected := mintKeeper.TakeInflationSnapshot(suite.ctx)
+		supply := mintKeeper.GetTotalSupply(suite.ctx)
+		mintKeeper.EndBlocker(suite.ctx)
+
+		// the snapshot is taken before the coins of the block are minted
+		if height == 15 {
+			snapshot, found := mintKeeper.GetInflationSnapshot(suite.ctx, height)
+			suite.Require().True(found)
+			suite.Require().Equal(expected, snapshot)
+			suite.Require().Equal(supply, snapshot.TotalSupply)
+			suite.Require().True(snapshot.TotalSupply.LT(mintKeeper.GetTotalSupply(suite.ctx)))
+			suite.Require().True(snapshot.Inflation.IsPositive())
+		}
+	}
+
+	// the snapshots older than the retention are pruned
+	heights := func(snapshots []types.InflationSnapshot) []int64 {
+		res := []int64{}
+		for _, snapshot := range snapshots {
+			res = append(res, snapshot.Height)
+		}
+		return res
+	}
+	suite.Require().Equal([]int64{20, 25, 30}, heights(mintKeeper.GetAllInflationSnapshots(suite.ctx)))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := querier.InflationHistory(ctx, &types.QueryInflationHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{20, 25, 30}, heights(res.Snapshots))
+
+	res, err = querier.InflationHistory(ctx, &types.QueryInflationHistoryRequest{FromHeight: 21, ToHeight: 25})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{25}, heights(res.Snapshots))
+
+	res, err = querier.InflationHistory(ctx, &types.QueryInflationHistoryRequest{
+		FromHeight: 21,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{25}, heights(res.Snapshots))
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	res, err = querier.InflationHistory(ctx, &types.QueryInflationHistoryRequest{
+		FromHeight: 21,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{30}, heights(res.Snapshots))
+
+	_, err = querier.InflationHistory(ctx, &types.QueryInflationHistoryRequest{FromHeight: 25, ToHeight: 20})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the snapshots are exported in genesis
+	genesis := mintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal([]int64{20, 25, 30}, heights(genesis.InflationSnapshots))
+
+	// disabling the snapshots keeps pruning them
+	params.InflationSnapshotIntervalBlocks = 0
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	suite.ctx = suite.ctx.WithBlockHeight(36)
+	mintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal([]int64{30}, heights(mintKeeper.GetAllInflationSnapshots(suite.ctx)))
+}
+
+func (suite *KeeperTestSuite) TestInflationSnapshotWithoutMinting() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	params := mintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 10
+	params.InflationSnapshotIntervalBlocks = 5
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+
+	// the snapshots are taken before the start block
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	mintKeeper.EndBlocker(suite.ctx)
+	_, found := mintKeeper.GetInflationSnapshot(suite.ctx, 5)
+	suite.Require().True(found)
+
+	// and while minting is paused
+	suite.ctx = suite.ctx.WithBlockHeight(14)
+	suite.Require().NoError(mintKeeper.PauseMinting(suite.ctx))
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	supply := mintKeeper.GetTotalSupply(suite.ctx)
+	mintKeeper.EndBlocker(suite.ctx)
+	snapshot, found := mintKeeper.GetInflationSnapshot(suite.ctx, 15)
+	suite.Require().True(found)
+	suite.Require().Equal(supply, snapshot.TotalSupply)
+	suite.Require().Equal(supply, mintKeeper.GetTotalSupply(suite.ctx))
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -22,6 +25,82 @@ func (k Keeper) SetLastReductionBlockNum(ctx sdk.Context, blockNum int64) {
 	store.Set(types.LastReductionBlockKey, sdk.Uint64ToBigEndian(uint64(blockNum)))
 }
 
+// GetLastReductionTime returns the block time at which the current reduction
+// period started.
+func (k Keeper) GetLastReductionTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastReductionTimeKey)
+	if b == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// SetLastReductionTime sets the block time at which the current reduction
+// period started.
+func (k Keeper) SetLastReductionTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastReductionTimeKey, sdk.FormatTimeBytes(t))
+}
+
+// GetLastBlockTime returns the time of the last block provisions were minted
+// for.
+func (k Keeper) GetLastBlockTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastBlockTimeKey)
+	if b == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// SetLastBlockTime sets the time of the last block provisions were minted for.
+func (k Keeper) SetLastBlockTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastBlockTimeKey, sdk.FormatTimeBytes(t))
+}
+
+// alignReductionSchedule converts the progress of the current reduction period
+// when the reduction mode changes, so that the next reduction happens where
+// the schedule of the previous mode expected it.
+func (k Keeper) alignReductionSchedule(ctx sdk.Context, oldParams, newParams types.Params) {
+	if oldParams.ReductionMode == newParams.ReductionMode {
+		return
+	}
+
+	// A progress that does not fit in a period, as when the schedule of the previous
+	// mode was never started, restarts the period at the current block instead of
+	// triggering reductions for every period it spans.
+	switch newParams.ReductionMode {
+	case types.ReductionModeTime:
+		elapsedBlocks := ctx.BlockHeight() - k.GetLastReductionBlockNum(ctx)
+		elapsed := time.Duration(elapsedBlocks) * newParams.NominalBlockTime()
+		if elapsed < 0 || elapsed >= newParams.ReductionPeriodDuration {
+			elapsed = 0
+		}
+		k.SetLastReductionTime(ctx, ctx.BlockTime().Add(-elapsed))
+	case types.ReductionModeBlocks:
+		var elapsedBlocks int64
+		if lastReductionTime := k.GetLastReductionTime(ctx); !lastReductionTime.IsZero() {
+			elapsedBlocks = int64(ctx.BlockTime().Sub(lastReductionTime) / oldParams.NominalBlockTime())
+		}
+		if elapsedBlocks < 0 || elapsedBlocks >= newParams.ReductionPeriodInBlocks {
+			elapsedBlocks = 0
+		}
+		k.SetLastReductionBlockNum(ctx, ctx.BlockHeight()-elapsedBlocks)
+	}
+}
+
 // GetTeamVestingMonthInfo returns month information for team vesting
 func (k Keeper) GetTeamVestingMonthInfo(ctx sdk.Context) types.TeamVestingMonthInfo {
 	store := ctx.KVStore(k.storeKey)
@@ -61,3 +140,112 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	b := k.cdc.MustMarshal(&minter)
 	store.Set(types.MinterKey, b)
 }
+
+// GetDistributionTotals returns the cumulative amounts minted and sent to each
+// distribution bucket.
+func (k Keeper) GetDistributionTotals(ctx sdk.Context) types.DistributionTotals {
+	store := ctx.KVStore(k.storeKey)
+	totals := types.NewDistributionTotals()
+	bz := store.Get(types.DistributionTotalsKey)
+	if bz == nil {
+		return totals
+	}
+
+	var stored types.DistributionTotals
+	k.cdc.MustUnmarshal(bz, &stored)
+	totals.Add(stored)
+	return totals
+}
+
+// SetDistributionTotals sets the cumulative amounts minted and sent to each
+// distribution bucket.
+func (k Keeper) SetDistributionTotals(ctx sdk.Context, totals types.DistributionTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&totals)
+	store.Set(types.DistributionTotalsKey, bz)
+}
+
+// GetPendingDistribution returns the amounts allocated in the current
+// distribution epoch and not distributed yet.
+func (k Keeper) GetPendingDistribution(ctx sdk.Context) types.DistributionTotals {
+	store := ctx.KVStore(k.storeKey)
+	pending := types.NewDistributionTotals()
+	bz := store.Get(types.PendingDistributionKey)
+	if bz == nil {
+		return pending
+	}
+
+	var stored types.DistributionTotals
+	k.cdc.MustUnmarshal(bz, &stored)
+	pending.Add(stored)
+	return pending
+}
+
+// SetPendingDistribution sets the amounts allocated in the current
+// distribution epoch and not distributed yet.
+func (k Keeper) SetPendingDistribution(ctx sdk.Context, pending types.DistributionTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pending)
+	store.Set(types.PendingDistributionKey, bz)
+}
+
+// DeletePendingDistribution clears the pending distribution once paid.
+func (k Keeper) DeletePendingDistribution(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingDistributionKey)
+}
+
+// GetEmissionCheckpoint returns the emission checkpoint, if any.
+func (k Keeper) GetEmissionCheckpoint(ctx sdk.Context) (types.EmissionCheckpoint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EmissionCheckpointKey)
+	if bz == nil {
+		return types.EmissionCheckpoint{}, false
+	}
+
+	var checkpoint types.EmissionCheckpoint
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return checkpoint, true
+}
+
+// SetEmissionCheckpoint sets the emission checkpoint.
+func (k Keeper) SetEmissionCheckpoint(ctx sdk.Context, checkpoint types.EmissionCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EmissionCheckpointKey, k.cdc.MustMarshal(&checkpoint))
+}
+
+// ResetEmissionCheckpoint sets the emission checkpoint to the current state, so
+// that the emission invariant projects the minted amount from the current block.
+// It must be called whenever the emission schedule parameters change.
+func (k Keeper) ResetEmissionCheckpoint(ctx sdk.Context) {
+	k.SetEmissionCheckpoint(ctx, types.EmissionCheckpoint{
+		Height:             ctx.BlockHeight(),
+		Minted:             k.GetDistributionTotals(ctx).Minted,
+		BlockProvisions:    k.GetMinter(ctx).BlockProvisions,
+		LastReductionBlock: k.GetLastReductionBlockNum(ctx),
+		Clipped:            math.ZeroInt(),
+	})
+}
+
+// emissionScheduleChanged returns whether the parameters the emission is
+// projected from differ.
+func emissionScheduleChanged(oldParams, newParams types.Params) bool {
+	return oldParams.MintDenom != newParams.MintDenom ||
+		oldParams.MintingRewardsDistributionStartBlock != newParams.MintingRewardsDistributionStartBlock ||
+		oldParams.ReductionMode != newParams.ReductionMode ||
+		oldParams.ReductionPeriodInBlocks != newParams.ReductionPeriodInBlocks ||
+		oldParams.ReductionPeriodDuration != newParams.ReductionPeriodDuration ||
+		!oldParams.ReductionFactor.Equal(newParams.ReductionFactor)
+}
+
+// AddClippedProvisions records block provisions not minted because of the max
+// supply in the emission checkpoint, so that the emission invariant accounts
+// for them.
+func (k Keeper) AddClippedProvisions(ctx sdk.Context, amount math.Int) {
+	checkpoint, found := k.GetEmissionCheckpoint(ctx)
+	if !found {
+		return
+	}
+	checkpoint.Clipped = checkpoint.Clipped.Add(amount)
+	k.SetEmissionCheckpoint(ctx, checkpoint)
+}
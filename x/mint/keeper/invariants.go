@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all mint invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "distribution-weights", DistributionWeightsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-reduction-block", LastReductionBlockInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "emission", EmissionInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			DistributionWeightsInvariant(k),
+			LastReductionBlockInvariant(k),
+			ModuleBalanceInvariant(k),
+			EmissionInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// DistributionWeightsInvariant checks that the weights of the distribution
+// recipients sum to one, so that the minted coins are entirely distributed.
+func DistributionWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := sdk.ZeroDec()
+		for _, r := range k.GetParams(ctx).DistributionRecipients {
+			total = total.Add(r.Weight)
+		}
+
+		broken := !total.Equal(sdk.OneDec())
+		return sdk.FormatInvariant(types.ModuleName, "distribution-weights",
+			fmt.Sprintf("\tdistribution recipients weights sum: %s\n", total)), broken
+	}
+}
+
+// LastReductionBlockInvariant checks that the last reduction block is not in
+// the future.
+func LastReductionBlockInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastReductionBlock := k.GetLastReductionBlockNum(ctx)
+
+		broken := lastReductionBlock > ctx.BlockHeight()
+		return sdk.FormatInvariant(types.ModuleName, "last-reduction-block",
+			fmt.Sprintf("\tlast reduction block: %d\n\tcurrent block: %d\n", lastReductionBlock, ctx.BlockHeight())), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the mint module account holds at least the
+// pending distribution and the claimable developer rewards. Coins sent to the
+// module account by anyone else do not break it.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		expected := pendingAmount(params, k.GetPendingDistribution(ctx))
+		for _, rewards := range k.GetAllVestingRewards(ctx) {
+			expected = expected.Add(rewards.Claimable)
+		}
+
+		expectedBalance := sdk.NewCoins(sdk.NewCoin(params.MintDenom, expected))
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.IsAllGTE(expectedBalance)
+		return sdk.FormatInvariant(types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmint module account balance: %s\n\tpending distribution and claimable developer rewards: %s\n", balance, expectedBalance)), broken
+	}
+}
+
+// EmissionInvariant checks that the minted amount of the distribution totals
+// does not exceed the closed-form emission from the emission checkpoint, which
+// starts from the distribution start block and the genesis block provisions on
+// a new chain, and only moves when the emission schedule parameters change. In block mode,
+// the emission is projected to the current block. In time mode, where the block
+// provisions are scaled by block time, it is the emission of the whole schedule.
+func EmissionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		checkpoint, found := k.GetEmissionCheckpoint(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "emission", "\temission not checked\n"), false
+		}
+
+		expected := checkpoint.ProjectedMinted(params, ctx.BlockHeight())
+		if params.ReductionMode == types.ReductionModeTime {
+			var bounded bool
+			if expected, bounded = checkpoint.MaxMinted(params); !bounded {
+				return sdk.FormatInvariant(types.ModuleName, "emission", "\temission not checked, the block provisions are not reduced\n"), false
+			}
+		}
+
+		minted := k.GetDistributionTotals(ctx).Minted
+		broken := minted.GT(expected)
+		return sdk.FormatInvariant(types.ModuleName, "emission",
+			fmt.Sprintf("\tminted: %s\n\tclipped: %s\n\tclosed-form emission: %s\n", minted, checkpoint.Clipped, expected)), broken
+	}
+}
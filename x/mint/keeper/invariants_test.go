@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 3
+	params.ReductionPeriodInBlocks = 5
+	params.DistributionEpochBlocks = 4
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: devAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(1000), sdk.NewInt(1000)}},
+	}
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(suite.app.MintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{
+		MonthStartedBlock:      3,
+		OneMonthPeriodInBlocks: 4,
+	})
+
+	invariant := keeper.AllInvariants(suite.app.MintKeeper)
+	for height := int64(1); height <= 20; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+	suite.Require().True(suite.app.MintKeeper.GetDistributionTotals(suite.ctx).Minted.IsPositive())
+
+	_, err = msgServer.ClaimVestedRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimVestedRewards(devAddr.String()))
+	suite.Require().NoError(err)
+	msg, broken := invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// updating the parameters resets the emission checkpoint, before the block
+	// minting
+	suite.ctx = suite.ctx.WithBlockHeight(21)
+	params.ReductionPeriodInBlocks = 3
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(suite.app.MintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	for height := int64(21); height <= 30; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+
+	tests := []struct {
+		name      string
+		invariant sdk.Invariant
+		breakFn   func(ctx sdk.Context) sdk.Context
+	}{
+		{
+			"distribution weights",
+			keeper.DistributionWeightsInvariant(suite.app.MintKeeper),
+			func(ctx sdk.Context) sdk.Context {
+				// bypass the parameters validation
+				params := suite.app.MintKeeper.GetParams(ctx)
+				params.DistributionRecipients[0].Weight = sdk.NewDecWithPrec(5, 2)
+				ctx.KVStore(suite.app.GetKey(types.StoreKey)).Set(types.ParamsKey, suite.app.AppCodec().MustMarshal(&params))
+				return ctx
+			},
+		},
+		{
+			"last reduction block",
+			keeper.LastReductionBlockInvariant(suite.app.MintKeeper),
+			func(ctx sdk.Context) sdk.Context {
+				suite.app.MintKeeper.SetLastReductionBlockNum(ctx, ctx.BlockHeight()+1)
+				return ctx
+			},
+		},
+		{
+			"module balance",
+			keeper.ModuleBalanceInvariant(suite.app.MintKeeper),
+			func(ctx sdk.Context) sdk.Context {
+				suite.app.MintKeeper.AddVestedRewards(ctx, devAddr, sdk.NewInt(1))
+				return ctx
+			},
+		},
+		{
+			"emission",
+			keeper.EmissionInvariant(suite.app.MintKeeper),
+			func(ctx sdk.Context) sdk.Context {
+				minter := suite.app.MintKeeper.GetMinter(ctx)
+				minter.BlockProvisions = minter.BlockProvisions.Add(sdk.OneDec())
+				suite.app.MintKeeper.SetMinter(ctx, minter)
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+				suite.app.MintKeeper.EndBlocker(ctx)
+				return ctx
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		ctx, _ := suite.ctx.CacheContext()
+		_, broken := tc.invariant(ctx)
+		suite.Require().False(broken, tc.name)
+
+		ctx = tc.breakFn(ctx)
+		_, broken = tc.invariant(ctx)
+		suite.Require().True(broken, tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestEmissionInvariant() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	invariant := keeper.AllInvariants(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInBlocks = 5
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	checkpoint, found := mintKeeper.GetEmissionCheckpoint(suite.ctx)
+	suite.Require().True(found)
+
+	// coins sent to the mint module account are not a leftover balance
+	mintAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000), sdk.NewInt64Coin("other", 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, mintAddr).IsAllGTE(coins))
+
+	// neither the parameters unrelated to the schedule nor a pause move the checkpoint
+	height := int64(1)
+	endBlock := func() {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		mintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+		height++
+	}
+	for ; height <= 7; endBlock() {
+	}
+	params.DistributionEpochBlocks = 3
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mintKeeper.PauseMinting(suite.ctx))
+	for ; height <= 10; endBlock() {
+	}
+	_, _, err = mintKeeper.ResumeMinting(suite.ctx, true)
+	suite.Require().NoError(err)
+	for ; height <= 20; endBlock() {
+	}
+	last, found := mintKeeper.GetEmissionCheckpoint(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(checkpoint, last)
+
+	// coins minted beyond the closed-form emission break it, whatever the mode
+	// and while minting is paused
+	overMint := func(ctx sdk.Context) {
+		totals := mintKeeper.GetDistributionTotals(ctx)
+		totals.Minted = checkpoint.ProjectedMinted(mintKeeper.GetParams(ctx), ctx.BlockHeight()).Add(sdk.NewInt(1))
+		mintKeeper.SetDistributionTotals(ctx, totals)
+	}
+	ctx, _ := suite.ctx.CacheContext()
+	overMint(ctx)
+	_, broken := keeper.EmissionInvariant(mintKeeper)(ctx)
+	suite.Require().True(broken)
+
+	ctx, _ = suite.ctx.CacheContext()
+	suite.Require().NoError(mintKeeper.PauseMinting(ctx))
+	overMint(ctx)
+	_, broken = keeper.EmissionInvariant(mintKeeper)(ctx)
+	suite.Require().True(broken)
+
+	ctx, _ = suite.ctx.CacheContext()
+	params.ReductionMode = types.ReductionModeTime
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	_, broken = keeper.EmissionInvariant(mintKeeper)(ctx)
+	suite.Require().False(broken)
+	checkpoint, _ = mintKeeper.GetEmissionCheckpoint(ctx)
+	maxMinted, bounded := checkpoint.MaxMinted(params)
+	suite.Require().True(bounded)
+	totals := mintKeeper.GetDistributionTotals(ctx)
+	totals.Minted = maxMinted.Add(sdk.NewInt(1))
+	mintKeeper.SetDistributionTotals(ctx, totals)
+	_, broken = keeper.EmissionInvariant(mintKeeper)(ctx)
+	suite.Require().True(broken)
+}
@@ -10,20 +10,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper of the mint store.
 type Keeper struct {
 	cdc                 codec.BinaryCodec
 	storeKey            storetypes.StoreKey
-	paramSpace          paramtypes.Subspace
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
 	stakingKeeper       types.StakingKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
 	hooks               types.MintHooks
 	feeCollectorName    string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 type invalidRatioError struct {
@@ -38,29 +40,24 @@ const emptyAddressReceiver = ""
 
 // NewKeeper creates a new mint Keeper instance.
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	ck types.CommunityPoolKeeper, feeCollectorName string,
+	ck types.CommunityPoolKeeper, feeCollectorName string, authority string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
 	}
 
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return Keeper{
 		cdc:                 cdc,
 		storeKey:            key,
-		paramSpace:          paramSpace,
 		accountKeeper:       ak,
 		bankKeeper:          bk,
 		stakingKeeper:       sk,
 		communityPoolKeeper: ck,
 		feeCollectorName:    feeCollectorName,
+		authority:           authority,
 	}
 }
 
@@ -71,6 +68,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the x/mint module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Set the mint hooks.
 func (k *Keeper) SetHooks(h types.MintHooks) *Keeper {
 	if k.hooks != nil {
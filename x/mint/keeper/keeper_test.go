@@ -11,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	simapp "github.com/TERITORI/teritori-chain/app"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
@@ -36,3 +38,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// equalDistributionRecipients returns the five historical distribution buckets
+// with a weight of 20% each.
+func equalDistributionRecipients(grantsAddr, usageIncentiveAddr sdk.AccAddress) []types.DistributionRecipient {
+	return []types.DistributionRecipient{
+		{Name: types.RecipientGrantsProgram, TargetType: types.DistributionTargetAccount, Target: grantsAddr.String(), Weight: sdk.NewDecWithPrec(2, 1)},
+		{Name: types.RecipientUsageIncentive, TargetType: types.DistributionTargetAccount, Target: usageIncentiveAddr.String(), Weight: sdk.NewDecWithPrec(2, 1)},
+		{Name: types.RecipientStaking, TargetType: types.DistributionTargetModule, Target: authtypes.FeeCollectorName, Weight: sdk.NewDecWithPrec(2, 1)},
+		{Name: types.RecipientDeveloperRewards, TargetType: types.DistributionTargetDeveloperVesting, Weight: sdk.NewDecWithPrec(2, 1)},
+		{Name: types.RecipientCommunityPool, TargetType: types.DistributionTargetCommunityPool, Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+}
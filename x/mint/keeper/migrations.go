@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/TERITORI/teritori-chain/x/mint/exported"
+	v2 "github.com/TERITORI/teritori-chain/x/mint/migrations/v2"
+	v3 "github.com/TERITORI/teritori-chain/x/mint/migrations/v3"
+	v4 "github.com/TERITORI/teritori-chain/x/mint/migrations/v4"
+	v5 "github.com/TERITORI/teritori-chain/x/mint/migrations/v5"
+	v7 "github.com/TERITORI/teritori-chain/x/mint/migrations/v7"
+	v8 "github.com/TERITORI/teritori-chain/x/mint/migrations/v8"
+	v9 "github.com/TERITORI/teritori-chain/x/mint/migrations/v9"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
+	return Migrator{
+		keeper:         k,
+		legacySubspace: ss,
+	}
+}
+
+// Migrate1to2 migrates the x/mint module state from the consensus version 1 to
+// version 2. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params modules and stores them directly into the x/mint
+// module state.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it moves the cumulative burnt amount from the
+// parameters into the burn ledger.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, m.keeper.storeKey, m.keeper.accountKeeper, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it sets the reduction mode parameters and the start
+// time of the current reduction period.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the x/mint module state from the consensus version 4 to
+// version 5. Specifically, it replaces the distribution proportions with the
+// equivalent weighted distribution recipients.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.feeCollectorName)
+}
+
+// Migrate5to6 migrates the x/mint module state from the consensus version 5 to
+// version 6. Nothing carries over: up to version 5, the developer rewards were
+// paid to the receivers every block, so nothing is left to claim, and the
+// distribution totals the paid amounts could be read from are introduced in the
+// same upgrade. The vested rewards ledger starts empty, and the team vesting
+// month info is kept, so the current month keeps vesting from its next block.
+func (m Migrator) Migrate5to6(_ sdk.Context) error {
+	return nil
+}
+
+// Migrate6to7 migrates the x/mint module state from the consensus version 6 to
+// version 7. Specifically, it sets the emission checkpoint the emission
+// invariant projects the minted amount from.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates the x/mint module state from the consensus version 7 to
+// version 8. Specifically, it sets the max supply, disabled.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates the x/mint module state from the consensus version 8 to
+// version 9. Specifically, it sets the fee burn ratio, burning no fees.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
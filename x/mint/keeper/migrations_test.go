@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/TERITORI/teritori-chain/x/mint"
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	legacyParams := types.DefaultParams()
+	legacyParams.BlocksPerYear = 6000000
+	legacyParams.TotalBurntAmount = []sdk.Coin{sdk.NewInt64Coin("utori", 1000)}
+	legacyParams.DistributionProportions = types.DistributionProportions{
+		GrantsProgram:    sdk.NewDecWithPrec(10, 2),
+		CommunityPool:    sdk.NewDecWithPrec(10, 2),
+		UsageIncentive:   sdk.NewDecWithPrec(25, 2),
+		Staking:          sdk.NewDecWithPrec(40, 2),
+		DeveloperRewards: sdk.NewDecWithPrec(15, 2),
+	}
+	legacyParams.GrantsProgramAddress = legacyParams.DistributionRecipients[0].Target
+	legacyParams.UsageIncentiveAddress = legacyParams.DistributionRecipients[1].Target
+	// params added after v2 are not part of the legacy param set
+	legacyParams.ReductionPeriodDuration = 0
+	legacyParams.DistributionRecipients = nil
+
+	subspace := suite.app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(suite.ctx, &legacyParams)
+
+	// drop the params written by InitGenesis to start from a v1 store
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	suite.Require().Equal(types.Params{}, suite.app.MintKeeper.GetParams(suite.ctx))
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, subspace)
+	suite.Require().NoError(migrator.Migrate1to2(suite.ctx))
+	suite.Require().Equal(legacyParams, suite.app.MintKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestRunMigrationsFromV1() {
+	grantsAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	usageIncentiveAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	// v1 params are held by the x/params subspace
+	legacyParams := types.DefaultParams()
+	legacyParams.TotalBurntAmount = []sdk.Coin{sdk.NewInt64Coin("utori", 1000)}
+	legacyParams.DistributionProportions = types.DistributionProportions{
+		GrantsProgram:    sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		UsageIncentive:   sdk.NewDecWithPrec(2, 1),
+		Staking:          sdk.NewDecWithPrec(2, 1),
+		DeveloperRewards: sdk.NewDecWithPrec(2, 1),
+	}
+	legacyParams.GrantsProgramAddress = grantsAddr.String()
+	legacyParams.UsageIncentiveAddress = usageIncentiveAddr.String()
+	legacyParams.ReductionPeriodDuration = 0
+	legacyParams.DistributionRecipients = nil
+	subspace := suite.app.GetSubspace(types.ModuleName)
+	subspace.SetParamSet(suite.ctx, &legacyParams)
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	macc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	macc.Permissions = []string{authtypes.Minter}
+	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, macc)
+
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(suite.app.InterfaceRegistry())
+	cfg := module.NewConfigurator(suite.app.AppCodec(), msr, baseapp.NewGRPCQueryRouter())
+	mm := module.NewManager(mint.NewAppModule(suite.app.AppCodec(), suite.app.MintKeeper, suite.app.AccountKeeper, suite.app.BankKeeper, subspace))
+	mm.RegisterServices(cfg)
+	versions, err := mm.RunMigrations(suite.ctx, cfg, module.VersionMap{types.ModuleName: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(mint.AppModule{}.ConsensusVersion(), versions[types.ModuleName])
+
+	// the fully migrated params are valid
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().NoError(params.Validate())
+	suite.Require().Equal(equalDistributionRecipients(grantsAddr, usageIncentiveAddr), params.DistributionRecipients)
+	suite.Require().Equal(types.ReductionModeBlocks, params.ReductionMode)
+	suite.Require().Empty(params.TotalBurntAmount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("utori", 1000)), suite.app.MintKeeper.GetTotalBurnt(suite.ctx))
+	suite.Require().True(suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).HasPermission(authtypes.Burner))
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.TotalBurntAmount = []sdk.Coin{sdk.NewInt64Coin("utori", 1000)}
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	// v2 module accounts were created without the burner permission
+	macc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	macc.Permissions = []string{authtypes.Minter}
+	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, macc)
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate2to3(suite.ctx))
+
+	suite.Require().Empty(suite.app.MintKeeper.GetParams(suite.ctx).TotalBurntAmount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("utori", 1000)), suite.app.MintKeeper.GetTotalBurnt(suite.ctx))
+	suite.Require().True(suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName).HasPermission(authtypes.Burner))
+}
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	// v3 params hold the distribution proportions and no reduction mode params
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.DistributionProportions = types.DistributionProportions{
+		GrantsProgram:    sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		UsageIncentive:   sdk.NewDecWithPrec(2, 1),
+		Staking:          sdk.NewDecWithPrec(2, 1),
+		DeveloperRewards: sdk.NewDecWithPrec(2, 1),
+	}
+	params.GrantsProgramAddress = params.DistributionRecipients[0].Target
+	params.UsageIncentiveAddress = params.DistributionRecipients[1].Target
+	params.DistributionRecipients = nil
+	params.ReductionPeriodDuration = 0
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.ParamsKey, suite.app.AppCodec().MustMarshal(&params))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.app.MintKeeper.SetLastReductionBlockNum(suite.ctx, 100)
+	suite.ctx = suite.ctx.WithBlockHeight(1100).WithBlockTime(now)
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate3to4(suite.ctx))
+
+	params = suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(types.ReductionModeBlocks, params.ReductionMode)
+	suite.Require().Equal(types.DefaultReductionPeriodDuration, params.ReductionPeriodDuration)
+	// 1000 blocks at the nominal block time of 5s
+	suite.Require().Equal(now.Add(-5000*time.Second), suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+	suite.Require().Equal(now, suite.app.MintKeeper.GetLastBlockTime(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	grantsAddr := sdk.MustAccAddressFromBech32(params.DistributionRecipients[0].Target)
+	usageIncentiveAddr := sdk.MustAccAddressFromBech32(params.DistributionRecipients[1].Target)
+
+	// v4 params hold the distribution proportions and the grants and usage incentive addresses
+	legacyParams := params
+	legacyParams.DistributionRecipients = nil
+	legacyParams.DistributionProportions = types.DistributionProportions{
+		GrantsProgram:    sdk.NewDecWithPrec(2, 1),
+		CommunityPool:    sdk.NewDecWithPrec(2, 1),
+		UsageIncentive:   sdk.NewDecWithPrec(2, 1),
+		Staking:          sdk.NewDecWithPrec(2, 1),
+		DeveloperRewards: sdk.NewDecWithPrec(2, 1),
+	}
+	legacyParams.GrantsProgramAddress = grantsAddr.String()
+	legacyParams.UsageIncentiveAddress = usageIncentiveAddr.String()
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.ParamsKey, suite.app.AppCodec().MustMarshal(&legacyParams))
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate4to5(suite.ctx))
+
+	params = suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(equalDistributionRecipients(grantsAddr, usageIncentiveAddr), params.DistributionRecipients)
+	suite.Require().True(params.DistributionProportions.Staking.IsZero())
+	suite.Require().Empty(params.GrantsProgramAddress)
+	suite.Require().Empty(params.UsageIncentiveAddress)
+}
+
+func (suite *KeeperTestSuite) TestMigrate5to6() {
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: devAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(1005)}},
+	}
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	// v5 paid the developer rewards every block, and kept no distribution totals
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.DistributionTotalsKey)
+	monthInfo := types.TeamVestingMonthInfo{MonthsSinceGenesis: 0, MonthStartedBlock: 100, OneMonthPeriodInBlocks: 10}
+	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, monthInfo)
+	suite.ctx = suite.ctx.WithBlockHeight(104)
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate5to6(suite.ctx))
+
+	// nothing carries over, the ledger starts empty
+	suite.Require().Empty(suite.app.MintKeeper.GetAllVestingRewards(suite.ctx))
+	suite.Require().Equal(monthInfo, suite.app.MintKeeper.GetTeamVestingMonthInfo(suite.ctx))
+
+	// the current month keeps vesting from the upgrade block
+	for height := int64(104); height < 110; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+	}
+	rewards := suite.app.MintKeeper.GetVestingRewards(suite.ctx, devAddr)
+	suite.Require().Equal(types.VestedInBlocks(sdk.NewInt(1005), 10, 4, 10).String(), rewards.Claimable.String())
+	suite.Require().Equal("0", rewards.Claimed.String())
+}
+
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	minter := types.NewMinter(sdk.NewDec(1000))
+	suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+	suite.app.MintKeeper.SetLastReductionBlockNum(suite.ctx, 90)
+	totals := types.NewDistributionTotals()
+	totals.Minted = sdk.NewInt(12345)
+	suite.app.MintKeeper.SetDistributionTotals(suite.ctx, totals)
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate6to7(suite.ctx))
+
+	checkpoint, found := suite.app.MintKeeper.GetEmissionCheckpoint(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(100), checkpoint.Height)
+	suite.Require().Equal("12345", checkpoint.Minted.String())
+	suite.Require().Equal(minter.BlockProvisions, checkpoint.BlockProvisions)
+	suite.Require().Equal(int64(90), checkpoint.LastReductionBlock)
+
+	// the emission invariant holds from the upgrade block
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInBlocks = 20
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	msg, broken := keeper.EmissionInvariant(suite.app.MintKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	// drop the fields added in v8 to start from a v7 store
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MaxSupply = sdk.Int{}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.ParamsKey, suite.app.AppCodec().MustMarshal(&params))
+	checkpoint, found := suite.app.MintKeeper.GetEmissionCheckpoint(suite.ctx)
+	suite.Require().True(found)
+	checkpoint.Clipped = sdk.Int{}
+	store.Set(types.EmissionCheckpointKey, suite.app.AppCodec().MustMarshal(&checkpoint))
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate7to8(suite.ctx))
+
+	params = suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().True(params.MaxSupply.IsZero())
+	suite.Require().NoError(params.Validate())
+	checkpoint, found = suite.app.MintKeeper.GetEmissionCheckpoint(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().True(checkpoint.Clipped.IsZero())
+
+	// the supply is not capped
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	msg, broken := keeper.EmissionInvariant(suite.app.MintKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+	suite.Require().True(suite.app.MintKeeper.GetDistributionTotals(suite.ctx).Minted.IsPositive())
+}
+
+func (suite *KeeperTestSuite) TestMigrate8to9() {
+	// drop the fields added in v9 to start from a v8 store
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.FeeBurnRatio = sdk.Dec{}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.ParamsKey, suite.app.AppCodec().MustMarshal(&params))
+
+	migrator := keeper.NewMigrator(suite.app.MintKeeper, suite.app.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate8to9(suite.ctx))
+
+	params = suite.app.MintKeeper.GetParams(suite.ctx)
+	suite.Require().True(params.FeeBurnRatio.IsZero())
+	suite.Require().Zero(params.FeeBurnEpochBlocks)
+	suite.Require().NoError(params.Validate())
+}
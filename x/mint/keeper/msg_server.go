@@ -3,9 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -19,23 +19,119 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
-// RegisterAccount implements the Msg/RegisterAccount interface
+// BurnTokens implements the Msg/BurnTokens interface
 func (k msgServer) BurnTokens(goCtx context.Context, msg *types.MsgBurnTokens) (*types.MsgBurnTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender := sdk.MustAccAddressFromBech32(msg.Sender)
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, govtypes.ModuleName, sdk.Coins(msg.Amount))
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
-	err = k.bankKeeper.BurnCoins(ctx, govtypes.ModuleName, sdk.Coins(msg.Amount))
+	if err := k.Keeper.BurnTokens(ctx, sender, sdk.Coins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnTokensResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams interface
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateDistributionTargets(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	oldParams := k.GetParams(ctx)
+	// the pending allocations are paid to the recipients they were allocated to, or to the
+	// community pool if one of them cannot receive them
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.FlushPendingDistribution(cacheCtx); err != nil {
+		redirected, redirectErr := k.redirectPendingDistribution(ctx, oldParams)
+		if redirectErr != nil {
+			return nil, redirectErr
+		}
+		k.recordMintFault(ctx, err, redirected)
+	} else {
+		write()
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	k.alignReductionSchedule(ctx, oldParams, msg.Params)
+	if emissionScheduleChanged(oldParams, msg.Params) {
+		k.ResetEmissionCheckpoint(ctx)
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ClaimVestedRewards implements the Msg/ClaimVestedRewards interface
+func (k msgServer) ClaimVestedRewards(goCtx context.Context, msg *types.MsgClaimVestedRewards) (*types.MsgClaimVestedRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.ClaimVestedRewards(ctx, receiver)
 	if err != nil {
 		return nil, err
 	}
 
-	params := k.GetParams(ctx)
-	params.TotalBurntAmount = sdk.Coins(params.TotalBurntAmount).Add(msg.Amount...)
-	k.SetParams(ctx, params)
+	return &types.MsgClaimVestedRewardsResponse{Amount: amount}, nil
+}
 
-	return &types.MsgBurnTokensResponse{}, nil
+// RotateVestingReceiver implements the Msg/RotateVestingReceiver interface
+func (k msgServer) RotateVestingReceiver(goCtx context.Context, msg *types.MsgRotateVestingReceiver) (*types.MsgRotateVestingReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	newReceiver, err := sdk.AccAddressFromBech32(msg.NewReceiver)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := k.Keeper.RotateVestingReceiver(ctx, receiver, newReceiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateVestingReceiverResponse{Slots: slots}, nil
+}
+
+// SetMintingPaused implements the Msg/SetMintingPaused interface
+func (k msgServer) SetMintingPaused(goCtx context.Context, msg *types.MsgSetMintingPaused) (*types.MsgSetMintingPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian := k.GetParams(ctx).GuardianAddress
+	if msg.Authority != k.authority && (guardian == "" || msg.Authority != guardian) {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s or the guardian, got %s", k.authority, msg.Authority)
+	}
+
+	event := types.EventSetMintingPaused{Authority: msg.Authority, Paused: msg.Paused}
+	if msg.Paused {
+		if err := k.PauseMinting(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		pausedBlocks, pausedDuration, err := k.ResumeMinting(ctx, msg.ExtendSchedule)
+		if err != nil {
+			return nil, err
+		}
+		event.PausedBlocks = pausedBlocks
+		event.PausedDuration = pausedDuration
+		event.ScheduleExtended = msg.ExtendSchedule
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetMintingPausedResponse{}, nil
 }
@@ -0,0 +1,352 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"time"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.MintKeeper.GetAuthority()
+	defaultParams := suite.app.MintKeeper.GetParams(suite.ctx)
+
+	updatedParams := defaultParams
+	updatedParams.BlocksPerYear = 6000000
+	updatedParams.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+
+	invalidParams := defaultParams
+	invalidParams.DistributionRecipients = append([]types.DistributionRecipient{}, defaultParams.DistributionRecipients...)
+	invalidParams.DistributionRecipients[2].Weight = sdk.NewDecWithPrec(90, 2)
+
+	unknownModuleParams := defaultParams
+	unknownModuleParams.DistributionRecipients = append([]types.DistributionRecipient{}, defaultParams.DistributionRecipients...)
+	unknownModuleParams.DistributionRecipients[2].Target = "unknown"
+
+	blockedAccountParams := defaultParams
+	blockedAccountParams.DistributionRecipients = append([]types.DistributionRecipient{}, defaultParams.DistributionRecipients...)
+	blockedAccountParams.DistributionRecipients[0].Target = suite.app.AccountKeeper.GetModuleAddress(types.ModuleName).String()
+
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	invalidReceiverParams := defaultParams
+	invalidReceiverParams.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: "tori1invalid", MonthlyAmounts: []math.Int{sdk.NewInt(1000)}},
+	}
+
+	negativeAmountParams := defaultParams
+	negativeAmountParams.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: receiver, MonthlyAmounts: []math.Int{sdk.NewInt(1000), sdk.NewInt(-1)}},
+	}
+
+	duplicateReceiverParams := defaultParams
+	duplicateReceiverParams.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: receiver, MonthlyAmounts: []math.Int{sdk.NewInt(1000)}},
+		{Address: receiver, MonthlyAmounts: []math.Int{sdk.NewInt(1000)}},
+	}
+
+	tests := []struct {
+		testCase   string
+		msg        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"invalid authority",
+			types.NewMsgUpdateParams(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), updatedParams),
+			false,
+		},
+		{
+			"invalid params",
+			types.NewMsgUpdateParams(authority, invalidParams),
+			false,
+		},
+		{
+			"unknown module recipient",
+			types.NewMsgUpdateParams(authority, unknownModuleParams),
+			false,
+		},
+		{
+			"blocked account recipient",
+			types.NewMsgUpdateParams(authority, blockedAccountParams),
+			false,
+		},
+		{
+			"invalid developer rewards receiver",
+			types.NewMsgUpdateParams(authority, invalidReceiverParams),
+			false,
+		},
+		{
+			"negative developer rewards amount",
+			types.NewMsgUpdateParams(authority, negativeAmountParams),
+			false,
+		},
+		{
+			"duplicate developer rewards receiver",
+			types.NewMsgUpdateParams(authority, duplicateReceiverParams),
+			false,
+		},
+		{
+			"successful update",
+			types.NewMsgUpdateParams(authority, updatedParams),
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest()
+		msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.msg)
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.testCase)
+			suite.Require().Equal(tc.msg.Params, suite.app.MintKeeper.GetParams(suite.ctx), tc.testCase)
+		} else {
+			suite.Require().Error(err, tc.testCase)
+			suite.Require().Equal(defaultParams, suite.app.MintKeeper.GetParams(suite.ctx), tc.testCase)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParamsReductionModeSwitch() {
+	authority := suite.app.MintKeeper.GetAuthority()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.ReductionPeriodInBlocks = 720
+	params.ReductionPeriodDuration = time.Hour // nominal block time of 5s
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+
+	// half of the period has elapsed in blocks
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.app.MintKeeper.SetLastReductionBlockNum(suite.ctx, 10)
+	suite.ctx = suite.ctx.WithBlockHeight(370).WithBlockTime(now)
+
+	params.ReductionMode = types.ReductionModeTime
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(now.Add(-30*time.Minute), suite.app.MintKeeper.GetLastReductionTime(suite.ctx))
+
+	// switching back after another quarter of the period in time
+	suite.ctx = suite.ctx.WithBlockHeight(400).WithBlockTime(now.Add(15 * time.Minute))
+	params.ReductionMode = types.ReductionModeBlocks
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(400-540), suite.app.MintKeeper.GetLastReductionBlockNum(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgBurnTokens() {
+	burner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	funds := sdk.NewCoins(sdk.NewInt64Coin("utori", 1000))
+
+	tests := []struct {
+		testCase   string
+		amounts    []sdk.Coins
+		expectPass bool
+	}{
+		{
+			"single burn",
+			[]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("utori", 400))},
+			true,
+		},
+		{
+			"multiple burns",
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("utori", 400)),
+				sdk.NewCoins(sdk.NewInt64Coin("utori", 500)),
+			},
+			true,
+		},
+		{
+			"insufficient funds",
+			[]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("utori", 1001))},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest()
+		msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+		suite.Require().NoError(suite.app.MintKeeper.MintCoins(suite.ctx, funds))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, burner, funds))
+		supply := suite.app.BankKeeper.GetSupply(suite.ctx, "utori")
+
+		burnt := sdk.Coins{}
+		for _, amount := range tc.amounts {
+			_, err := msgServer.BurnTokens(sdk.WrapSDKContext(suite.ctx), types.NewMsgBurnTokens(burner.String(), amount))
+			if !tc.expectPass {
+				suite.Require().Error(err, tc.testCase)
+				continue
+			}
+			suite.Require().NoError(err, tc.testCase)
+			burnt = burnt.Add(amount...)
+		}
+
+		suite.Require().Equal(supply.SubAmount(burnt.AmountOf("utori")), suite.app.BankKeeper.GetSupply(suite.ctx, "utori"), tc.testCase)
+		suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero(), tc.testCase)
+		suite.Require().Equal(burnt, suite.app.MintKeeper.GetTotalBurnt(suite.ctx), tc.testCase)
+		suite.Require().Equal(burnt, suite.app.MintKeeper.GetBurntByAddress(suite.ctx, burner), tc.testCase)
+
+		history := suite.app.MintKeeper.GetBurnHistory(suite.ctx)
+		if !tc.expectPass {
+			suite.Require().Empty(history, tc.testCase)
+			continue
+		}
+		suite.Require().Len(history, len(tc.amounts), tc.testCase)
+		for i, record := range history {
+			suite.Require().Equal(uint64(i), record.Id, tc.testCase)
+			suite.Require().Equal(burner.String(), record.Burner, tc.testCase)
+			suite.Require().Equal(sdk.Coins(tc.amounts[i]), sdk.Coins(record.Amount), tc.testCase)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgClaimVestedRewards() {
+	suite.SetupTest()
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 1
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{
+			Address:        devAddr.String(),
+			MonthlyAmounts: []math.Int{sdk.NewInt(1000), sdk.NewInt(500)},
+		},
+	}
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{
+		MonthStartedBlock:      1,
+		OneMonthPeriodInBlocks: 3,
+	})
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+	claim := func() (*types.MsgClaimVestedRewardsResponse, error) {
+		return msgServer.ClaimVestedRewards(sdk.WrapSDKContext(suite.ctx), types.NewMsgClaimVestedRewards(devAddr.String()))
+	}
+
+	_, err := claim()
+	suite.Require().ErrorIs(err, types.ErrNoVestedRewards)
+
+	// a monthly amount that does not divide by the month length vests exactly
+	for height := int64(1); height <= 3; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+	}
+	res, err := querier.VestingRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryVestingRewardsRequest{Address: devAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal("1000", res.Rewards.Claimable.String())
+	suite.Require().Equal("0", res.Rewards.Claimed.String())
+
+	claimRes, err := claim()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)), sdk.Coins(claimRes.Amount))
+	suite.Require().Equal("1000", suite.app.BankKeeper.GetBalance(suite.ctx, devAddr, params.MintDenom).Amount.String())
+
+	_, err = claim()
+	suite.Require().ErrorIs(err, types.ErrNoVestedRewards)
+
+	// the second month keeps accruing after the claim
+	suite.ctx = suite.ctx.WithBlockHeight(4)
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	allRes, err := querier.AllVestingRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryAllVestingRewardsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Rewards, 1)
+	suite.Require().Equal("166", allRes.Rewards[0].Claimable.String())
+	suite.Require().Equal("1000", allRes.Rewards[0].Claimed.String())
+}
+
+func (suite *KeeperTestSuite) TestMsgRotateVestingReceiver() {
+	suite.SetupTest()
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	newAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	params := suite.app.MintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 1
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: devAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(300), sdk.NewInt(600)}},
+		{Address: otherAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(300), sdk.NewInt(300)}},
+		{Address: "", MonthlyAmounts: []math.Int{sdk.NewInt(300), sdk.NewInt(300)}},
+	}
+	suite.Require().NoError(suite.app.MintKeeper.SetParams(suite.ctx, params))
+	suite.app.MintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{
+		MonthStartedBlock:      1,
+		OneMonthPeriodInBlocks: 3,
+	})
+
+	for height := int64(1); height <= 3; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.MintKeeper.EndBlocker(suite.ctx)
+	}
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	querier := keeper.NewQuerier(suite.app.MintKeeper)
+
+	_, err := msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(newAddr.String(), devAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrNotVestingReceiver)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), feeCollector.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingReceiver)
+
+	// the slots of two receivers are not merged
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), otherAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingReceiver)
+
+	// the new receiver is validated like the receivers of a parameters update
+	govAddr := suite.app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	suite.Require().False(suite.app.BankKeeper.BlockedAddr(govAddr))
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(suite.ctx, govAddr))
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), govAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingReceiver)
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), params.TeamReserveAddress))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingReceiver)
+	recipientAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	updated := suite.app.MintKeeper.GetParams(suite.ctx)
+	updated.DistributionRecipients = append([]types.DistributionRecipient{}, updated.DistributionRecipients...)
+	updated.DistributionRecipients[0].TargetType = types.DistributionTargetAccount
+	updated.DistributionRecipients[0].Target = otherAddr.String()
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(suite.app.MintKeeper.GetAuthority(), updated))
+	suite.Require().ErrorIs(err, types.ErrInvalidDistributionRecipient)
+	updated.DistributionRecipients[0].Target = recipientAddr.String()
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(suite.app.MintKeeper.GetAuthority(), updated))
+	suite.Require().NoError(err)
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), recipientAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingReceiver)
+	suite.Require().Empty(suite.app.MintKeeper.GetVestingReceiverRotations(suite.ctx, 0))
+
+	suite.ctx = suite.ctx.WithBlockHeight(4)
+	res, err := msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(devAddr.String(), newAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{0}, res.Slots)
+
+	receivers := suite.app.MintKeeper.GetParams(suite.ctx).WeightedDeveloperRewardsReceivers
+	suite.Require().Equal(newAddr.String(), receivers[0].Address)
+	suite.Require().Equal(otherAddr.String(), receivers[1].Address)
+	suite.Require().Equal("", receivers[2].Address)
+
+	// the rewards vested before the rotation stay with the previous receiver
+	suite.app.MintKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal("300", suite.app.MintKeeper.GetVestingRewards(suite.ctx, devAddr).Claimable.String())
+	suite.Require().Equal("200", suite.app.MintKeeper.GetVestingRewards(suite.ctx, newAddr).Claimable.String())
+
+	// rotating back is recorded as a second rotation of the slots
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	_, err = msgServer.RotateVestingReceiver(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateVestingReceiver(newAddr.String(), devAddr.String()))
+	suite.Require().NoError(err)
+
+	rotationsRes, err := querier.VestingReceiverRotations(sdk.WrapSDKContext(suite.ctx), &types.QueryVestingReceiverRotationsRequest{Slot: 0})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.VestingReceiverRotation{
+		{Id: 0, Slot: 0, PreviousAddress: devAddr.String(), NewAddress: newAddr.String(), Height: 4},
+		{Id: 1, Slot: 0, PreviousAddress: newAddr.String(), NewAddress: devAddr.String(), Height: 5},
+	}, rotationsRes.Rotations)
+
+	rotationsRes, err = querier.VestingReceiverRotations(sdk.WrapSDKContext(suite.ctx), &types.QueryVestingReceiverRotationsRequest{Slot: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(rotationsRes.Rotations)
+}
@@ -1,17 +1,111 @@
 package keeper
 
 import (
+	"fmt"
+
+	"cosmossdk.io/errors"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of minting parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// validateDistributionTargets checks that the distribution recipients, the team reserve and the
+// developer rewards receivers can receive coins from the mint module account, which the stateless
+// validation of the parameters cannot tell. A developer rewards receiver must also be a plain
+// account, distinct from the account recipients and the team reserve, so that its vested rewards
+// are not mixed with the other allocations.
+func (k Keeper) validateDistributionTargets(ctx sdk.Context, params types.Params) error {
+	if params.TeamReserveAddress != "" {
+		if err := k.validateReceiver(params.TeamReserveAddress); err != nil {
+			return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: %s", types.BucketTeamReserve, err)
+		}
+	}
+	for _, w := range params.WeightedDeveloperRewardsReceivers {
+		if w.Address == "" {
+			continue
+		}
+		if err := k.validateReceiver(w.Address); err != nil {
+			return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: %s", types.BucketDeveloperVesting, err)
+		}
+		if err := k.validateDeveloperReceiver(ctx, params, w.Address); err != nil {
+			return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: %s", types.BucketDeveloperVesting, err)
+		}
+	}
+
+	for _, r := range params.DistributionRecipients {
+		switch r.TargetType {
+		case types.DistributionTargetModule:
+			if k.accountKeeper.GetModuleAddress(r.Target) == nil {
+				return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: module account %s does not exist", r.Name, r.Target)
+			}
+		case types.DistributionTargetAccount:
+			addr, err := sdk.AccAddressFromBech32(r.Target)
+			if err != nil {
+				return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: %s", r.Name, err)
+			}
+			if k.bankKeeper.BlockedAddr(addr) {
+				return errors.Wrapf(types.ErrInvalidDistributionRecipient, "%s: %s is not allowed to receive funds", r.Name, r.Target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateReceiver checks that an account address can receive funds.
+func (k Keeper) validateReceiver(address string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	if k.bankKeeper.BlockedAddr(addr) {
+		return fmt.Errorf("%s is not allowed to receive funds", address)
+	}
+	return nil
+}
+
+// validateDeveloperReceiver checks that a developer rewards receiver is neither a module account,
+// nor the team reserve, nor the target of an account distribution recipient.
+func (k Keeper) validateDeveloperReceiver(ctx sdk.Context, params types.Params, address string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+		return fmt.Errorf("%s is a module account", address)
+	}
+	if address == params.TeamReserveAddress {
+		return fmt.Errorf("%s is the team reserve", address)
+	}
+	for _, r := range params.DistributionRecipients {
+		if r.TargetType == types.DistributionTargetAccount && r.Target == address {
+			return fmt.Errorf("%s is the target of the %s recipient", address, r.Name)
+		}
+	}
+	return nil
 }
@@ -16,25 +16,21 @@ func (suite *KeeperTestSuite) TestParamsGetSet() {
 		GenesisBlockProvisions:  sdk.NewDec(1000),
 		ReductionPeriodInBlocks: 86400,
 		ReductionFactor:         sdk.NewDecWithPrec(5, 1),
-		DistributionProportions: types.DistributionProportions{
-			GrantsProgram:    sdk.NewDecWithPrec(2, 1),
-			CommunityPool:    sdk.NewDecWithPrec(2, 1),
-			UsageIncentive:   sdk.NewDecWithPrec(2, 1),
-			Staking:          sdk.NewDecWithPrec(2, 1),
-			DeveloperRewards: sdk.NewDecWithPrec(2, 1),
-		},
+		DistributionProportions: types.DefaultParams().DistributionProportions,
+		DistributionRecipients:  equalDistributionRecipients(addr, addr),
 		WeightedDeveloperRewardsReceivers: []types.MonthlyVestingAddress{
 			{
 				Address:        "",
 				MonthlyAmounts: []math.Int{sdk.NewInt(7000), sdk.NewInt(7000), sdk.NewInt(7000)},
 			},
 		},
-		UsageIncentiveAddress:                addr.String(),
-		GrantsProgramAddress:                 addr.String(),
 		TeamReserveAddress:                   addr.String(),
 		MintingRewardsDistributionStartBlock: 1,
 		BlocksPerYear:                        5733818,
 		TotalBurntAmount:                     sdk.Coins(nil),
+		MaxSupply:                            sdk.ZeroInt(),
+		FeeBurnRatio:                         sdk.NewDecWithPrec(25, 2),
+		FeeBurnEpochBlocks:                   100,
 	}
 
 	suite.app.MintKeeper.SetParams(suite.ctx, params)
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMintingPause returns the state of the minting circuit breaker.
+func (k Keeper) GetMintingPause(ctx sdk.Context) types.MintingPause {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintingPauseKey)
+	if bz == nil {
+		return types.MintingPause{}
+	}
+
+	var pause types.MintingPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause
+}
+
+// SetMintingPause sets the state of the minting circuit breaker.
+func (k Keeper) SetMintingPause(ctx sdk.Context, pause types.MintingPause) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintingPauseKey, k.cdc.MustMarshal(&pause))
+}
+
+// IsMintingPaused returns whether minting and distribution are paused.
+func (k Keeper) IsMintingPaused(ctx sdk.Context) bool {
+	return k.GetMintingPause(ctx).Paused
+}
+
+// PauseMinting stops minting and distribution from the current block on.
+func (k Keeper) PauseMinting(ctx sdk.Context) error {
+	pause := k.GetMintingPause(ctx)
+	if pause.Paused {
+		return errors.Wrapf(types.ErrMintingPaused, "since height %d", pause.PausedHeight)
+	}
+
+	pause.Paused = true
+	pause.PausedHeight = ctx.BlockHeight()
+	pause.PausedTime = ctx.BlockTime()
+	k.SetMintingPause(ctx, pause)
+	return nil
+}
+
+// ResumeMinting resumes minting and distribution from the current block on,
+// and returns the number of blocks and the block time the pause lasted. If
+// extendSchedule is set, the reduction schedule and the current vesting month
+// are delayed by the pause, so that it does not consume schedule time.
+// Otherwise the reductions and the vesting months due during the pause happen
+// on their original boundaries.
+func (k Keeper) ResumeMinting(ctx sdk.Context, extendSchedule bool) (int64, time.Duration, error) {
+	pause := k.GetMintingPause(ctx)
+	if !pause.Paused {
+		return 0, 0, types.ErrMintingNotPaused
+	}
+
+	pausedBlocks := ctx.BlockHeight() - pause.PausedHeight
+	pausedDuration := ctx.BlockTime().Sub(pause.PausedTime)
+	if extendSchedule {
+		k.SetLastReductionBlockNum(ctx, k.GetLastReductionBlockNum(ctx)+pausedBlocks)
+		if lastReductionTime := k.GetLastReductionTime(ctx); !lastReductionTime.IsZero() {
+			k.SetLastReductionTime(ctx, lastReductionTime.Add(pausedDuration))
+		}
+		monthInfo := k.GetTeamVestingMonthInfo(ctx)
+		monthInfo.MonthStartedBlock += pausedBlocks
+		k.SetTeamVestingMonthInfo(ctx, monthInfo)
+	} else {
+		k.catchUpSchedule(ctx)
+	}
+
+	// in time mode, the first block after the pause is not scaled by the time
+	// elapsed since the last minted block
+	k.SetLastBlockTime(ctx, time.Time{})
+
+	k.SetMintingPause(ctx, types.MintingPause{
+		TotalPausedBlocks:   pause.TotalPausedBlocks + pausedBlocks,
+		TotalPausedDuration: pause.TotalPausedDuration + pausedDuration,
+	})
+	return pausedBlocks, pausedDuration, nil
+}
+
+// catchUpSchedule applies the block mode reductions and the vesting months due
+// while minting was paused, the next ones starting where the due ones ended
+// rather than at the current block. The time mode reductions already keep their
+// boundaries when they are caught up.
+func (k Keeper) catchUpSchedule(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	blockNumber := ctx.BlockHeight()
+	if blockNumber < params.MintingRewardsDistributionStartBlock {
+		return
+	}
+
+	if params.ReductionMode != types.ReductionModeTime {
+		lastReductionBlock := k.GetLastReductionBlockNum(ctx)
+		minter := k.GetMinter(ctx)
+		reduced, periods := minter, 0
+		for periods < maxReductionsPerBlock && blockNumber >= lastReductionBlock+params.ReductionPeriodInBlocks {
+			reduced.BlockProvisions = reduced.NextBlockProvisions(params)
+			lastReductionBlock += params.ReductionPeriodInBlocks
+			periods++
+		}
+		if periods > 0 {
+			k.reduceBlockProvisions(ctx, minter, reduced)
+			k.SetLastReductionBlockNum(ctx, lastReductionBlock)
+		}
+	}
+
+	monthInfo := k.GetTeamVestingMonthInfo(ctx)
+	if monthInfo.OneMonthPeriodInBlocks <= 0 {
+		return
+	}
+	if monthInfo.MonthStartedBlock < params.MintingRewardsDistributionStartBlock {
+		monthInfo.MonthStartedBlock = params.MintingRewardsDistributionStartBlock
+	}
+	for blockNumber >= monthInfo.OneMonthPeriodInBlocks+monthInfo.MonthStartedBlock {
+		monthInfo.MonthsSinceGenesis++
+		monthInfo.MonthStartedBlock += monthInfo.OneMonthPeriodInBlocks
+		k.SetTeamVestingMonthInfo(ctx, monthInfo)
+		if k.hooks != nil {
+			k.hooks.AfterVestingMonthAdvanced(ctx, monthInfo.MonthsSinceGenesis)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestSetMintingPaused() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	querier := keeper.NewQuerier(mintKeeper)
+	invariant := keeper.AllInvariants(mintKeeper)
+	authority := mintKeeper.GetAuthority()
+	guardian := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	stranger := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	params := mintKeeper.GetParams(suite.ctx)
+	params.MintingRewardsDistributionStartBlock = 1
+	params.ReductionPeriodInBlocks = 100
+	params.GuardianAddress = guardian
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	mintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{MonthStartedBlock: 1, OneMonthPeriodInBlocks: 50})
+
+	blockTime := time.Unix(1_000_000, 0).UTC()
+	// endBlock runs the block at height and returns the amount it minted
+	endBlock := func(height int64) string {
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * 6 * time.Second))
+		before := mintKeeper.GetDistributionTotals(suite.ctx).Minted
+		mintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+		return mintKeeper.GetDistributionTotals(suite.ctx).Minted.Sub(before).String()
+	}
+	setPaused := func(signer string, paused, extendSchedule bool) error {
+		msg := types.NewMsgSetMintingPaused(signer, paused, extendSchedule)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := msgServer.SetMintingPaused(sdk.WrapSDKContext(suite.ctx), msg)
+		return err
+	}
+
+	for height := int64(1); height <= 5; height++ {
+		suite.Require().Equal("47000000", endBlock(height))
+	}
+	suite.Require().Equal(int64(1), mintKeeper.GetLastReductionBlockNum(suite.ctx))
+
+	// only the governance account and the guardian can pause minting
+	suite.ctx = suite.ctx.WithBlockHeight(6).WithBlockTime(blockTime.Add(36 * time.Second))
+	suite.Require().ErrorIs(setPaused(stranger, true, false), types.ErrInvalidAuthority)
+	suite.Require().Error(setPaused(guardian, true, true))
+	suite.Require().NoError(setPaused(guardian, true, false))
+	suite.Require().ErrorIs(setPaused(authority, true, false), types.ErrMintingPaused)
+
+	// nothing is minted while paused
+	for height := int64(6); height <= 15; height++ {
+		suite.Require().Equal("0", endBlock(height))
+	}
+	res, err := querier.MintingPause(sdk.WrapSDKContext(suite.ctx), &types.QueryMintingPauseRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Pause.Paused)
+	suite.Require().Equal(int64(6), res.Pause.PausedHeight)
+
+	// resuming with the schedule extended delays the reduction and the vesting month by the pause
+	suite.ctx = suite.ctx.WithBlockHeight(16).WithBlockTime(blockTime.Add(96 * time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.Require().ErrorIs(setPaused(stranger, false, true), types.ErrInvalidAuthority)
+	suite.Require().NoError(setPaused(authority, false, true))
+	suite.Require().Equal(int64(11), mintKeeper.GetLastReductionBlockNum(suite.ctx))
+	suite.Require().Equal(int64(11), mintKeeper.GetTeamVestingMonthInfo(suite.ctx).MonthStartedBlock)
+	suite.Require().True(mintKeeper.GetLastBlockTime(suite.ctx).IsZero())
+	pause := mintKeeper.GetMintingPause(suite.ctx)
+	suite.Require().Equal(types.MintingPause{TotalPausedBlocks: 10, TotalPausedDuration: time.Minute}, pause)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(proto.MessageName(&types.EventSetMintingPaused{}), events[len(events)-1].Type)
+	attribute, found := events[len(events)-1].GetAttribute("paused_blocks")
+	suite.Require().True(found)
+	suite.Require().Equal(`"10"`, attribute.Value)
+
+	suite.Require().Equal("47000000", endBlock(16))
+	suite.Require().ErrorIs(setPaused(guardian, false, false), types.ErrMintingNotPaused)
+
+	// resuming without extending the schedule keeps the reduction and the vesting month
+	suite.ctx = suite.ctx.WithBlockHeight(20)
+	suite.Require().NoError(setPaused(guardian, true, false))
+	suite.Require().Equal("0", endBlock(20))
+	suite.ctx = suite.ctx.WithBlockHeight(25)
+	suite.Require().NoError(setPaused(guardian, false, false))
+	suite.Require().Equal(int64(11), mintKeeper.GetLastReductionBlockNum(suite.ctx))
+	suite.Require().Equal(int64(11), mintKeeper.GetTeamVestingMonthInfo(suite.ctx).MonthStartedBlock)
+	suite.Require().Equal(int64(15), mintKeeper.GetMintingPause(suite.ctx).TotalPausedBlocks)
+	suite.Require().Equal("47000000", endBlock(25))
+
+	// resuming without extending the schedule after a reduction boundary keeps the
+	// original reduction and vesting month schedule
+	suite.ctx = suite.ctx.WithBlockHeight(30)
+	suite.Require().NoError(setPaused(guardian, true, false))
+	for height := int64(30); height <= 130; height++ {
+		suite.Require().Equal("0", endBlock(height))
+	}
+	suite.ctx = suite.ctx.WithBlockHeight(131)
+	suite.Require().NoError(setPaused(guardian, false, false))
+	suite.Require().Equal(int64(111), mintKeeper.GetLastReductionBlockNum(suite.ctx))
+	suite.Require().Equal(int64(111), mintKeeper.GetTeamVestingMonthInfo(suite.ctx).MonthStartedBlock)
+	suite.Require().Equal(int64(2), mintKeeper.GetTeamVestingMonthInfo(suite.ctx).MonthsSinceGenesis)
+	suite.Require().Equal("31330200", endBlock(131))
+	for height := int64(132); height < 211; height++ {
+		suite.Require().Equal("31330200", endBlock(height))
+	}
+	suite.Require().Equal("20884711", endBlock(211))
+	suite.Require().Equal(int64(211), mintKeeper.GetLastReductionBlockNum(suite.ctx))
+
+	// the pause is exported in genesis
+	suite.Require().NoError(setPaused(guardian, true, false))
+	genesis := mintKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(mintKeeper.GetMintingPause(suite.ctx), genesis.MintingPause)
+}
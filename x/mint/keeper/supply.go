@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTotalSupply returns the total supply of the mint denom.
+func (k Keeper) GetTotalSupply(ctx sdk.Context) math.Int {
+	return k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).MintDenom).Amount
+}
+
+// ClipToMaxSupply returns the part of a block provision that can be minted
+// without the total supply of the mint denom exceeding the max supply, which is
+// zero once the max supply is reached, until burns make room for minting again.
+func (k Keeper) ClipToMaxSupply(ctx sdk.Context, params types.Params, provision math.Int) math.Int {
+	if params.MaxSupply.IsNil() || params.MaxSupply.IsZero() {
+		return provision
+	}
+
+	headroom := params.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	if !headroom.IsPositive() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(provision, headroom)
+}
+
+// GetCirculatingSupply returns the total supply of the mint denom minus the
+// balances and delegations of the supply locked addresses. It only reads the
+// locked addresses, so the module accounts and vesting accounts whose tokens
+// do not circulate must be listed in the locked addresses.
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context) math.Int {
+	params := k.GetParams(ctx)
+	denom := params.MintDenom
+
+	locked := math.ZeroInt()
+	for _, addr := range params.SupplyLockedAddresses() {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			continue
+		}
+		locked = locked.Add(k.bankKeeper.GetBalance(ctx, accAddr, denom).Amount)
+		if params.MintDenom == k.stakingKeeper.BondDenom(ctx) {
+			locked = locked.Add(k.stakingKeeper.GetDelegatorBonded(ctx, accAddr))
+			locked = locked.Add(k.stakingKeeper.GetDelegatorUnbonding(ctx, accAddr))
+		}
+	}
+
+	circulating := k.GetTotalSupply(ctx).Sub(locked)
+	if circulating.IsNegative() {
+		return math.ZeroInt()
+	}
+	return circulating
+}
+
+// GetBurntSupply returns the cumulative amount of the mint denom burnt.
+func (k Keeper) GetBurntSupply(ctx sdk.Context) math.Int {
+	return k.GetTotalBurntByDenom(ctx, k.GetParams(ctx).MintDenom).Amount
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestSupply() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	mintKeeper := suite.app.MintKeeper
+	querier := keeper.NewQuerier(mintKeeper)
+	params := mintKeeper.GetParams(suite.ctx)
+	denom := params.MintDenom
+
+	fund := func(addr sdk.AccAddress, amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+		suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, coins))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addr, coins))
+	}
+	supply := func() (total, circulating, burnt string) {
+		ctx := sdk.WrapSDKContext(suite.ctx)
+		totalRes, err := querier.TotalSupply(ctx, &types.QueryTotalSupplyRequest{})
+		suite.Require().NoError(err)
+		circulatingRes, err := querier.CirculatingSupply(ctx, &types.QueryCirculatingSupplyRequest{})
+		suite.Require().NoError(err)
+		burntRes, err := querier.BurntSupply(ctx, &types.QueryBurntSupplyRequest{})
+		suite.Require().NoError(err)
+		return totalRes.Amount.String(), circulatingRes.Amount.String(), burntRes.Amount.String()
+	}
+
+	initialTotal := mintKeeper.GetTotalSupply(suite.ctx)
+	initialCirculating := mintKeeper.GetCirculatingSupply(suite.ctx)
+	suite.Require().True(initialCirculating.LTE(initialTotal))
+
+	// a regular account balance circulates
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	fund(holder, 1000)
+
+	// the listed module accounts and the team reserve balances are locked
+	suite.Require().NoError(mintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, disttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	teamReserve, err := sdk.AccAddressFromBech32(params.TeamReserveAddress)
+	suite.Require().NoError(err)
+	fund(teamReserve, 200)
+
+	// the balance and delegations of a locked address are locked
+	lockedAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	fund(lockedAddr, 500)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, lockedAddr, sdk.NewInt(200), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+	distrAddr := suite.app.AccountKeeper.GetModuleAddress(disttypes.ModuleName)
+	params.LockedAddresses = []string{lockedAddr.String(), distrAddr.String()}
+	suite.Require().NoError(mintKeeper.SetParams(suite.ctx, params))
+	// the community pool circulated until the distribution module account was listed
+	initialCirculating = initialCirculating.Sub(suite.app.BankKeeper.GetBalance(suite.ctx, distrAddr, denom).Amount).AddRaw(300)
+
+	total, circulating, burnt := supply()
+	suite.Require().Equal(initialTotal.AddRaw(2000).String(), total)
+	suite.Require().Equal(initialCirculating.AddRaw(1000).String(), circulating)
+	suite.Require().Equal("0", burnt)
+
+	// burnt tokens leave the total and circulating supplies
+	suite.Require().NoError(mintKeeper.BurnTokens(suite.ctx, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	total, circulating, burnt = supply()
+	suite.Require().Equal(initialTotal.AddRaw(1900).String(), total)
+	suite.Require().Equal(initialCirculating.AddRaw(900).String(), circulating)
+	suite.Require().Equal("100", burnt)
+}
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	suite.SetupTest()
+	mintKeeper := suite.app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+	grantsAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	usageIncentiveAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	devAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	teamReserveAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	mintKeeper.SetMinter(suite.ctx, types.NewMinter(sdk.NewDec(1000)))
+	mintKeeper.SetTeamVestingMonthInfo(suite.ctx, types.TeamVestingMonthInfo{MonthStartedBlock: 1, OneMonthPeriodInBlocks: 10})
+	params := mintKeeper.GetParams(suite.ctx)
+	denom := params.MintDenom
+	params.MintingRewardsDistributionStartBlock = 1
+	params.ReductionPeriodInBlocks = 1000
+	params.DistributionRecipients = equalDistributionRecipients(grantsAddr, usageIncentiveAddr)
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: devAddr.String(), MonthlyAmounts: []math.Int{sdk.NewInt(1000)}},
+	}
+	params.TeamReserveAddress = teamReserveAddr.String()
+	// room for two and a half blocks
+	params.MaxSupply = mintKeeper.GetTotalSupply(suite.ctx).AddRaw(2500)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateParams(mintKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	balance := func(addr sdk.AccAddress) math.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, addr, denom).Amount
+	}
+	// buckets returns the grants, usage incentive, staking, developer vesting,
+	// team reserve and community pool amounts
+	buckets := func() []math.Int {
+		return []math.Int{
+			balance(grantsAddr),
+			balance(usageIncentiveAddr),
+			balance(feeCollector),
+			mintKeeper.GetVestingRewards(suite.ctx, devAddr).Claimable,
+			balance(teamReserveAddr),
+			suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).TruncateInt(),
+		}
+	}
+	invariant := keeper.AllInvariants(mintKeeper)
+	// endBlock runs the block at height and returns the amounts it distributed
+	endBlock := func(height int64) []string {
+		before := buckets()
+		suite.ctx = suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		mintKeeper.EndBlocker(suite.ctx)
+		msg, broken := invariant(suite.ctx)
+		suite.Require().False(broken, msg)
+
+		distributed := []string{}
+		for i, after := range buckets() {
+			distributed = append(distributed, after.Sub(before[i]).String())
+		}
+		return distributed
+	}
+	expected := func(amounts ...int64) []string {
+		res := []string{}
+		for _, amount := range amounts {
+			res = append(res, sdk.NewInt(amount).String())
+		}
+		return res
+	}
+
+	// the full provisions are minted below the max supply
+	suite.Require().Equal(expected(200, 200, 200, 100, 100, 200), endBlock(1))
+	suite.Require().Equal(expected(200, 200, 200, 100, 100, 200), endBlock(2))
+
+	// every bucket is clipped by the same ratio
+	suite.Require().Equal(expected(100, 100, 100, 50, 50, 100), endBlock(3))
+	suite.Require().Equal(params.MaxSupply, mintKeeper.GetTotalSupply(suite.ctx))
+
+	// minting is suspended at the max supply
+	suite.Require().Equal(expected(0, 0, 0, 0, 0, 0), endBlock(4))
+	suite.Require().Equal(params.MaxSupply, mintKeeper.GetTotalSupply(suite.ctx))
+	events := suite.ctx.EventManager().Events()
+	suite.Require().NotEmpty(events)
+	attribute, found := events[len(events)-1].GetAttribute(types.AttributeKeyClipped)
+	suite.Require().True(found)
+	suite.Require().Equal("1000", attribute.Value)
+
+	// burns make room for minting again
+	suite.Require().NoError(mintKeeper.BurnTokens(suite.ctx, grantsAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	suite.Require().Equal(expected(60, 60, 60, 30, 30, 60), endBlock(5))
+	suite.Require().Equal(params.MaxSupply, mintKeeper.GetTotalSupply(suite.ctx))
+
+	// the clipped provisions are recorded in the distribution totals as not minted
+	suite.Require().Equal(sdk.NewInt(2800), mintKeeper.GetDistributionTotals(suite.ctx).Minted)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetVestingRewards returns the vested and claimed developer rewards of a
+// receiver.
+func (k Keeper) GetVestingRewards(ctx sdk.Context, addr sdk.AccAddress) types.VestingRewards {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVestingRewardsKey(addr))
+	if bz == nil {
+		return types.VestingRewards{
+			Address:   addr.String(),
+			Claimable: math.ZeroInt(),
+			Claimed:   math.ZeroInt(),
+		}
+	}
+
+	var rewards types.VestingRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards
+}
+
+// SetVestingRewards sets the vested and claimed developer rewards of a
+// receiver.
+func (k Keeper) SetVestingRewards(ctx sdk.Context, rewards types.VestingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(rewards.Address)
+	store.Set(types.GetVestingRewardsKey(addr), k.cdc.MustMarshal(&rewards))
+}
+
+// GetAllVestingRewards returns the vested and claimed developer rewards of all
+// receivers.
+func (k Keeper) GetAllVestingRewards(ctx sdk.Context) []types.VestingRewards {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingRewardsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	all := []types.VestingRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.VestingRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		all = append(all, rewards)
+	}
+	return all
+}
+
+// AddVestedRewards adds to the claimable developer rewards of a receiver. The
+// coins stay in the mint module account until claimed.
+func (k Keeper) AddVestedRewards(ctx sdk.Context, addr sdk.AccAddress, amount math.Int) {
+	rewards := k.GetVestingRewards(ctx, addr)
+	rewards.Claimable = rewards.Claimable.Add(amount)
+	k.SetVestingRewards(ctx, rewards)
+}
+
+// ClaimVestedRewards pays the claimable developer rewards of a receiver.
+func (k Keeper) ClaimVestedRewards(ctx sdk.Context, receiver sdk.AccAddress) (sdk.Coins, error) {
+	rewards := k.GetVestingRewards(ctx, receiver)
+	if !rewards.Claimable.IsPositive() {
+		return nil, errors.Wrapf(types.ErrNoVestedRewards, "%s", receiver)
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).MintDenom, rewards.Claimable))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, amount); err != nil {
+		return nil, err
+	}
+
+	rewards.Claimed = rewards.Claimed.Add(rewards.Claimable)
+	rewards.Claimable = math.ZeroInt()
+	k.SetVestingRewards(ctx, rewards)
+
+	return amount, ctx.EventManager().EmitTypedEvent(&types.EventClaimVestedRewards{
+		Receiver: receiver.String(),
+		Amount:   amount,
+	})
+}
+
+// RotateVestingReceiver moves the future monthly amounts of all the developer rewards receiver slots
+// of receiver to newReceiver, and returns the rotated slots. The rewards vested so far stay
+// claimable by receiver. The new receiver is validated like the receivers of a parameters update.
+func (k Keeper) RotateVestingReceiver(ctx sdk.Context, receiver, newReceiver sdk.AccAddress) ([]uint64, error) {
+	if receiver.Equals(newReceiver) {
+		return nil, errors.Wrap(types.ErrInvalidVestingReceiver, "new receiver must differ from the receiver")
+	}
+
+	params := k.GetParams(ctx)
+	isReceiver, isNewReceiver := false, false
+	for _, w := range params.WeightedDeveloperRewardsReceivers {
+		isReceiver = isReceiver || w.Address == receiver.String()
+		isNewReceiver = isNewReceiver || w.Address == newReceiver.String()
+	}
+	if !isReceiver {
+		return nil, errors.Wrapf(types.ErrNotVestingReceiver, "%s", receiver)
+	}
+	// the slots of two receivers are not merged, as their vested rewards would be claimable by
+	// a single address
+	if isNewReceiver {
+		return nil, errors.Wrapf(types.ErrInvalidVestingReceiver, "%s already receives developer rewards", newReceiver)
+	}
+	slots := []uint64{}
+	for i, w := range params.WeightedDeveloperRewardsReceivers {
+		if w.Address != receiver.String() {
+			continue
+		}
+		params.WeightedDeveloperRewardsReceivers[i].Address = newReceiver.String()
+		slots = append(slots, uint64(i))
+	}
+	if err := k.validateDistributionTargets(ctx, params); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidVestingReceiver, err.Error())
+	}
+	for _, slot := range slots {
+		k.SetVestingReceiverRotation(ctx, types.VestingReceiverRotation{
+			Id:              k.nextVestingReceiverRotationID(ctx),
+			Slot:            slot,
+			PreviousAddress: receiver.String(),
+			NewAddress:      newReceiver.String(),
+			Height:          ctx.BlockHeight(),
+		})
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return slots, ctx.EventManager().EmitTypedEvent(&types.EventRotateVestingReceiver{
+		Receiver:    receiver.String(),
+		NewReceiver: newReceiver.String(),
+		Slots:       slots,
+	})
+}
+
+// SetVestingReceiverRotation stores a developer rewards receiver rotation.
+func (k Keeper) SetVestingReceiverRotation(ctx sdk.Context, rotation types.VestingReceiverRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVestingReceiverRotationKey(rotation.Slot, rotation.Id), k.cdc.MustMarshal(&rotation))
+}
+
+// GetVestingReceiverRotations returns the rotations of a developer rewards
+// receiver slot, oldest first.
+func (k Keeper) GetVestingReceiverRotations(ctx sdk.Context, slot uint64) []types.VestingReceiverRotation {
+	return k.getVestingReceiverRotations(ctx, types.GetVestingReceiverRotationPrefix(slot))
+}
+
+// GetAllVestingReceiverRotations returns the rotations of all developer
+// rewards receiver slots.
+func (k Keeper) GetAllVestingReceiverRotations(ctx sdk.Context) []types.VestingReceiverRotation {
+	return k.getVestingReceiverRotations(ctx, types.VestingReceiverRotationKeyPrefix)
+}
+
+func (k Keeper) getVestingReceiverRotations(ctx sdk.Context, keyPrefix []byte) []types.VestingReceiverRotation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	rotations := []types.VestingReceiverRotation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.VestingReceiverRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// GetVestingReceiverRotationSequence returns the id the next rotation will get.
+func (k Keeper) GetVestingReceiverRotationSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VestingReceiverRotationSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetVestingReceiverRotationSequence sets the id the next rotation will get.
+func (k Keeper) SetVestingReceiverRotationSequence(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VestingReceiverRotationSequenceKey, sdk.Uint64ToBigEndian(seq))
+}
+
+func (k Keeper) nextVestingReceiverRotationID(ctx sdk.Context) uint64 {
+	id := k.GetVestingReceiverRotationSequence(ctx)
+	k.SetVestingReceiverRotationSequence(ctx, id+1)
+	return id
+}
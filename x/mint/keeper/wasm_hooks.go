@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HookContractGasLimit is the gas a hook contract can use to handle an
+// emission event.
+const HookContractGasLimit = 1_000_000
+
+var _ types.MintHooks = WasmHooks{}
+
+// WasmHooks notifies the hook_contracts of the emission events through a sudo
+// call. A contract failing to handle an event, or running out of gas, does not
+// affect the chain nor the other contracts: its changes are discarded and the
+// failure is logged.
+type WasmHooks struct {
+	k              Keeper
+	contractKeeper types.ContractKeeper
+}
+
+// NewWasmHooks returns the hooks notifying the hook contracts.
+func NewWasmHooks(k Keeper, contractKeeper types.ContractKeeper) WasmHooks {
+	return WasmHooks{k: k, contractKeeper: contractKeeper}
+}
+
+// AfterEpochMint notifies the hook contracts of the coins minted during a
+// distribution epoch.
+func (h WasmHooks) AfterEpochMint(ctx sdk.Context, minted sdk.Coin) {
+	h.notify(ctx, types.MintHookMsg{
+		AfterEpochMint: &types.AfterEpochMintMsg{Minted: minted},
+	})
+}
+
+// BeforeReduction does not notify the hook contracts, which are notified after
+// the reduction.
+func (h WasmHooks) BeforeReduction(_ sdk.Context, _, _ sdk.Dec) {}
+
+// AfterReduction notifies the hook contracts of a block provisions reduction.
+func (h WasmHooks) AfterReduction(ctx sdk.Context, oldProvisions, newProvisions sdk.Dec) {
+	h.notify(ctx, types.MintHookMsg{
+		AfterReduction: &types.AfterReductionMsg{OldProvisions: oldProvisions, NewProvisions: newProvisions},
+	})
+}
+
+// AfterVestingMonthAdvanced notifies the hook contracts of the start of a team
+// vesting month.
+func (h WasmHooks) AfterVestingMonthAdvanced(ctx sdk.Context, month int64) {
+	h.notify(ctx, types.MintHookMsg{
+		AfterVestingMonthAdvanced: &types.AfterVestingMonthAdvancedMsg{Month: month},
+	})
+}
+
+// AfterBurn notifies the hook contracts of a burn.
+func (h WasmHooks) AfterBurn(ctx sdk.Context, burner sdk.AccAddress, coins sdk.Coins) {
+	h.notify(ctx, types.MintHookMsg{
+		AfterBurn: &types.AfterBurnMsg{Burner: burner.String(), Coins: coins},
+	})
+}
+
+func (h WasmHooks) notify(ctx sdk.Context, msg types.MintHookMsg) {
+	contracts := h.k.GetParams(ctx).HookContracts
+	if len(contracts) == 0 {
+		return
+	}
+
+	bz, err := json.Marshal(types.HookSudoMsg{MintHook: msg})
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to marshal hook sudo message", "error", err)
+		return
+	}
+	for _, contract := range contracts {
+		if err := h.sudo(ctx, sdk.MustAccAddressFromBech32(contract), bz); err != nil {
+			h.k.Logger(ctx).Error("hook contract failed", "contract", contract, "error", err)
+		}
+	}
+}
+
+// sudo calls a hook contract with a limited gas meter, keeping its changes only
+// if it succeeds. The gas used by the contract is charged to the caller, so that
+// the burns notified within a transaction pay for the contracts they trigger.
+// The limit is capped by the gas left to the caller, so that the events notified
+// by a contract itself, such as its burns, share the gas of the first call.
+func (h WasmHooks) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasLimit := uint64(HookContractGasLimit)
+	if remaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumedToLimit(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "mint hook contract")
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas in %s", outOfGas.Descriptor)
+		}
+	}()
+
+	if _, err := h.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
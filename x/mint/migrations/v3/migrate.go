@@ -0,0 +1,76 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it moves the cumulative burnt amount out of the
+// module parameters into the burn ledger, and grants the burner permission to
+// the mint module account which now executes the burns instead of the x/gov
+// module account.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("x/%s params not found", types.ModuleName)
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	for _, coin := range params.TotalBurntAmount {
+		total := sdk.NewInt64Coin(coin.Denom, 0)
+		key := types.GetTotalBurntKey(coin.Denom)
+		if bz := store.Get(key); bz != nil {
+			if err := cdc.Unmarshal(bz, &total); err != nil {
+				return err
+			}
+		}
+		total = total.Add(coin)
+
+		bz, err := cdc.Marshal(&total)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+
+	params.TotalBurntAmount = nil
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return addBurnerPermission(ctx, ak)
+}
+
+func addBurnerPermission(ctx sdk.Context, ak types.AccountKeeper) error {
+	acc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	macc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected x/%s module account type %T", types.ModuleName, acc)
+	}
+	macc.Permissions = append(macc.Permissions, authtypes.Burner)
+	ak.SetModuleAccount(ctx, macc)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package v4
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it sets the reduction mode parameters, keeping the
+// block reduction mode, and records the start time of the current reduction
+// period as if it had been measured in time, so the schedule stays continuous
+// when the time mode is enabled.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("x/%s params not found", types.ModuleName)
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.ReductionMode = types.ReductionModeBlocks
+	if params.ReductionPeriodDuration == 0 {
+		params.ReductionPeriodDuration = types.DefaultReductionPeriodDuration
+	}
+	if err := params.ValidateLegacy(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	var lastReductionBlock int64
+	if bz := store.Get(types.LastReductionBlockKey); bz != nil {
+		lastReductionBlock = int64(sdk.BigEndianToUint64(bz))
+	}
+	elapsed := time.Duration(ctx.BlockHeight()-lastReductionBlock) * params.NominalBlockTime()
+	store.Set(types.LastReductionTimeKey, sdk.FormatTimeBytes(ctx.BlockTime().Add(-elapsed)))
+	store.Set(types.LastBlockTimeKey, sdk.FormatTimeBytes(ctx.BlockTime()))
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package v5
+
+import (
+	"fmt"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 4 to
+// version 5. Specifically, it replaces the distribution proportions and the
+// grants program and usage incentive addresses with the equivalent weighted
+// distribution recipients, in the order the buckets used to be paid, so the
+// payouts do not change.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	feeCollectorName string,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("x/%s params not found", types.ModuleName)
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	proportions := params.DistributionProportions
+	params.DistributionRecipients = []types.DistributionRecipient{
+		{
+			Name:       types.RecipientGrantsProgram,
+			TargetType: types.DistributionTargetAccount,
+			Target:     params.GrantsProgramAddress,
+			Weight:     proportions.GrantsProgram,
+		},
+		{
+			Name:       types.RecipientUsageIncentive,
+			TargetType: types.DistributionTargetAccount,
+			Target:     params.UsageIncentiveAddress,
+			Weight:     proportions.UsageIncentive,
+		},
+		{
+			Name:       types.RecipientStaking,
+			TargetType: types.DistributionTargetModule,
+			Target:     feeCollectorName,
+			Weight:     proportions.Staking,
+		},
+		{
+			Name:       types.RecipientDeveloperRewards,
+			TargetType: types.DistributionTargetDeveloperVesting,
+			Weight:     proportions.DeveloperRewards,
+		},
+		{
+			Name:       types.RecipientCommunityPool,
+			TargetType: types.DistributionTargetCommunityPool,
+			Weight:     proportions.CommunityPool,
+		},
+	}
+	params.DistributionProportions = types.DistributionProportions{
+		GrantsProgram:    sdk.ZeroDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		UsageIncentive:   sdk.ZeroDec(),
+		Staking:          sdk.ZeroDec(),
+		DeveloperRewards: sdk.ZeroDec(),
+	}
+	params.GrantsProgramAddress = ""
+	params.UsageIncentiveAddress = ""
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
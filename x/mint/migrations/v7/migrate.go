@@ -0,0 +1,53 @@
+package v7
+
+import (
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 6 to
+// version 7. Specifically, it sets the emission checkpoint to the current
+// state, the distribution totals only covering the minting since they were
+// introduced.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var minter types.Minter
+	if err := cdc.Unmarshal(store.Get(types.MinterKey), &minter); err != nil {
+		return err
+	}
+
+	totals := types.NewDistributionTotals()
+	if bz := store.Get(types.DistributionTotalsKey); bz != nil {
+		var stored types.DistributionTotals
+		if err := cdc.Unmarshal(bz, &stored); err != nil {
+			return err
+		}
+		totals.Add(stored)
+	}
+
+	var lastReductionBlock int64
+	if bz := store.Get(types.LastReductionBlockKey); bz != nil {
+		lastReductionBlock = int64(sdk.BigEndianToUint64(bz))
+	}
+
+	checkpoint := types.EmissionCheckpoint{
+		Height:             ctx.BlockHeight(),
+		Minted:             totals.Minted,
+		BlockProvisions:    minter.BlockProvisions,
+		LastReductionBlock: lastReductionBlock,
+	}
+	bz, err := cdc.Marshal(&checkpoint)
+	if err != nil {
+		return err
+	}
+	store.Set(types.EmissionCheckpointKey, bz)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package v8
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 7 to
+// version 8. Specifically, it sets the max supply to 0, so the supply is not
+// capped until governance sets it, and starts counting the provisions clipped
+// to the max supply in the emission checkpoint.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("x/%s params not found", types.ModuleName)
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.MaxSupply = math.ZeroInt()
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	bz = store.Get(types.EmissionCheckpointKey)
+	if bz == nil {
+		return nil
+	}
+	var checkpoint types.EmissionCheckpoint
+	if err := cdc.Unmarshal(bz, &checkpoint); err != nil {
+		return err
+	}
+	checkpoint.Clipped = math.ZeroInt()
+	bz, err = cdc.Marshal(&checkpoint)
+	if err != nil {
+		return err
+	}
+	store.Set(types.EmissionCheckpointKey, bz)
+
+	return nil
+}
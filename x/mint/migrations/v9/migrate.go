@@ -0,0 +1,41 @@
+package v9
+
+import (
+	"fmt"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 8 to
+// version 9. Specifically, it sets the fee burn ratio to 0, so no transaction
+// fees are burnt until governance sets it. The fee burn statistics stay
+// disabled, their epoch length being 0.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("x/%s params not found", types.ModuleName)
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.FeeBurnRatio = sdk.ZeroDec()
+	params.FeeBurnEpochBlocks = 0
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/TERITORI/teritori-chain/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -20,6 +22,62 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.LastReductionBlockKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key, types.TeamVestingMonthInfoKey):
+			var monthInfoA, monthInfoB types.TeamVestingMonthInfo
+			cdc.MustUnmarshal(kvA.Value, &monthInfoA)
+			cdc.MustUnmarshal(kvB.Value, &monthInfoB)
+			return fmt.Sprintf("%v\n%v", monthInfoA, monthInfoB)
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key, types.DistributionTotalsKey), bytes.Equal(kvA.Key, types.PendingDistributionKey):
+			var totalsA, totalsB types.DistributionTotals
+			cdc.MustUnmarshal(kvA.Value, &totalsA)
+			cdc.MustUnmarshal(kvB.Value, &totalsB)
+			return fmt.Sprintf("%v\n%v", totalsA, totalsB)
+		case bytes.Equal(kvA.Key, types.EmissionCheckpointKey):
+			var checkpointA, checkpointB types.EmissionCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &checkpointA)
+			cdc.MustUnmarshal(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+		case bytes.Equal(kvA.Key, types.FeeInflowWindowKey):
+			var windowA, windowB types.FeeInflowWindow
+			cdc.MustUnmarshal(kvA.Value, &windowA)
+			cdc.MustUnmarshal(kvB.Value, &windowB)
+			return fmt.Sprintf("%v\n%v", windowA, windowB)
+		case bytes.Equal(kvA.Key, types.LastMintFaultKey):
+			var faultA, faultB types.MintFault
+			cdc.MustUnmarshal(kvA.Value, &faultA)
+			cdc.MustUnmarshal(kvB.Value, &faultB)
+			return fmt.Sprintf("%v\n%v", faultA, faultB)
+		case bytes.Equal(kvA.Key, types.MintingPauseKey):
+			var pauseA, pauseB types.MintingPause
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+		case bytes.HasPrefix(kvA.Key, types.FeeBurnEpochKeyPrefix):
+			var epochA, epochB types.FeeBurnEpoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+		case bytes.HasPrefix(kvA.Key, types.InflationSnapshotKeyPrefix):
+			var snapshotA, snapshotB types.InflationSnapshot
+			cdc.MustUnmarshal(kvA.Value, &snapshotA)
+			cdc.MustUnmarshal(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+		case bytes.HasPrefix(kvA.Key, types.FeeInflowKeyPrefix):
+			var amountA, amountB math.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
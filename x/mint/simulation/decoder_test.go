@@ -0,0 +1,98 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/TERITORI/teritori-chain/x/mint"
+	"github.com/TERITORI/teritori-chain/x/mint/simulation"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+
+	dec := simulation.NewDecodeStore(encCfg.Codec)
+
+	minter := types.NewMinter(sdk.NewDec(47000000))
+	monthInfo := types.TeamVestingMonthInfo{
+		MonthsSinceGenesis:     2,
+		MonthStartedBlock:      100,
+		OneMonthPeriodInBlocks: 50,
+	}
+	params := types.DefaultParams()
+	feeInflowWindow := types.FeeInflowWindow{Total: sdk.NewInt(1500), StartHeight: 10}
+	snapshot := types.InflationSnapshot{
+		Height:          100,
+		BlockProvisions: sdk.NewDec(47000000),
+		TotalSupply:     sdk.NewInt(1_000_000_000),
+		BondedTokens:    sdk.NewInt(500_000_000),
+		Inflation:       sdk.NewDecWithPrec(27, 2),
+		StakingApr:      sdk.NewDecWithPrec(21, 2),
+	}
+	fault := types.MintFault{
+		Height:     100,
+		Bucket:     types.BucketTeamReserve,
+		Error:      "failed to pay team_reserve",
+		Redirected: sdk.NewInt(47000000),
+	}
+	feeBurnEpoch := types.FeeBurnEpoch{
+		StartHeight: 100,
+		EndHeight:   109,
+		Collected:   sdk.NewInt(1000),
+		Burnt:       sdk.NewInt(250),
+	}
+	pause := types.MintingPause{Paused: true, PausedHeight: 100, TotalPausedBlocks: 20}
+	feeInflow, err := sdk.NewInt(300).Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MinterKey, Value: encCfg.Codec.MustMarshal(&minter)},
+			{Key: types.LastReductionBlockKey, Value: sdk.Uint64ToBigEndian(42)},
+			{Key: types.TeamVestingMonthInfoKey, Value: encCfg.Codec.MustMarshal(&monthInfo)},
+			{Key: types.ParamsKey, Value: encCfg.Codec.MustMarshal(&params)},
+			{Key: types.FeeInflowWindowKey, Value: encCfg.Codec.MustMarshal(&feeInflowWindow)},
+			{Key: types.GetFeeInflowKey(12), Value: feeInflow},
+			{Key: types.GetInflationSnapshotKey(100), Value: encCfg.Codec.MustMarshal(&snapshot)},
+			{Key: types.LastMintFaultKey, Value: encCfg.Codec.MustMarshal(&fault)},
+			{Key: types.GetFeeBurnEpochKey(100), Value: encCfg.Codec.MustMarshal(&feeBurnEpoch)},
+			{Key: types.MintingPauseKey, Value: encCfg.Codec.MustMarshal(&pause)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"LastReductionBlock", "42\n42"},
+		{"TeamVestingMonthInfo", fmt.Sprintf("%v\n%v", monthInfo, monthInfo)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"FeeInflowWindow", fmt.Sprintf("%v\n%v", feeInflowWindow, feeInflowWindow)},
+		{"FeeInflow", "300\n300"},
+		{"InflationSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
+		{"LastMintFault", fmt.Sprintf("%v\n%v", fault, fault)},
+		{"FeeBurnEpoch", fmt.Sprintf("%v\n%v", feeBurnEpoch, feeBurnEpoch)},
+		{"MintingPause", fmt.Sprintf("%v\n%v", pause, pause)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
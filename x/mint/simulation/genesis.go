@@ -3,6 +3,7 @@ package simulation
 // DONTCOVER
 
 import (
+	"fmt"
 	"math/rand"
 
 	"cosmossdk.io/math"
@@ -10,6 +11,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // Simulation parameter constants.
@@ -17,34 +20,16 @@ const (
 	blockProvisionsKey         = "genesis_block_provisions"
 	reductionFactorKey         = "reduction_factor"
 	reductionPeriodInBlocksKey = "reduction_period_in_blocks"
+	distributionEpochBlocksKey = "distribution_epoch_blocks"
+	oneMonthPeriodInBlocksKey  = "one_month_period_in_blocks"
 
 	MintingRewardsDistributionStartBlockKey = "minting_rewards_distribution_start_block"
 
-	maxInt64 = int(^uint(0) >> 1)
-)
-
-var (
-	distributionProportions = types.DistributionProportions{
-		GrantsProgram:    sdk.NewDecWithPrec(10, 2), // 10%
-		CommunityPool:    sdk.NewDecWithPrec(10, 2), // 10%
-		UsageIncentive:   sdk.NewDecWithPrec(25, 2), // 25%
-		Staking:          sdk.NewDecWithPrec(40, 2), // 40%
-		DeveloperRewards: sdk.NewDecWithPrec(15, 2), // 15%
-	}
-	weightedDevRewardReceivers = []types.MonthlyVestingAddress{
-		{
-			Address:        "tori1g2escsu26508tgrpv865d80d62pvmw69je2ztn",
-			MonthlyAmounts: []math.Int{sdk.NewInt(7000), sdk.NewInt(7000), sdk.NewInt(7000)},
-		},
-		{
-			Address:        "tori1g2escsu26508tgrpv865d80d62pvmw69je2ztn",
-			MonthlyAmounts: []math.Int{sdk.NewInt(2000), sdk.NewInt(2000), sdk.NewInt(2000)},
-		},
-		{
-			Address:        "tori1g2escsu26508tgrpv865d80d62pvmw69je2ztn",
-			MonthlyAmounts: []math.Int{sdk.NewInt(1000), sdk.NewInt(1000), sdk.NewInt(1000)},
-		},
-	}
+	// vestingMonths is the number of months of the simulated vesting schedule.
+	vestingMonths = 3
+	// maxDeveloperRewardsReceivers is the maximum number of simulated developer
+	// rewards receivers.
+	maxDeveloperRewardsReceivers = 3
 )
 
 // RandomizedGenState generates a random GenesisState for mint.
@@ -73,44 +58,158 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { mintingRewardsDistributionStartBlock = genMintingRewardsDistributionStartBlock(r) },
 	)
 
-	reductionStartedBlock := genReductionStartedBlock(simState.Rand)
-
-	mintDenom := sdk.DefaultBondDenom
-	params := types.NewParams(
-		mintDenom,
-		blockProvisions,
-		reductionFactor,
-		reductionPeriodInBlocks,
-		distributionProportions,
-		weightedDevRewardReceivers,
-		mintingRewardsDistributionStartBlock,
-		5733818,
-		[]sdk.Coin{},
+	var distributionEpochBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, distributionEpochBlocksKey, &distributionEpochBlocks, simState.Rand,
+		func(r *rand.Rand) { distributionEpochBlocks = genDistributionEpochBlocks(r) },
 	)
 
+	var oneMonthPeriodInBlocks int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, oneMonthPeriodInBlocksKey, &oneMonthPeriodInBlocks, simState.Rand,
+		func(r *rand.Rand) { oneMonthPeriodInBlocks = genOneMonthPeriodInBlocks(r) },
+	)
+
+	params := types.DefaultParams()
+	params.MintDenom = sdk.DefaultBondDenom
+	params.GenesisBlockProvisions = blockProvisions
+	params.ReductionFactor = reductionFactor
+	params.ReductionPeriodInBlocks = reductionPeriodInBlocks
+	params.MintingRewardsDistributionStartBlock = mintingRewardsDistributionStartBlock
+	params.DistributionEpochBlocks = distributionEpochBlocks
+	params.DistributionRecipients = genDistributionRecipients(simState.Rand, simState.Accounts)
+	params.TeamReserveAddress = simState.Accounts[simState.Rand.Intn(len(simState.Accounts))].Address.String()
+
 	minter := types.NewMinter(blockProvisions)
+	// the vesting months start with minting
+	monthInfo := types.TeamVestingMonthInfo{
+		MonthsSinceGenesis:     0,
+		MonthStartedBlock:      mintingRewardsDistributionStartBlock,
+		OneMonthPeriodInBlocks: oneMonthPeriodInBlocks,
+	}
+	params.WeightedDeveloperRewardsReceivers = genWeightedDeveloperRewardsReceivers(simState.Rand, simState.Accounts, params, monthInfo)
+	if err := types.ValidateDeveloperRewardsBudget(params, minter, 0, monthInfo); err != nil {
+		panic(fmt.Sprintf("invalid simulated vesting schedule: %s", err))
+	}
 
-	mintGenesis := types.NewGenesisState(minter, params, reductionStartedBlock, types.TeamVestingMonthInfo{})
+	// the reduction periods start with minting, at the distribution start block
+	mintGenesis := types.NewGenesisState(minter, params, 0, monthInfo)
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(mintGenesis)
 }
 
 func genBlockProvisions(r *rand.Rand) sdk.Dec {
-	return sdk.NewDec(int64(r.Intn(maxInt64)))
+	return sdk.NewDec(int64(simtypes.RandIntBetween(r, 1_000, 100_000_000)))
 }
 
 func genReductionFactor(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(int64(r.Intn(10)), 1)
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 5, 11)), 1)
 }
 
 func genReductionPeriodInBlocks(r *rand.Rand) int64 {
-	return int64(r.Intn(maxInt64))
+	return int64(simtypes.RandIntBetween(r, 10, 1_000))
 }
 
 func genMintingRewardsDistributionStartBlock(r *rand.Rand) int64 {
-	return int64(r.Intn(maxInt64))
+	return int64(r.Intn(100))
+}
+
+func genDistributionEpochBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10))
+}
+
+func genOneMonthPeriodInBlocks(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 10, 100))
 }
 
-func genReductionStartedBlock(r *rand.Rand) int64 {
-	return int64(r.Intn(maxInt64))
+// genDistributionRecipients returns the default distribution recipients with
+// random weights, the accounts being paid to random simulation accounts.
+func genDistributionRecipients(r *rand.Rand, accs []simtypes.Account) []types.DistributionRecipient {
+	weights := genWeights(r, 5)
+	return []types.DistributionRecipient{
+		{
+			Name:       types.RecipientGrantsProgram,
+			TargetType: types.DistributionTargetAccount,
+			Target:     accs[r.Intn(len(accs))].Address.String(),
+			Weight:     weights[0],
+		},
+		{
+			Name:       types.RecipientUsageIncentive,
+			TargetType: types.DistributionTargetAccount,
+			Target:     accs[r.Intn(len(accs))].Address.String(),
+			Weight:     weights[1],
+		},
+		{
+			Name:       types.RecipientStaking,
+			TargetType: types.DistributionTargetModule,
+			Target:     authtypes.FeeCollectorName,
+			Weight:     weights[2],
+		},
+		{
+			Name:       types.RecipientDeveloperRewards,
+			TargetType: types.DistributionTargetDeveloperVesting,
+			Weight:     weights[3],
+		},
+		{
+			Name:       types.RecipientCommunityPool,
+			TargetType: types.DistributionTargetCommunityPool,
+			Weight:     weights[4],
+		},
+	}
+}
+
+// genWeights returns n random weights of at least 5% summing to one.
+func genWeights(r *rand.Rand, n int) []sdk.Dec {
+	weights := make([]sdk.Dec, n)
+	remaining := int64(100 - 5*n)
+	for i := 0; i < n-1; i++ {
+		share := r.Int63n(remaining + 1)
+		weights[i] = sdk.NewDecWithPrec(5+share, 2)
+		remaining -= share
+	}
+	weights[n-1] = sdk.NewDecWithPrec(5+remaining, 2)
+	return weights
+}
+
+// genWeightedDeveloperRewardsReceivers returns developer rewards receivers
+// picked among the simulation accounts, with monthly amounts the developer
+// rewards cover until the end of the vesting schedule.
+func genWeightedDeveloperRewardsReceivers(
+	r *rand.Rand, accs []simtypes.Account, params types.Params, monthInfo types.TeamVestingMonthInfo,
+) []types.MonthlyVestingAddress {
+	var developerRewardsWeight sdk.Dec
+	for _, recipient := range params.DistributionRecipients {
+		if recipient.TargetType == types.DistributionTargetDeveloperVesting {
+			developerRewardsWeight = recipient.Weight
+		}
+	}
+
+	// lowest block provisions of the vesting schedule
+	blockProvisions := params.GenesisBlockProvisions
+	vestingBlocks := vestingMonths * monthInfo.OneMonthPeriodInBlocks
+	for height := params.ReductionPeriodInBlocks; height < vestingBlocks; height += params.ReductionPeriodInBlocks {
+		blockProvisions = blockProvisions.Mul(params.ReductionFactor)
+	}
+	budget := sdk.NewDecFromInt(blockProvisions.TruncateInt()).Mul(developerRewardsWeight).TruncateInt()
+
+	count := simtypes.RandIntBetween(r, 1, maxDeveloperRewardsReceivers+1)
+	// the amounts vested in a block are rounded up
+	maxMonthlyAmount := budget.QuoRaw(int64(count)).SubRaw(1).MulRaw(monthInfo.OneMonthPeriodInBlocks)
+
+	receivers := make([]types.MonthlyVestingAddress, 0, count)
+	for i := 0; i < count; i++ {
+		amounts := make([]math.Int, 0, vestingMonths)
+		for month := 0; month < vestingMonths; month++ {
+			amount := math.ZeroInt()
+			if maxMonthlyAmount.IsPositive() {
+				amount, _ = simtypes.RandPositiveInt(r, maxMonthlyAmount)
+			}
+			amounts = append(amounts, amount)
+		}
+		receivers = append(receivers, types.MonthlyVestingAddress{
+			Address:        accs[r.Intn(len(accs))].Address.String(),
+			MonthlyAmounts: amounts,
+		})
+	}
+	return receivers
 }
@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgBurnTokens = "op_weight_msg_burn_tokens" //nolint:gosec
+
+	DefaultWeightMsgBurnTokens = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgBurnTokens int
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnTokens, &weightMsgBurnTokens, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnTokens = DefaultWeightMsgBurnTokens
+		},
+	)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), tx.DefaultSignModes)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgBurnTokens,
+			SimulateMsgBurnTokens(txConfig, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgBurnTokens generates a MsgBurnTokens burning a random part of the
+// spendable coins of a random account.
+func SimulateMsgBurnTokens(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		amount := simtypes.RandSubsetCoins(r, spendable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeBurnTokens, "no coins to burn"), nil, nil
+		}
+
+		msg := types.NewMsgBurnTokens(simAccount.Address.String(), amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: amount,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/TERITORI/teritori-chain/x/mint/keeper"
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //nolint:gosec
+
+	DefaultWeightMsgUpdateParams = 100
+)
+
+// ProposalMsgs defines the module weighted proposals' contents.
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams changing the
+// emission and the distribution of the current parameters. The developer
+// rewards weight is kept, and so is the emission if the new one does not cover
+// the vesting schedule.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		current := k.GetParams(ctx)
+
+		params := current
+		params.ReductionFactor = genReductionFactor(r)
+		params.ReductionPeriodInBlocks = genReductionPeriodInBlocks(r)
+		params.DistributionEpochBlocks = genDistributionEpochBlocks(r)
+		params.BlocksPerYear = uint64(simtypes.RandIntBetween(r, 1, 10_000_000))
+
+		// the other recipients share what the developer rewards leave
+		recipients := make([]types.DistributionRecipient, len(current.DistributionRecipients))
+		copy(recipients, current.DistributionRecipients)
+		remaining := sdk.OneDec()
+		others := []int{}
+		for i, recipient := range recipients {
+			if recipient.TargetType == types.DistributionTargetDeveloperVesting {
+				remaining = remaining.Sub(recipient.Weight)
+			} else {
+				others = append(others, i)
+			}
+		}
+		for n, i := range others {
+			if n == len(others)-1 {
+				recipients[i].Weight = remaining
+				break
+			}
+			recipients[i].Weight = remaining.Mul(sdk.NewDecWithPrec(r.Int63n(101), 2))
+			remaining = remaining.Sub(recipients[i].Weight)
+		}
+		params.DistributionRecipients = recipients
+
+		if err := types.ValidateDeveloperRewardsBudget(
+			params, k.GetMinter(ctx), k.GetLastReductionBlockNum(ctx), k.GetTeamVestingMonthInfo(ctx),
+		); err != nil {
+			params.ReductionFactor = current.ReductionFactor
+			params.ReductionPeriodInBlocks = current.ReductionPeriodInBlocks
+		}
+
+		return types.NewMsgUpdateParams(k.GetAuthority(), params)
+	}
+}
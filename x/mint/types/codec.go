@@ -10,11 +10,19 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurnTokens{}, "teritori/mint/MsgBurnTokens", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "teritori/mint/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgClaimVestedRewards{}, "teritori/mint/MsgClaimVestedRewards", nil)
+	cdc.RegisterConcrete(&MsgRotateVestingReceiver{}, "teritori/mint/MsgRotateVestingReceiver", nil)
+	cdc.RegisterConcrete(&MsgSetMintingPaused{}, "teritori/mint/MsgSetMintingPaused", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBurnTokens{},
+		&MsgUpdateParams{},
+		&MsgClaimVestedRewards{},
+		&MsgRotateVestingReceiver{},
+		&MsgSetMintingPaused{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the buckets reported by the mint faults, besides the distribution
+// recipient names.
+const (
+	BucketMint             = "mint"
+	BucketDeveloperVesting = "developer_vesting"
+	BucketTeamReserve      = "team_reserve"
+	BucketCommunityPool    = "community_pool"
+)
+
+// NewDistributionTotals returns distribution totals with all amounts set to
+// zero.
+func NewDistributionTotals() DistributionTotals {
+	return DistributionTotals{
+		Minted:           math.ZeroInt(),
+		Recipients:       []DistributionRecipientTotal{},
+		DeveloperVesting: []DeveloperVestingTotal{},
+		TeamReserve:      math.ZeroInt(),
+		CommunityPool:    math.ZeroInt(),
+	}
+}
+
+// Add adds the amounts of other to the totals. Unset amounts count as zero.
+func (t *DistributionTotals) Add(other DistributionTotals) {
+	t.Minted = addInt(t.Minted, other.Minted)
+	t.TeamReserve = addInt(t.TeamReserve, other.TeamReserve)
+	t.CommunityPool = addInt(t.CommunityPool, other.CommunityPool)
+	for _, v := range other.Recipients {
+		t.AddRecipient(v.Name, v.Amount)
+	}
+	for _, v := range other.DeveloperVesting {
+		t.AddDeveloperVesting(v.Address, v.Amount)
+	}
+}
+
+// AddRecipient adds to the total allocated to a distribution recipient.
+func (t *DistributionTotals) AddRecipient(name string, amount math.Int) {
+	for i, v := range t.Recipients {
+		if v.Name == name {
+			t.Recipients[i].Amount = addInt(v.Amount, amount)
+			return
+		}
+	}
+	t.Recipients = append(t.Recipients, DistributionRecipientTotal{
+		Name:   name,
+		Amount: addInt(math.ZeroInt(), amount),
+	})
+}
+
+// RecipientTotal returns the total allocated to a distribution recipient.
+func (t DistributionTotals) RecipientTotal(name string) math.Int {
+	for _, v := range t.Recipients {
+		if v.Name == name {
+			return v.Amount
+		}
+	}
+	return math.ZeroInt()
+}
+
+// AddDeveloperVesting adds to the total vested to a developer rewards
+// receiver.
+func (t *DistributionTotals) AddDeveloperVesting(address string, amount math.Int) {
+	for i, v := range t.DeveloperVesting {
+		if v.Address == address {
+			t.DeveloperVesting[i].Amount = addInt(v.Amount, amount)
+			return
+		}
+	}
+	t.DeveloperVesting = append(t.DeveloperVesting, DeveloperVestingTotal{
+		Address: address,
+		Amount:  addInt(math.ZeroInt(), amount),
+	})
+}
+
+// Validate validates the distribution totals.
+func (t DistributionTotals) Validate() error {
+	buckets := []struct {
+		name   string
+		amount math.Int
+	}{
+		{"minted", t.Minted},
+		{"team reserve", t.TeamReserve},
+		{"community pool", t.CommunityPool},
+	}
+	for _, b := range buckets {
+		if !b.amount.IsNil() && b.amount.IsNegative() {
+			return fmt.Errorf("%s distribution total cannot be negative: %s", b.name, b.amount)
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, v := range t.Recipients {
+		if names[v.Name] {
+			return fmt.Errorf("duplicate distribution recipient %s", v.Name)
+		}
+		names[v.Name] = true
+		if v.Amount.IsNil() || v.Amount.IsNegative() {
+			return fmt.Errorf("invalid distribution total of recipient %s: %s", v.Name, v.Amount)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range t.DeveloperVesting {
+		if _, err := sdk.AccAddressFromBech32(v.Address); err != nil {
+			return fmt.Errorf("invalid developer vesting address %s: %w", v.Address, err)
+		}
+		if seen[v.Address] {
+			return fmt.Errorf("duplicate developer vesting address %s", v.Address)
+		}
+		seen[v.Address] = true
+		if v.Amount.IsNil() || v.Amount.IsNegative() {
+			return fmt.Errorf("invalid developer vesting total of %s: %s", v.Address, v.Amount)
+		}
+	}
+
+	return nil
+}
+
+func addInt(a, b math.Int) math.Int {
+	if a.IsNil() {
+		a = math.ZeroInt()
+	}
+	if b.IsNil() {
+		return a
+	}
+	return a.Add(b)
+}
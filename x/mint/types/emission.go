@@ -0,0 +1,225 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEmissionSchedulePeriods is the furthest reduction period the emission
+// schedule can be projected to.
+const MaxEmissionSchedulePeriods = 200
+
+// EmissionSchedule projects the emission of count reduction periods starting
+// from fromPeriod, the current reduction period being 0. The projection replays
+// the per-block allocation of the minted provisions, assuming the parameters do
+// not change. In time mode, periods are assumed to last
+// reduction_period_in_blocks blocks of the nominal block time.
+func EmissionSchedule(
+	params Params, minter Minter, lastReductionBlock int64, monthInfo TeamVestingMonthInfo,
+	fromPeriod, count uint64,
+) []EmissionPeriod {
+	// minting and the vesting months start at the distribution start block
+	periodStart := lastReductionBlock
+	if periodStart < params.MintingRewardsDistributionStartBlock {
+		periodStart = params.MintingRewardsDistributionStartBlock
+	}
+	if monthInfo.MonthStartedBlock < params.MintingRewardsDistributionStartBlock {
+		monthInfo.MonthStartedBlock = params.MintingRewardsDistributionStartBlock
+	}
+
+	blockProvisions := minter.BlockProvisions
+	for i := uint64(0); i < fromPeriod; i++ {
+		blockProvisions = blockProvisions.Mul(params.ReductionFactor)
+	}
+
+	periods := make([]EmissionPeriod, 0, count)
+	for i := fromPeriod; i < fromPeriod+count; i++ {
+		start := periodStart + int64(i)*params.ReductionPeriodInBlocks
+		end := start + params.ReductionPeriodInBlocks
+		periods = append(periods, projectEmissionPeriod(params, monthInfo, i, start, end, blockProvisions))
+		blockProvisions = blockProvisions.Mul(params.ReductionFactor)
+	}
+	return periods
+}
+
+func projectEmissionPeriod(
+	params Params, monthInfo TeamVestingMonthInfo, period uint64, start, end int64, blockProvisions sdk.Dec,
+) EmissionPeriod {
+	blocks := math.NewInt(end - start)
+	minted := blockProvisions.TruncateInt()
+	total := minted.Mul(blocks)
+
+	// allocations are truncated per block, as in DistributeMintedCoin, and the
+	// community pool receives whatever is not sent to the other recipients
+	recipients := make([]DistributionRecipientTotal, 0, len(params.DistributionRecipients))
+	developerRewards := math.ZeroInt()
+	hasDeveloperVesting := false
+	communityPool := total
+	for _, r := range params.DistributionRecipients {
+		amount := sdk.NewDecFromInt(minted).Mul(r.Weight).TruncateInt().Mul(blocks)
+		recipients = append(recipients, DistributionRecipientTotal{Name: r.Name, Amount: amount})
+		switch r.TargetType {
+		case DistributionTargetCommunityPool:
+			continue
+		case DistributionTargetDeveloperVesting:
+			developerRewards = developerRewards.Add(amount)
+			hasDeveloperVesting = true
+		}
+		communityPool = communityPool.Sub(amount)
+	}
+
+	developerVesting := math.ZeroInt()
+	if hasDeveloperVesting {
+		developerVesting = projectDeveloperVesting(params.WeightedDeveloperRewardsReceivers, monthInfo, start, end)
+	}
+	teamReserve := math.ZeroInt()
+	if developerRewards.GT(developerVesting) {
+		teamReserve = developerRewards.Sub(developerVesting)
+	}
+
+	return EmissionPeriod{
+		Period:           period,
+		StartHeight:      start,
+		BlockProvisions:  blockProvisions,
+		TotalMinted:      total,
+		Recipients:       recipients,
+		DeveloperVesting: developerVesting,
+		TeamReserve:      teamReserve,
+		CommunityPool:    communityPool,
+	}
+}
+
+// projectDeveloperVesting returns the amount vested to the developer rewards
+// receivers between the start and end heights.
+func projectDeveloperVesting(receivers []MonthlyVestingAddress, monthInfo TeamVestingMonthInfo, start, end int64) math.Int {
+	vested := math.ZeroInt()
+	if monthInfo.OneMonthPeriodInBlocks <= 0 {
+		return vested
+	}
+
+	lastMonth := int64(0)
+	for _, w := range receivers {
+		if int64(len(w.MonthlyAmounts)) > lastMonth {
+			lastMonth = int64(len(w.MonthlyAmounts))
+		}
+	}
+
+	for height := start; height < end; {
+		// month of the block at height, and the height at which it ends
+		month := monthInfo.MonthsSinceGenesis
+		monthEnd := monthInfo.MonthStartedBlock + monthInfo.OneMonthPeriodInBlocks
+		if height >= monthInfo.MonthStartedBlock {
+			elapsedMonths := (height - monthInfo.MonthStartedBlock) / monthInfo.OneMonthPeriodInBlocks
+			month += elapsedMonths
+			monthEnd += elapsedMonths * monthInfo.OneMonthPeriodInBlocks
+		}
+		if month >= lastMonth {
+			break
+		}
+		monthStart := monthEnd - monthInfo.OneMonthPeriodInBlocks
+		if monthEnd > end {
+			monthEnd = end
+		}
+
+		for _, w := range receivers {
+			if w.Address == "" || int64(len(w.MonthlyAmounts)) <= month {
+				continue
+			}
+			vested = vested.Add(VestedInBlocks(w.MonthlyAmounts[month], monthInfo.OneMonthPeriodInBlocks, height-monthStart, monthEnd-monthStart))
+		}
+		height = monthEnd
+	}
+	return vested
+}
+
+// VestedInBlock returns the amount of a monthly amount vested in the block of
+// the given index in a month of blocksInMonth blocks. The amounts vested in the
+// blocks of a month sum up to the monthly amount exactly. Blocks before the
+// month start vest as its first block.
+func VestedInBlock(monthlyAmount math.Int, blocksInMonth, blockInMonth int64) math.Int {
+	if blockInMonth < 0 {
+		blockInMonth = 0
+	}
+	return VestedInBlocks(monthlyAmount, blocksInMonth, blockInMonth, blockInMonth+1)
+}
+
+// VestedInBlocks returns the amount of a monthly amount vested in the blocks of
+// index from to to, excluded, in a month of blocksInMonth blocks.
+func VestedInBlocks(monthlyAmount math.Int, blocksInMonth, from, to int64) math.Int {
+	vested := math.ZeroInt()
+	if from < 0 {
+		// blocks before the month start vest as its first block
+		before := to
+		if before > 0 {
+			before = 0
+		}
+		vested = vested.Add(VestedInBlock(monthlyAmount, blocksInMonth, 0).MulRaw(before - from))
+		from = 0
+	}
+	if to > blocksInMonth {
+		to = blocksInMonth
+	}
+	if to <= from {
+		return vested
+	}
+
+	vestedBefore := func(blocks int64) math.Int {
+		return monthlyAmount.MulRaw(blocks).QuoRaw(blocksInMonth)
+	}
+	return vested.Add(vestedBefore(to).Sub(vestedBefore(from)))
+}
+
+// ProjectedMinted returns the most the distribution totals can hold after the
+// block at height, projecting the block mode emission from the checkpoint:
+// every block from the distribution start block mints the truncated block
+// provisions, which are reduced every reduction_period_in_blocks blocks. Pauses,
+// mint failures and the max supply only lower the minted amount below it.
+func (c EmissionCheckpoint) ProjectedMinted(params Params, height int64) math.Int {
+	minted := c.Minted
+	provisions := c.BlockProvisions
+
+	// the first block minting is the distribution start block, which is also
+	// the first reduction period start, and there is no block 0
+	start := c.Height
+	if start < params.MintingRewardsDistributionStartBlock {
+		start = params.MintingRewardsDistributionStartBlock
+	}
+	if start < 1 {
+		start = 1
+	}
+	lastReduction := c.LastReductionBlock
+	if lastReduction < params.MintingRewardsDistributionStartBlock {
+		lastReduction = params.MintingRewardsDistributionStartBlock
+	}
+
+	for start <= height {
+		next := lastReduction + params.ReductionPeriodInBlocks
+		if start >= next {
+			provisions = provisions.Mul(params.ReductionFactor)
+			lastReduction = start
+			continue
+		}
+		end := next - 1
+		if end > height {
+			end = height
+		}
+		minted = minted.Add(provisions.TruncateInt().MulRaw(end - start + 1))
+		start = end + 1
+	}
+	return minted
+}
+
+// MaxMinted returns the most the distribution totals can hold in time mode,
+// from the checkpoint: the closed-form emission of the whole schedule, every
+// reduction period minting the block provisions of reduction_period_in_blocks
+// nominal blocks, plus one more period for the blocks that are not scaled by
+// the block time, the first after the start and after a pause. It returns
+// false if the block provisions are not reduced.
+func (c EmissionCheckpoint) MaxMinted(params Params) (math.Int, bool) {
+	if !params.ReductionFactor.LT(sdk.OneDec()) {
+		return math.Int{}, false
+	}
+	periodEmission := c.BlockProvisions.MulInt64(params.ReductionPeriodInBlocks)
+	emission := periodEmission.Quo(sdk.OneDec().Sub(params.ReductionFactor)).Add(periodEmission)
+	return c.Minted.Add(emission.Ceil().TruncateInt()), true
+}
@@ -6,5 +6,13 @@ import (
 
 // errors
 var (
-	ErrEmptyAddress = errors.Register(ModuleName, 1, "empty address")
+	ErrEmptyAddress     = errors.Register(ModuleName, 1, "empty address")
+	ErrInvalidAuthority = errors.Register(ModuleName, 2, "invalid authority")
+
+	ErrInvalidDistributionRecipient = errors.Register(ModuleName, 3, "invalid distribution recipient")
+	ErrNoVestedRewards              = errors.Register(ModuleName, 4, "no vested rewards to claim")
+	ErrNotVestingReceiver           = errors.Register(ModuleName, 5, "not a developer rewards receiver")
+	ErrInvalidVestingReceiver       = errors.Register(ModuleName, 6, "invalid developer rewards receiver")
+	ErrMintingPaused                = errors.Register(ModuleName, 7, "minting is already paused")
+	ErrMintingNotPaused             = errors.Register(ModuleName, 8, "minting is not paused")
 )
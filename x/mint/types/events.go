@@ -4,4 +4,5 @@ package types
 const (
 	AttributeKeyBlockProvisions = "block_provisions"
 	AttributeBlockNumber        = "block_number"
+	AttributeKeyClipped         = "clipped"
 )
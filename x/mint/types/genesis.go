@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object.
 func NewGenesisState(minter Minter, params Params, reductionStartedBlock int64, monthInfo TeamVestingMonthInfo) *GenesisState {
 	return &GenesisState{
@@ -19,6 +25,8 @@ func DefaultGenesisState() *GenesisState {
 		MonthInfo: TeamVestingMonthInfo{
 			OneMonthPeriodInBlocks: 525600, // 1 month - 86400 x 365 / 12 / 5			,
 		},
+		DistributionTotals:  NewDistributionTotals(),
+		PendingDistribution: NewDistributionTotals(),
 	}
 }
 
@@ -29,5 +37,168 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return data.Minter.Validate()
+	if err := data.Minter.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBurnLedger(data); err != nil {
+		return err
+	}
+
+	if err := data.DistributionTotals.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.PendingDistribution.Validate(); err != nil {
+		return fmt.Errorf("invalid pending distribution: %w", err)
+	}
+	for _, v := range data.PendingDistribution.Recipients {
+		if _, found := data.Params.DistributionRecipient(v.Name); !found {
+			return fmt.Errorf("pending distribution to unknown recipient %s", v.Name)
+		}
+	}
+
+	if err := validateVestingRewards(data.VestingRewards); err != nil {
+		return err
+	}
+
+	if err := validateVestingReceiverRotations(data.VestingReceiverRotations); err != nil {
+		return err
+	}
+
+	if err := validateInflationSnapshots(data.InflationSnapshots); err != nil {
+		return err
+	}
+
+	if err := validateFeeBurnEpochs(data.FeeBurnEpochs); err != nil {
+		return err
+	}
+
+	return data.MintingPause.Validate()
+}
+
+func validateInflationSnapshots(snapshots []InflationSnapshot) error {
+	seenHeights := make(map[int64]bool)
+	for _, snapshot := range snapshots {
+		if snapshot.Height <= 0 {
+			return fmt.Errorf("invalid inflation snapshot height %d", snapshot.Height)
+		}
+		if seenHeights[snapshot.Height] {
+			return fmt.Errorf("duplicate inflation snapshot height %d", snapshot.Height)
+		}
+		seenHeights[snapshot.Height] = true
+		if snapshot.BlockProvisions.IsNil() || snapshot.BlockProvisions.IsNegative() {
+			return fmt.Errorf("invalid block provisions of inflation snapshot %d: %s", snapshot.Height, snapshot.BlockProvisions)
+		}
+		if snapshot.TotalSupply.IsNil() || snapshot.TotalSupply.IsNegative() {
+			return fmt.Errorf("invalid total supply of inflation snapshot %d: %s", snapshot.Height, snapshot.TotalSupply)
+		}
+		if snapshot.BondedTokens.IsNil() || snapshot.BondedTokens.IsNegative() {
+			return fmt.Errorf("invalid bonded tokens of inflation snapshot %d: %s", snapshot.Height, snapshot.BondedTokens)
+		}
+		if snapshot.Inflation.IsNil() || snapshot.Inflation.IsNegative() {
+			return fmt.Errorf("invalid inflation of inflation snapshot %d: %s", snapshot.Height, snapshot.Inflation)
+		}
+		if snapshot.StakingApr.IsNil() || snapshot.StakingApr.IsNegative() {
+			return fmt.Errorf("invalid staking APR of inflation snapshot %d: %s", snapshot.Height, snapshot.StakingApr)
+		}
+	}
+
+	return nil
+}
+
+func validateFeeBurnEpochs(epochs []FeeBurnEpoch) error {
+	var lastEnd int64
+	for _, epoch := range epochs {
+		if epoch.StartHeight <= lastEnd {
+			return fmt.Errorf("fee burn epoch %d overlaps the previous epoch or is not ordered by height", epoch.StartHeight)
+		}
+		if epoch.EndHeight < epoch.StartHeight {
+			return fmt.Errorf("fee burn epoch %d ends at %d, before it starts", epoch.StartHeight, epoch.EndHeight)
+		}
+		lastEnd = epoch.EndHeight
+		if epoch.Collected.IsNil() || epoch.Collected.IsNegative() {
+			return fmt.Errorf("invalid collected fees of fee burn epoch %d: %s", epoch.StartHeight, epoch.Collected)
+		}
+		if epoch.Burnt.IsNil() || epoch.Burnt.IsNegative() || epoch.Burnt.GT(epoch.Collected) {
+			return fmt.Errorf("invalid burnt fees of fee burn epoch %d: %s", epoch.StartHeight, epoch.Burnt)
+		}
+	}
+
+	return nil
+}
+
+func validateVestingReceiverRotations(rotations []VestingReceiverRotation) error {
+	seenIDs := make(map[uint64]bool)
+	for _, rotation := range rotations {
+		if seenIDs[rotation.Id] {
+			return fmt.Errorf("duplicate vesting receiver rotation id %d", rotation.Id)
+		}
+		seenIDs[rotation.Id] = true
+		if _, err := sdk.AccAddressFromBech32(rotation.PreviousAddress); err != nil {
+			return fmt.Errorf("invalid previous address of vesting receiver rotation %d: %w", rotation.Id, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(rotation.NewAddress); err != nil {
+			return fmt.Errorf("invalid new address of vesting receiver rotation %d: %w", rotation.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func validateVestingRewards(vestingRewards []VestingRewards) error {
+	seen := make(map[string]bool)
+	for _, rewards := range vestingRewards {
+		if _, err := sdk.AccAddressFromBech32(rewards.Address); err != nil {
+			return fmt.Errorf("invalid vesting rewards address %s: %w", rewards.Address, err)
+		}
+		if seen[rewards.Address] {
+			return fmt.Errorf("duplicate vesting rewards address %s", rewards.Address)
+		}
+		seen[rewards.Address] = true
+		if rewards.Claimable.IsNil() || rewards.Claimable.IsNegative() {
+			return fmt.Errorf("invalid claimable vesting rewards of %s: %s", rewards.Address, rewards.Claimable)
+		}
+		if rewards.Claimed.IsNil() || rewards.Claimed.IsNegative() {
+			return fmt.Errorf("invalid claimed vesting rewards of %s: %s", rewards.Address, rewards.Claimed)
+		}
+	}
+
+	return nil
+}
+
+func validateBurnLedger(data GenesisState) error {
+	if err := sdk.Coins(data.TotalBurnt).Validate(); err != nil {
+		return fmt.Errorf("invalid total burnt amount: %w", err)
+	}
+
+	seenBurners := make(map[string]bool)
+	for _, total := range data.BurntByAddress {
+		if _, err := sdk.AccAddressFromBech32(total.Address); err != nil {
+			return fmt.Errorf("invalid burner address %s: %w", total.Address, err)
+		}
+		if seenBurners[total.Address] {
+			return fmt.Errorf("duplicate burner address %s", total.Address)
+		}
+		seenBurners[total.Address] = true
+		if err := sdk.Coins(total.Amount).Validate(); err != nil {
+			return fmt.Errorf("invalid burnt amount of %s: %w", total.Address, err)
+		}
+	}
+
+	seenIDs := make(map[uint64]bool)
+	for _, record := range data.BurnHistory {
+		if seenIDs[record.Id] {
+			return fmt.Errorf("duplicate burn record id %d", record.Id)
+		}
+		seenIDs[record.Id] = true
+		if _, err := sdk.AccAddressFromBech32(record.Burner); err != nil {
+			return fmt.Errorf("invalid burner address %s: %w", record.Burner, err)
+		}
+		if err := sdk.Coins(record.Amount).Validate(); err != nil {
+			return fmt.Errorf("invalid amount of burn record %d: %w", record.Id, err)
+		}
+	}
+
+	return nil
 }
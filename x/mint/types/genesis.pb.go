@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +38,28 @@ type GenesisState struct {
 	MonthInfo TeamVestingMonthInfo `protobuf:"bytes,3,opt,name=month_info,json=monthInfo,proto3" json:"month_info"`
 	// current reduction period start block
 	ReductionStartedBlock int64 `protobuf:"varint,4,opt,name=reduction_started_block,json=reductionStartedBlock,proto3" json:"reduction_started_block,omitempty"`
+	// cumulative burnt amount per denom
+	TotalBurnt []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,rep,name=total_burnt,json=totalBurnt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_burnt"`
+	// cumulative burnt amount per burner
+	BurntByAddress []BurnerTotal `protobuf:"bytes,6,rep,name=burnt_by_address,json=burntByAddress,proto3" json:"burnt_by_address"`
+	// history of all burns
+	BurnHistory []BurnRecord `protobuf:"bytes,7,rep,name=burn_history,json=burnHistory,proto3" json:"burn_history"`
+	// current reduction period start time, used in time mode
+	ReductionStartedTime time.Time `protobuf:"bytes,8,opt,name=reduction_started_time,json=reductionStartedTime,proto3,stdtime" json:"reduction_started_time"`
+	// cumulative amounts minted and sent to each distribution bucket
+	DistributionTotals DistributionTotals `protobuf:"bytes,9,opt,name=distribution_totals,json=distributionTotals,proto3" json:"distribution_totals"`
+	// amounts allocated in the current distribution epoch and not distributed yet
+	PendingDistribution DistributionTotals `protobuf:"bytes,10,opt,name=pending_distribution,json=pendingDistribution,proto3" json:"pending_distribution"`
+	// vested and claimed developer rewards of each receiver
+	VestingRewards []VestingRewards `protobuf:"bytes,11,rep,name=vesting_rewards,json=vestingRewards,proto3" json:"vesting_rewards"`
+	// history of the developer rewards receiver rotations
+	VestingReceiverRotations []VestingReceiverRotation `protobuf:"bytes,12,rep,name=vesting_receiver_rotations,json=vestingReceiverRotations,proto3" json:"vesting_receiver_rotations"`
+	// inflation snapshots not pruned yet
+	InflationSnapshots []InflationSnapshot `protobuf:"bytes,13,rep,name=inflation_snapshots,json=inflationSnapshots,proto3" json:"inflation_snapshots"`
+	// fee burn statistics of every epoch
+	FeeBurnEpochs []FeeBurnEpoch `protobuf:"bytes,14,rep,name=fee_burn_epochs,json=feeBurnEpochs,proto3" json:"fee_burn_epochs"`
+	// state of the minting circuit breaker
+	MintingPause MintingPause `protobuf:"bytes,15,opt,name=minting_pause,json=mintingPause,proto3" json:"minting_pause"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +123,76 @@ func (m *GenesisState) GetReductionStartedBlock() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetBurntByAddress() []BurnerTotal {
+	if m != nil {
+		return m.BurntByAddress
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnHistory() []BurnRecord {
+	if m != nil {
+		return m.BurnHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReductionStartedTime() time.Time {
+	if m != nil {
+		return m.ReductionStartedTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetDistributionTotals() DistributionTotals {
+	if m != nil {
+		return m.DistributionTotals
+	}
+	return DistributionTotals{}
+}
+
+func (m *GenesisState) GetPendingDistribution() DistributionTotals {
+	if m != nil {
+		return m.PendingDistribution
+	}
+	return DistributionTotals{}
+}
+
+func (m *GenesisState) GetVestingRewards() []VestingRewards {
+	if m != nil {
+		return m.VestingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingReceiverRotations() []VestingReceiverRotation {
+	if m != nil {
+		return m.VestingReceiverRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInflationSnapshots() []InflationSnapshot {
+	if m != nil {
+		return m.InflationSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeBurnEpochs() []FeeBurnEpoch {
+	if m != nil {
+		return m.FeeBurnEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintingPause() MintingPause {
+	if m != nil {
+		return m.MintingPause
+	}
+	return MintingPause{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "teritori.mint.v1beta1.GenesisState")
 }
@@ -105,27 +202,51 @@ func init() {
 }
 
 var fileDescriptor_5048229303dbfc79 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0xc7, 0xdb, 0x6d, 0x0c, 0xac, 0x9e, 0x8a, 0xc3, 0x32, 0x30, 0x0e, 0xbd, 0x0c, 0xc4, 0x84,
-	0x29, 0x78, 0xf1, 0x36, 0x10, 0xe9, 0x61, 0x38, 0xba, 0xe1, 0xc1, 0xcb, 0x48, 0xbb, 0xac, 0x0b,
-	0x9a, 0x64, 0x24, 0x6f, 0xa2, 0xdf, 0x42, 0xfc, 0x54, 0x3b, 0xee, 0xe8, 0x49, 0x64, 0xfb, 0x22,
-	0x92, 0xb4, 0xf5, 0x34, 0xbd, 0x85, 0xfc, 0x7f, 0xbf, 0xff, 0x7b, 0xbc, 0xe0, 0x0c, 0x98, 0xe6,
-	0xa0, 0x34, 0x27, 0x82, 0x4b, 0x20, 0x2f, 0xbd, 0x94, 0x01, 0xed, 0x91, 0x9c, 0x49, 0x66, 0xb8,
-	0xc1, 0x0b, 0xad, 0x40, 0x85, 0xad, 0x0a, 0xc2, 0x16, 0xc2, 0x25, 0xd4, 0x3e, 0xcc, 0x55, 0xae,
-	0x1c, 0x41, 0xec, 0xab, 0x80, 0xdb, 0x9d, 0xdd, 0x8d, 0xce, 0x74, 0xc4, 0xe9, 0x47, 0x2d, 0x38,
-	0xb8, 0x2b, 0x06, 0x8c, 0x80, 0x02, 0x0b, 0x6f, 0x82, 0xa6, 0x8d, 0x99, 0x8e, 0xfc, 0x8e, 0xdf,
-	0xdd, 0xbf, 0x3c, 0xc6, 0x3b, 0x07, 0xe2, 0x81, 0x83, 0xfa, 0x8d, 0xd5, 0xd7, 0x89, 0x97, 0x94,
-	0x8a, 0x95, 0x17, 0x54, 0x53, 0x61, 0xa2, 0xda, 0xbf, 0xf2, 0xd0, 0x41, 0x95, 0x5c, 0x28, 0xe1,
-	0x30, 0x08, 0x84, 0x92, 0x30, 0x9f, 0x70, 0x39, 0x53, 0x51, 0xdd, 0x15, 0x9c, 0xff, 0x51, 0x30,
-	0x66, 0x54, 0x3c, 0x30, 0x03, 0x5c, 0xe6, 0x03, 0xeb, 0xc4, 0x72, 0xa6, 0xca, 0xba, 0x3d, 0x51,
-	0x7d, 0x84, 0xd7, 0xc1, 0x91, 0x66, 0xd3, 0x65, 0x06, 0x5c, 0xc9, 0x89, 0x01, 0xaa, 0x81, 0x4d,
-	0x27, 0xe9, 0xb3, 0xca, 0x9e, 0xa2, 0x46, 0xc7, 0xef, 0xd6, 0x93, 0xd6, 0x6f, 0x3c, 0x2a, 0xd2,
-	0xbe, 0x0d, 0xfb, 0xf1, 0x6a, 0x83, 0xfc, 0xf5, 0x06, 0xf9, 0xdf, 0x1b, 0xe4, 0xbf, 0x6f, 0x91,
-	0xb7, 0xde, 0x22, 0xef, 0x73, 0x8b, 0xbc, 0x47, 0x92, 0x73, 0x98, 0x2f, 0x53, 0x9c, 0x29, 0x41,
-	0xc6, 0xb7, 0x49, 0x3c, 0xbe, 0x4f, 0x62, 0x52, 0xad, 0x78, 0x91, 0xcd, 0x29, 0x97, 0xe4, 0xb5,
-	0x38, 0x36, 0xbc, 0x2d, 0x98, 0x49, 0x9b, 0xee, 0xcc, 0x57, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x0a, 0x8f, 0x30, 0x61, 0xdc, 0x01, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0x13, 0x3b,
+	0x14, 0x86, 0x33, 0x37, 0xbd, 0xbd, 0x8d, 0x93, 0xb6, 0x57, 0x6e, 0x0b, 0x56, 0x24, 0x92, 0xd0,
+	0x0a, 0x11, 0x84, 0x3a, 0xa3, 0x16, 0x89, 0x0d, 0x2b, 0x02, 0x05, 0x82, 0x54, 0x08, 0xd3, 0x88,
+	0x45, 0x37, 0x83, 0x67, 0xc6, 0x99, 0x58, 0xcd, 0xd8, 0x23, 0xdb, 0x09, 0xe4, 0x2d, 0xfa, 0x58,
+	0x5d, 0x76, 0x89, 0x58, 0x14, 0xd4, 0xbe, 0x02, 0x0f, 0x80, 0x6c, 0xcf, 0x40, 0x68, 0x9b, 0x22,
+	0xb1, 0x4a, 0x72, 0xce, 0xff, 0x7f, 0xff, 0xcc, 0xf1, 0x71, 0xc0, 0x96, 0x22, 0x82, 0x2a, 0x2e,
+	0xa8, 0x97, 0x52, 0xa6, 0xbc, 0xc9, 0x4e, 0x48, 0x14, 0xde, 0xf1, 0x12, 0xc2, 0x88, 0xa4, 0xd2,
+	0xcd, 0x04, 0x57, 0x1c, 0x6e, 0x14, 0x22, 0x57, 0x8b, 0xdc, 0x5c, 0x54, 0x5f, 0x4f, 0x78, 0xc2,
+	0x8d, 0xc2, 0xd3, 0xdf, 0xac, 0xb8, 0xde, 0x4c, 0x38, 0x4f, 0x46, 0xc4, 0x33, 0xbf, 0xc2, 0xf1,
+	0xc0, 0x53, 0x34, 0x25, 0x52, 0xe1, 0x34, 0xcb, 0x05, 0xad, 0xeb, 0x23, 0x0d, 0xda, 0x28, 0x36,
+	0xbf, 0x57, 0x40, 0xed, 0xa5, 0x7d, 0x82, 0x03, 0x85, 0x15, 0x81, 0x4f, 0xc0, 0xa2, 0x6e, 0x13,
+	0x81, 0x9c, 0x96, 0xd3, 0xae, 0xee, 0xde, 0x71, 0xaf, 0x7d, 0x22, 0x77, 0xdf, 0x88, 0x3a, 0x0b,
+	0x27, 0x67, 0xcd, 0x92, 0x9f, 0x5b, 0xb4, 0x39, 0xc3, 0x02, 0xa7, 0x12, 0xfd, 0x73, 0xa3, 0xb9,
+	0x67, 0x44, 0x85, 0xd9, 0x5a, 0x60, 0x0f, 0x80, 0x94, 0x33, 0x35, 0x0c, 0x28, 0x1b, 0x70, 0x54,
+	0x36, 0x80, 0x87, 0x73, 0x00, 0x7d, 0x82, 0xd3, 0xf7, 0x44, 0x2a, 0xca, 0x92, 0x7d, 0xed, 0xe9,
+	0xb2, 0x01, 0xcf, 0x71, 0x95, 0xb4, 0x28, 0xc0, 0xc7, 0xe0, 0xb6, 0x20, 0xf1, 0x38, 0x52, 0x94,
+	0xb3, 0x40, 0x2a, 0x2c, 0x14, 0x89, 0x83, 0x70, 0xc4, 0xa3, 0x23, 0xb4, 0xd0, 0x72, 0xda, 0x65,
+	0x7f, 0xe3, 0x67, 0xfb, 0xc0, 0x76, 0x3b, 0xba, 0x09, 0x7b, 0xa0, 0xaa, 0xb8, 0xc2, 0xa3, 0x20,
+	0x1c, 0x0b, 0xa6, 0xd0, 0xbf, 0xad, 0x72, 0xbb, 0xd2, 0xf1, 0x34, 0xfd, 0xcb, 0x59, 0xf3, 0x7e,
+	0x42, 0xd5, 0x70, 0x1c, 0xba, 0x11, 0x4f, 0xbd, 0x88, 0xcb, 0x94, 0xcb, 0xfc, 0x63, 0x5b, 0xc6,
+	0x47, 0x9e, 0x9a, 0x66, 0x44, 0xba, 0xcf, 0x38, 0x65, 0x3e, 0x30, 0x8c, 0x8e, 0x46, 0x40, 0x1f,
+	0xfc, 0x6f, 0x58, 0x41, 0x38, 0x0d, 0x70, 0x1c, 0x0b, 0x22, 0x25, 0x5a, 0x6c, 0x95, 0xdb, 0xd5,
+	0xdd, 0xcd, 0x39, 0x6f, 0xa8, 0x7d, 0x44, 0xf4, 0x0d, 0xc2, 0xbe, 0xd8, 0x8a, 0x21, 0x74, 0xa6,
+	0x4f, 0xad, 0x1f, 0xbe, 0x06, 0x35, 0x5d, 0x09, 0x86, 0x54, 0x2a, 0x2e, 0xa6, 0xe8, 0x3f, 0xc3,
+	0xbb, 0x7b, 0x03, 0xcf, 0x27, 0x11, 0x17, 0x71, 0x8e, 0xab, 0x6a, 0xf3, 0x2b, 0xeb, 0x85, 0x87,
+	0xe0, 0xd6, 0xd5, 0x49, 0xe9, 0x6d, 0x42, 0x4b, 0xe6, 0x1c, 0xea, 0xae, 0x5d, 0x35, 0xb7, 0x58,
+	0x35, 0xb7, 0x5f, 0xac, 0x5a, 0x67, 0x49, 0xe3, 0x8e, 0xbf, 0x36, 0x1d, 0x7f, 0xfd, 0xf2, 0x38,
+	0xb5, 0x08, 0x7e, 0x00, 0x6b, 0x31, 0x95, 0x4a, 0xd0, 0x70, 0x6c, 0xf0, 0x66, 0x2c, 0x12, 0x55,
+	0x0c, 0xf8, 0xc1, 0x9c, 0xc7, 0x7d, 0x3e, 0xe3, 0x30, 0x43, 0x28, 0xb6, 0x05, 0xc6, 0x57, 0x3a,
+	0x30, 0x04, 0xeb, 0x19, 0x61, 0x31, 0x65, 0x49, 0x30, 0xdb, 0x45, 0xe0, 0xef, 0x22, 0xd6, 0x72,
+	0xd8, 0xac, 0x00, 0xf6, 0xc1, 0xea, 0xc4, 0x2e, 0x5c, 0x20, 0xc8, 0x47, 0x2c, 0x62, 0x89, 0xaa,
+	0x66, 0xe0, 0xf7, 0xe6, 0xe0, 0xf3, 0xf5, 0xf4, 0xad, 0xb8, 0x38, 0xc3, 0xc9, 0x6f, 0x55, 0x28,
+	0x40, 0xfd, 0x17, 0x35, 0x22, 0x74, 0x42, 0x44, 0x20, 0xb8, 0xc2, 0x3a, 0x52, 0xa2, 0x9a, 0x09,
+	0x70, 0xff, 0x14, 0x60, 0x7d, 0x7e, 0x6e, 0xcb, 0x93, 0xd0, 0xe4, 0xfa, 0xb6, 0x84, 0x01, 0x58,
+	0xa3, 0x6c, 0x30, 0xc2, 0xf6, 0xac, 0x19, 0xce, 0xe4, 0x90, 0x2b, 0x89, 0x96, 0x4d, 0x58, 0x7b,
+	0x4e, 0x58, 0xb7, 0x70, 0x1c, 0xe4, 0x86, 0xe2, 0x38, 0xe8, 0xe5, 0x86, 0x84, 0xef, 0xc0, 0xea,
+	0x80, 0x10, 0x73, 0x79, 0x02, 0x92, 0xf1, 0x68, 0x28, 0xd1, 0x8a, 0x81, 0x6f, 0xcd, 0x81, 0xbf,
+	0x20, 0x44, 0xaf, 0xe7, 0x9e, 0xd6, 0xe6, 0xdc, 0xe5, 0xc1, 0x4c, 0x4d, 0xc2, 0x37, 0x60, 0x59,
+	0x3b, 0xf4, 0x9c, 0x32, 0x3c, 0x96, 0x04, 0xad, 0xb6, 0x9c, 0x1b, 0x80, 0xfb, 0x56, 0xdb, 0xd3,
+	0xd2, 0x1c, 0x58, 0x4b, 0x67, 0x6b, 0xdd, 0x93, 0xf3, 0x86, 0x73, 0x7a, 0xde, 0x70, 0xbe, 0x9d,
+	0x37, 0x9c, 0xe3, 0x8b, 0x46, 0xe9, 0xf4, 0xa2, 0x51, 0xfa, 0x7c, 0xd1, 0x28, 0x1d, 0x7a, 0x33,
+	0xd7, 0xbb, 0xbf, 0xe7, 0x77, 0xfb, 0x6f, 0xfd, 0xae, 0x57, 0xa4, 0x6c, 0x47, 0x43, 0x4c, 0x99,
+	0xf7, 0xc9, 0xfe, 0x9d, 0x9a, 0xbb, 0x1e, 0x2e, 0x9a, 0x2b, 0xf1, 0xe8, 0xc7, 0x00, 0x02, 0x0d,
+	0x16, 0xb6, 0xdf, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -148,6 +269,142 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MintingPause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.FeeBurnEpochs) > 0 {
+		for iNdEx := len(m.FeeBurnEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeBurnEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.InflationSnapshots) > 0 {
+		for iNdEx := len(m.InflationSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VestingReceiverRotations) > 0 {
+		for iNdEx := len(m.VestingReceiverRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingReceiverRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VestingRewards) > 0 {
+		for iNdEx := len(m.VestingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.PendingDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.DistributionTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReductionStartedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReductionStartedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if len(m.BurnHistory) > 0 {
+		for iNdEx := len(m.BurnHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BurntByAddress) > 0 {
+		for iNdEx := len(m.BurntByAddress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurntByAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalBurnt) > 0 {
+		for iNdEx := len(m.TotalBurnt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TotalBurnt[iNdEx].Size()
+				i -= size
+				if _, err := m.TotalBurnt[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.ReductionStartedBlock != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ReductionStartedBlock))
 		i--
@@ -212,6 +469,56 @@ func (m *GenesisState) Size() (n int) {
 	if m.ReductionStartedBlock != 0 {
 		n += 1 + sovGenesis(uint64(m.ReductionStartedBlock))
 	}
+	if len(m.TotalBurnt) > 0 {
+		for _, e := range m.TotalBurnt {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurntByAddress) > 0 {
+		for _, e := range m.BurntByAddress {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnHistory) > 0 {
+		for _, e := range m.BurnHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReductionStartedTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DistributionTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PendingDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VestingRewards) > 0 {
+		for _, e := range m.VestingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingReceiverRotations) > 0 {
+		for _, e := range m.VestingReceiverRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InflationSnapshots) > 0 {
+		for _, e := range m.InflationSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeBurnEpochs) > 0 {
+		for _, e := range m.FeeBurnEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MintingPause.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -368,6 +675,378 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.TotalBurnt = append(m.TotalBurnt, v)
+			if err := m.TotalBurnt[len(m.TotalBurnt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntByAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurntByAddress = append(m.BurntByAddress, BurnerTotal{})
+			if err := m.BurntByAddress[len(m.BurntByAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnHistory = append(m.BurnHistory, BurnRecord{})
+			if err := m.BurnHistory[len(m.BurnHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionStartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReductionStartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingRewards = append(m.VestingRewards, VestingRewards{})
+			if err := m.VestingRewards[len(m.VestingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingReceiverRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingReceiverRotations = append(m.VestingReceiverRotations, VestingReceiverRotation{})
+			if err := m.VestingReceiverRotations[len(m.VestingReceiverRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSnapshots = append(m.InflationSnapshots, InflationSnapshot{})
+			if err := m.InflationSnapshots[len(m.InflationSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeBurnEpochs = append(m.FeeBurnEpochs, FeeBurnEpoch{})
+			if err := m.FeeBurnEpochs[len(m.FeeBurnEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingPause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,7 +6,17 @@ import (
 
 // MintHooks defines an interface for mint module's hooks.
 type MintHooks interface {
-	AfterDistributeMintedCoin(ctx sdk.Context)
+	// AfterEpochMint is called once the coins minted during a distribution
+	// epoch are distributed, every block when the distribution is not batched.
+	AfterEpochMint(ctx sdk.Context, minted sdk.Coin)
+	// BeforeReduction is called before the block provisions are reduced.
+	BeforeReduction(ctx sdk.Context, oldProvisions, newProvisions sdk.Dec)
+	// AfterReduction is called after the block provisions are reduced.
+	AfterReduction(ctx sdk.Context, oldProvisions, newProvisions sdk.Dec)
+	// AfterVestingMonthAdvanced is called when a new team vesting month starts.
+	AfterVestingMonthAdvanced(ctx sdk.Context, month int64)
+	// AfterBurn is called after coins are burnt and recorded in the burn ledger.
+	AfterBurn(ctx sdk.Context, burner sdk.AccAddress, coins sdk.Coins)
 }
 
 var _ MintHooks = MultiMintHooks{}
@@ -20,10 +30,75 @@ func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
 	return hooks
 }
 
-// AfterDistributeMintedCoin is a hook that runs after minter mints and distributes coins
-// at the beginning of each block.
-func (h MultiMintHooks) AfterDistributeMintedCoin(ctx sdk.Context) {
+// AfterEpochMint is a hook that runs after the coins minted during a
+// distribution epoch are distributed.
+func (h MultiMintHooks) AfterEpochMint(ctx sdk.Context, minted sdk.Coin) {
 	for i := range h {
-		h[i].AfterDistributeMintedCoin(ctx)
+		h[i].AfterEpochMint(ctx, minted)
 	}
 }
+
+// BeforeReduction is a hook that runs before the block provisions are reduced.
+func (h MultiMintHooks) BeforeReduction(ctx sdk.Context, oldProvisions, newProvisions sdk.Dec) {
+	for i := range h {
+		h[i].BeforeReduction(ctx, oldProvisions, newProvisions)
+	}
+}
+
+// AfterReduction is a hook that runs after the block provisions are reduced.
+func (h MultiMintHooks) AfterReduction(ctx sdk.Context, oldProvisions, newProvisions sdk.Dec) {
+	for i := range h {
+		h[i].AfterReduction(ctx, oldProvisions, newProvisions)
+	}
+}
+
+// AfterVestingMonthAdvanced is a hook that runs when a new team vesting month
+// starts.
+func (h MultiMintHooks) AfterVestingMonthAdvanced(ctx sdk.Context, month int64) {
+	for i := range h {
+		h[i].AfterVestingMonthAdvanced(ctx, month)
+	}
+}
+
+// AfterBurn is a hook that runs after coins are burnt.
+func (h MultiMintHooks) AfterBurn(ctx sdk.Context, burner sdk.AccAddress, coins sdk.Coins) {
+	for i := range h {
+		h[i].AfterBurn(ctx, burner, coins)
+	}
+}
+
+// HookSudoMsg is the sudo message sent to the hook contracts. Exactly one of
+// its fields is set.
+type HookSudoMsg struct {
+	MintHook MintHookMsg `json:"mint_hook"`
+}
+
+// MintHookMsg holds the emission event a hook contract is notified of.
+type MintHookMsg struct {
+	AfterEpochMint            *AfterEpochMintMsg            `json:"after_epoch_mint,omitempty"`
+	AfterReduction            *AfterReductionMsg            `json:"after_reduction,omitempty"`
+	AfterVestingMonthAdvanced *AfterVestingMonthAdvancedMsg `json:"after_vesting_month_advanced,omitempty"`
+	AfterBurn                 *AfterBurnMsg                 `json:"after_burn,omitempty"`
+}
+
+// AfterEpochMintMsg notifies the coins minted during a distribution epoch.
+type AfterEpochMintMsg struct {
+	Minted sdk.Coin `json:"minted"`
+}
+
+// AfterReductionMsg notifies a reduction of the block provisions.
+type AfterReductionMsg struct {
+	OldProvisions sdk.Dec `json:"old_provisions"`
+	NewProvisions sdk.Dec `json:"new_provisions"`
+}
+
+// AfterVestingMonthAdvancedMsg notifies the start of a team vesting month.
+type AfterVestingMonthAdvancedMsg struct {
+	Month int64 `json:"month"`
+}
+
+// AfterBurnMsg notifies a burn.
+type AfterBurnMsg struct {
+	Burner string    `json:"burner"`
+	Coins  sdk.Coins `json:"coins"`
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 // MinterKey is the key to use for the keeper store at which
 // the Minter and its BlockProvisions are stored.
 var MinterKey = []byte{0x00}
@@ -12,6 +17,82 @@ var LastReductionBlockKey = []byte{0x03}
 // for non-linear team token vesting
 var TeamVestingMonthInfoKey = []byte{0x04}
 
+// ParamsKey is the key to use for the keeper store at which
+// the module parameters are stored.
+var ParamsKey = []byte{0x05}
+
+// TotalBurntKeyPrefix is the prefix under which the cumulative burnt
+// amount of each denom is stored.
+var TotalBurntKeyPrefix = []byte{0x06}
+
+// BurntByAddressKeyPrefix is the prefix under which the cumulative burnt
+// amount of each burner is stored, keyed by address and denom.
+var BurntByAddressKeyPrefix = []byte{0x07}
+
+// BurnHistoryKeyPrefix is the prefix under which burn records are stored,
+// keyed by height and burn id.
+var BurnHistoryKeyPrefix = []byte{0x08}
+
+// BurnSequenceKey is the key to use for the keeper store at which
+// the next burn id is stored.
+var BurnSequenceKey = []byte{0x09}
+
+// LastReductionTimeKey is the key to use for the keeper store
+// for storing the block time at which the current reduction period started.
+var LastReductionTimeKey = []byte{0x0A}
+
+// LastBlockTimeKey is the key to use for the keeper store
+// for storing the time of the last block provisions were minted for.
+var LastBlockTimeKey = []byte{0x0B}
+
+// DistributionTotalsKey is the key to use for the keeper store at which
+// the cumulative distribution totals are stored.
+var DistributionTotalsKey = []byte{0x0C}
+
+// PendingDistributionKey is the key to use for the keeper store at which
+// the allocations of the current distribution epoch are stored.
+var PendingDistributionKey = []byte{0x0D}
+
+// VestingRewardsKeyPrefix is the prefix under which the vested and claimed
+// developer rewards of each receiver are stored, keyed by address.
+var VestingRewardsKeyPrefix = []byte{0x0E}
+
+// VestingReceiverRotationKeyPrefix is the prefix under which the developer
+// rewards receiver rotations are stored, keyed by slot and rotation id.
+var VestingReceiverRotationKeyPrefix = []byte{0x0F}
+
+// VestingReceiverRotationSequenceKey is the key to use for the keeper store at
+// which the next rotation id is stored.
+var VestingReceiverRotationSequenceKey = []byte{0x10}
+
+// EmissionCheckpointKey is the key to use for the keeper store at which the
+// emission checkpoint is stored.
+var EmissionCheckpointKey = []byte{0x11}
+
+// FeeInflowKeyPrefix is the prefix under which the transaction fees collected
+// in each block of the fee APR window are stored, keyed by height.
+var FeeInflowKeyPrefix = []byte{0x12}
+
+// FeeInflowWindowKey is the key to use for the keeper store at which the sum
+// of the fees of the fee APR window is stored.
+var FeeInflowWindowKey = []byte{0x13}
+
+// InflationSnapshotKeyPrefix is the prefix under which the inflation snapshots
+// are stored, keyed by height.
+var InflationSnapshotKeyPrefix = []byte{0x14}
+
+// LastMintFaultKey is the key to use for the keeper store at which the last
+// failure to mint or distribute the minted coins is stored.
+var LastMintFaultKey = []byte{0x15}
+
+// FeeBurnEpochKeyPrefix is the prefix under which the fee burn statistics
+// epochs are stored, keyed by start height.
+var FeeBurnEpochKeyPrefix = []byte{0x16}
+
+// MintingPauseKey is the key to use for the keeper store at which the state of
+// the minting circuit breaker is stored.
+var MintingPauseKey = []byte{0x17}
+
 const (
 	// ModuleName is the module name.
 	ModuleName = "mint"
@@ -22,3 +103,65 @@ const (
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 )
+
+// GetTotalBurntKey returns the key of the cumulative burnt amount of a denom.
+func GetTotalBurntKey(denom string) []byte {
+	return append(TotalBurntKeyPrefix, []byte(denom)...)
+}
+
+// GetBurntByAddressPrefix returns the prefix of the cumulative burnt amounts of
+// an address.
+func GetBurntByAddressPrefix(addr sdk.AccAddress) []byte {
+	return append(BurntByAddressKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// GetBurntByAddressKey returns the key of the cumulative burnt amount of a
+// denom by an address.
+func GetBurntByAddressKey(addr sdk.AccAddress, denom string) []byte {
+	return append(GetBurntByAddressPrefix(addr), []byte(denom)...)
+}
+
+// GetBurnHistoryKey returns the key of a burn record. Records are ordered by
+// height first so the history can be iterated chronologically.
+func GetBurnHistoryKey(height int64, id uint64) []byte {
+	key := make([]byte, 0, len(BurnHistoryKeyPrefix)+16)
+	key = append(key, BurnHistoryKeyPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingRewardsKey returns the key of the vested and claimed developer
+// rewards of a receiver.
+func GetVestingRewardsKey(addr sdk.AccAddress) []byte {
+	return append(VestingRewardsKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// GetVestingReceiverRotationPrefix returns the prefix of the rotations of a
+// developer rewards receiver slot.
+func GetVestingReceiverRotationPrefix(slot uint64) []byte {
+	key := make([]byte, 0, len(VestingReceiverRotationKeyPrefix)+8)
+	key = append(key, VestingReceiverRotationKeyPrefix...)
+	return append(key, sdk.Uint64ToBigEndian(slot)...)
+}
+
+// GetVestingReceiverRotationKey returns the key of a rotation of a developer
+// rewards receiver slot. Rotations of a slot are ordered by id.
+func GetVestingReceiverRotationKey(slot, id uint64) []byte {
+	return append(GetVestingReceiverRotationPrefix(slot), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFeeInflowKey returns the key of the transaction fees collected in a block.
+func GetFeeInflowKey(height int64) []byte {
+	return append(FeeInflowKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetInflationSnapshotKey returns the key of the inflation snapshot of a block.
+func GetInflationSnapshotKey(height int64) []byte {
+	return append(InflationSnapshotKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetFeeBurnEpochKey returns the key of the fee burn statistics epoch starting
+// at a height.
+func GetFeeBurnEpochKey(startHeight int64) []byte {
+	return append(FeeBurnEpochKeyPrefix, sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
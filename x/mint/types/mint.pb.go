@@ -9,17 +9,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +30,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReductionMode defines how the length of a reduction period is measured.
+type ReductionMode int32
+
+const (
+	// reduction periods last reduction_period_in_blocks blocks
+	ReductionModeBlocks ReductionMode = 0
+	// reduction periods last reduction_period_duration of block time and block
+	// provisions are scaled by the time elapsed since the previous block
+	ReductionModeTime ReductionMode = 1
+)
+
+var ReductionMode_name = map[int32]string{
+	0: "REDUCTION_MODE_BLOCKS",
+	1: "REDUCTION_MODE_TIME",
+}
+
+var ReductionMode_value = map[string]int32{
+	"REDUCTION_MODE_BLOCKS": 0,
+	"REDUCTION_MODE_TIME":   1,
+}
+
+func (x ReductionMode) String() string {
+	return proto.EnumName(ReductionMode_name, int32(x))
+}
+
+func (ReductionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{0}
+}
+
+// DistributionTargetType defines the kind of destination of a distribution
+// recipient.
+type DistributionTargetType int32
+
+const (
+	// target is an account address
+	DistributionTargetAccount DistributionTargetType = 0
+	// target is a module account name
+	DistributionTargetModule DistributionTargetType = 1
+	// the community pool, target is empty
+	DistributionTargetCommunityPool DistributionTargetType = 2
+	// the developer rewards receivers vesting schedule, the remainder of which
+	// goes to the team reserve address, target is empty
+	DistributionTargetDeveloperVesting DistributionTargetType = 3
+)
+
+var DistributionTargetType_name = map[int32]string{
+	0: "DISTRIBUTION_TARGET_TYPE_ACCOUNT",
+	1: "DISTRIBUTION_TARGET_TYPE_MODULE",
+	2: "DISTRIBUTION_TARGET_TYPE_COMMUNITY_POOL",
+	3: "DISTRIBUTION_TARGET_TYPE_DEVELOPER_VESTING",
+}
+
+var DistributionTargetType_value = map[string]int32{
+	"DISTRIBUTION_TARGET_TYPE_ACCOUNT":           0,
+	"DISTRIBUTION_TARGET_TYPE_MODULE":            1,
+	"DISTRIBUTION_TARGET_TYPE_COMMUNITY_POOL":    2,
+	"DISTRIBUTION_TARGET_TYPE_DEVELOPER_VESTING": 3,
+}
+
+func (x DistributionTargetType) String() string {
+	return proto.EnumName(DistributionTargetType_name, int32(x))
+}
+
+func (DistributionTargetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current block provisions
@@ -172,6 +242,9 @@ func (m *MonthlyVestingAddress) GetAddress() string {
 	return ""
 }
 
+// DistributionProportions defines the proportions of the minted denom sent to
+// each of the historical distribution buckets.
+// Deprecated: replaced by Params.distribution_recipients.
 type DistributionProportions struct {
 	// grants_program defines the proportion of the minted minted_denom that is
 	// to be allocated as grants.
@@ -223,6 +296,72 @@ func (m *DistributionProportions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
 
+// DistributionRecipient defines a destination of the minted denom.
+type DistributionRecipient struct {
+	// unique name of the recipient
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind of destination
+	TargetType DistributionTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=teritori.mint.v1beta1.DistributionTargetType" json:"target_type,omitempty" yaml:"target_type"`
+	// account address or module account name, depending on the target type
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// proportion of the minted denom sent to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *DistributionRecipient) Reset()         { *m = DistributionRecipient{} }
+func (m *DistributionRecipient) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipient) ProtoMessage()    {}
+func (*DistributionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{4}
+}
+func (m *DistributionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipient.Merge(m, src)
+}
+func (m *DistributionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecipient proto.InternalMessageInfo
+
+func (m *DistributionRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DistributionRecipient) GetTargetType() DistributionTargetType {
+	if m != nil {
+		return m.TargetType
+	}
+	return DistributionTargetAccount
+}
+
+func (m *DistributionRecipient) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -234,13 +373,16 @@ type Params struct {
 	// reduction multiplier to execute on each period
 	ReductionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduction_factor"`
 	// distribution_proportions defines the proportion of the minted denom
-	DistributionProportions DistributionProportions `protobuf:"bytes,5,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
+	// Deprecated: replaced by distribution_recipients.
+	DistributionProportions DistributionProportions `protobuf:"bytes,5,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"` // Deprecated: Do not use.
 	// address to receive developer rewards
 	WeightedDeveloperRewardsReceivers []MonthlyVestingAddress `protobuf:"bytes,6,rep,name=weighted_developer_rewards_receivers,json=weightedDeveloperRewardsReceivers,proto3" json:"weighted_developer_rewards_receivers"`
 	// usage incentive address
-	UsageIncentiveAddress string `protobuf:"bytes,7,opt,name=usage_incentive_address,json=usageIncentiveAddress,proto3" json:"usage_incentive_address,omitempty"`
+	// Deprecated: replaced by distribution_recipients.
+	UsageIncentiveAddress string `protobuf:"bytes,7,opt,name=usage_incentive_address,json=usageIncentiveAddress,proto3" json:"usage_incentive_address,omitempty"` // Deprecated: Do not use.
 	// grants program address
-	GrantsProgramAddress string `protobuf:"bytes,8,opt,name=grants_program_address,json=grantsProgramAddress,proto3" json:"grants_program_address,omitempty"`
+	// Deprecated: replaced by distribution_recipients.
+	GrantsProgramAddress string `protobuf:"bytes,8,opt,name=grants_program_address,json=grantsProgramAddress,proto3" json:"grants_program_address,omitempty"` // Deprecated: Do not use.
 	// team reserve funds address
 	TeamReserveAddress string `protobuf:"bytes,9,opt,name=team_reserve_address,json=teamReserveAddress,proto3" json:"team_reserve_address,omitempty"`
 	// start block to distribute minting rewards
@@ -248,13 +390,53 @@ type Params struct {
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,11,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// burnt amount total
-	TotalBurntAmount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,12,rep,name=total_burnt_amount,json=totalBurntAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_burnt_amount" yaml:"total_burnt_amount"`
+	// Deprecated: burns are tracked by the burn ledger, see Query/TotalBurnt.
+	TotalBurntAmount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,12,rep,name=total_burnt_amount,json=totalBurntAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_burnt_amount" yaml:"total_burnt_amount"` // Deprecated: Do not use.
+	// how the length of a reduction period is measured
+	ReductionMode ReductionMode `protobuf:"varint,13,opt,name=reduction_mode,json=reductionMode,proto3,enum=teritori.mint.v1beta1.ReductionMode" json:"reduction_mode,omitempty" yaml:"reduction_mode"`
+	// length of a reduction period in block time, used in time mode
+	ReductionPeriodDuration time.Duration `protobuf:"bytes,14,opt,name=reduction_period_duration,json=reductionPeriodDuration,proto3,stdduration" json:"reduction_period_duration" yaml:"reduction_period_duration"`
+	// destinations of the minted denom, the weights of which sum to one
+	DistributionRecipients []DistributionRecipient `protobuf:"bytes,15,rep,name=distribution_recipients,json=distributionRecipients,proto3" json:"distribution_recipients" yaml:"distribution_recipients"`
+	// number of blocks the minted denom is accumulated in the mint module account
+	// before being distributed, 0 or 1 distributing every block. Module account
+	// recipients are still paid every block.
+	DistributionEpochBlocks uint64 `protobuf:"varint,16,opt,name=distribution_epoch_blocks,json=distributionEpochBlocks,proto3" json:"distribution_epoch_blocks,omitempty" yaml:"distribution_epoch_blocks"`
+	// addresses of the contracts notified of the emission events through a sudo
+	// call
+	HookContracts []string `protobuf:"bytes,17,rep,name=hook_contracts,json=hookContracts,proto3" json:"hook_contracts,omitempty" yaml:"hook_contracts"`
+	// addresses whose tokens are locked and excluded from the circulating supply,
+	// along with the module accounts, the team reserve and the distribution
+	// recipient accounts
+	LockedAddresses []string `protobuf:"bytes,18,rep,name=locked_addresses,json=lockedAddresses,proto3" json:"locked_addresses,omitempty" yaml:"locked_addresses"`
+	// number of blocks the transaction fees collected by the fee collector are
+	// averaged over for the staking APR, 0 to disable the fee tracking
+	FeeAprWindowBlocks uint64 `protobuf:"varint,19,opt,name=fee_apr_window_blocks,json=feeAprWindowBlocks,proto3" json:"fee_apr_window_blocks,omitempty" yaml:"fee_apr_window_blocks"`
+	// number of blocks between two inflation snapshots, 0 to disable the
+	// snapshots
+	InflationSnapshotIntervalBlocks uint64 `protobuf:"varint,20,opt,name=inflation_snapshot_interval_blocks,json=inflationSnapshotIntervalBlocks,proto3" json:"inflation_snapshot_interval_blocks,omitempty" yaml:"inflation_snapshot_interval_blocks"`
+	// number of blocks the inflation snapshots are kept for, 0 to keep them all
+	InflationSnapshotRetentionBlocks uint64 `protobuf:"varint,21,opt,name=inflation_snapshot_retention_blocks,json=inflationSnapshotRetentionBlocks,proto3" json:"inflation_snapshot_retention_blocks,omitempty" yaml:"inflation_snapshot_retention_blocks"`
+	// maximum total supply of the mint denom, the minted amount being clipped so
+	// the supply does not exceed it, 0 for no maximum
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// share of the transaction fees of the mint denom burnt at the end of every
+	// block, between 0 and 1
+	FeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=fee_burn_ratio,json=feeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_ratio" yaml:"fee_burn_ratio"`
+	// number of blocks of a fee burn statistics epoch, 0 to disable the
+	// statistics
+	FeeBurnEpochBlocks uint64 `protobuf:"varint,24,opt,name=fee_burn_epoch_blocks,json=feeBurnEpochBlocks,proto3" json:"fee_burn_epoch_blocks,omitempty" yaml:"fee_burn_epoch_blocks"`
+	// address allowed to pause and resume minting besides the governance
+	// account, empty for none
+	GuardianAddress string `protobuf:"bytes,25,opt,name=guardian_address,json=guardianAddress,proto3" json:"guardian_address,omitempty" yaml:"guardian_address"`
+	// number of fee burn statistics epochs kept, 0 to keep them all
+	FeeBurnRetentionEpochs uint64 `protobuf:"varint,26,opt,name=fee_burn_retention_epochs,json=feeBurnRetentionEpochs,proto3" json:"fee_burn_retention_epochs,omitempty" yaml:"fee_burn_retention_epochs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c07847a6b41ff6df, []int{4}
+	return fileDescriptor_c07847a6b41ff6df, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,6 +479,7 @@ func (m *Params) GetReductionPeriodInBlocks() int64 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetDistributionProportions() DistributionProportions {
 	if m != nil {
 		return m.DistributionProportions
@@ -311,6 +494,7 @@ func (m *Params) GetWeightedDeveloperRewardsReceivers() []MonthlyVestingAddress
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetUsageIncentiveAddress() string {
 	if m != nil {
 		return m.UsageIncentiveAddress
@@ -318,6 +502,7 @@ func (m *Params) GetUsageIncentiveAddress() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetGrantsProgramAddress() string {
 	if m != nil {
 		return m.GrantsProgramAddress
@@ -346,527 +531,5247 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "teritori.mint.v1beta1.Minter")
-	proto.RegisterType((*TeamVestingMonthInfo)(nil), "teritori.mint.v1beta1.TeamVestingMonthInfo")
-	proto.RegisterType((*MonthlyVestingAddress)(nil), "teritori.mint.v1beta1.MonthlyVestingAddress")
-	proto.RegisterType((*DistributionProportions)(nil), "teritori.mint.v1beta1.DistributionProportions")
-	proto.RegisterType((*Params)(nil), "teritori.mint.v1beta1.Params")
+func (m *Params) GetReductionMode() ReductionMode {
+	if m != nil {
+		return m.ReductionMode
+	}
+	return ReductionModeBlocks
 }
 
-func init() { proto.RegisterFile("teritori/mint/v1beta1/mint.proto", fileDescriptor_c07847a6b41ff6df) }
+func (m *Params) GetReductionPeriodDuration() time.Duration {
+	if m != nil {
+		return m.ReductionPeriodDuration
+	}
+	return 0
+}
 
-var fileDescriptor_c07847a6b41ff6df = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xb7, 0xe3, 0x90, 0xe0, 0x09, 0x71, 0xd2, 0xc1, 0x49, 0xb6, 0x91, 0xb0, 0xcd, 0xaa, 0x2a,
-	0x3e, 0xb4, 0xbb, 0x6d, 0x41, 0x1c, 0xc2, 0xa9, 0x26, 0xd0, 0x5a, 0xa2, 0xc2, 0xda, 0x86, 0xa2,
-	0xc2, 0x61, 0x34, 0xde, 0x9d, 0xac, 0x47, 0xf1, 0xce, 0x98, 0x99, 0x59, 0x17, 0x73, 0xe5, 0x0b,
-	0x70, 0xe4, 0xc8, 0x87, 0xe0, 0xc0, 0x99, 0x53, 0x8f, 0x3d, 0x22, 0x0e, 0x56, 0x95, 0x7c, 0x83,
-	0x7c, 0x02, 0x34, 0x7f, 0xd6, 0xc4, 0x4e, 0x22, 0x35, 0x3e, 0x79, 0xe6, 0xfd, 0xde, 0xfb, 0xbd,
-	0x99, 0x79, 0xef, 0xfd, 0xd6, 0xa0, 0xa5, 0x88, 0xa0, 0x8a, 0x0b, 0x1a, 0x66, 0x94, 0xa9, 0x70,
-	0xfc, 0xb0, 0x4f, 0x14, 0x7e, 0x68, 0x36, 0xc1, 0x48, 0x70, 0xc5, 0xe1, 0x4e, 0xe1, 0x11, 0x18,
-	0xa3, 0xf3, 0xd8, 0xaf, 0xa7, 0x3c, 0xe5, 0xc6, 0x23, 0xd4, 0x2b, 0xeb, 0xbc, 0xdf, 0x4c, 0x39,
-	0x4f, 0x87, 0x24, 0x34, 0xbb, 0x7e, 0x7e, 0x1c, 0x2a, 0x9a, 0x11, 0xa9, 0x70, 0x36, 0x72, 0x0e,
-	0xb7, 0x17, 0x1d, 0x30, 0x9b, 0x38, 0xa8, 0xb1, 0x08, 0x25, 0xb9, 0xc0, 0x8a, 0x72, 0x66, 0x71,
-	0x3f, 0x06, 0x6b, 0xcf, 0x28, 0x53, 0x44, 0xc0, 0x97, 0x60, 0xbb, 0x3f, 0xe4, 0xf1, 0x09, 0x1a,
-	0x09, 0x3e, 0xa6, 0x92, 0x72, 0x26, 0xbd, 0x72, 0xab, 0xdc, 0xae, 0x76, 0x82, 0xd7, 0xd3, 0x66,
-	0xe9, 0xdf, 0x69, 0xf3, 0x6e, 0x4a, 0xd5, 0x20, 0xef, 0x07, 0x31, 0xcf, 0xc2, 0x98, 0xcb, 0x8c,
-	0x4b, 0xf7, 0x73, 0x5f, 0x26, 0x27, 0xa1, 0x9a, 0x8c, 0x88, 0x0c, 0x0e, 0x49, 0x1c, 0x6d, 0x19,
-	0x9e, 0xde, 0x8c, 0xc6, 0xff, 0xb3, 0x0c, 0xea, 0x47, 0x04, 0x67, 0x2f, 0x88, 0x54, 0x94, 0xa5,
-	0xcf, 0x38, 0x53, 0x83, 0x2e, 0x3b, 0xe6, 0xf0, 0x01, 0xa8, 0x67, 0x7a, 0x23, 0x91, 0xa4, 0x2c,
-	0x26, 0x28, 0x25, 0x8c, 0x48, 0x6a, 0xf3, 0x56, 0x22, 0x68, 0xb1, 0xe7, 0x1a, 0x7a, 0x62, 0x11,
-	0x18, 0x80, 0x0f, 0x8d, 0x15, 0x49, 0x85, 0x85, 0x22, 0x09, 0x32, 0xb9, 0xbc, 0x15, 0x13, 0x70,
-	0xcb, 0x40, 0xcf, 0x2d, 0xd2, 0xd1, 0x00, 0x3c, 0x00, 0xfb, 0x9c, 0x11, 0x64, 0x63, 0x46, 0x44,
-	0x50, 0x9e, 0x20, 0xca, 0x6c, 0x94, 0xf4, 0x2a, 0x26, 0x6c, 0x97, 0x33, 0x62, 0xce, 0xd4, 0x33,
-	0x78, 0x97, 0x99, 0x50, 0xe9, 0xff, 0x55, 0x06, 0x3b, 0xc6, 0x3e, 0x9c, 0xb8, 0x93, 0x3f, 0x4e,
-	0x12, 0x41, 0xa4, 0x84, 0xf7, 0xc0, 0x3a, 0xb6, 0x4b, 0xf7, 0x44, 0xf0, 0x7c, 0xda, 0xac, 0x4d,
-	0x70, 0x36, 0x3c, 0xf0, 0x1d, 0xe0, 0x47, 0x85, 0x0b, 0xfc, 0x09, 0x6c, 0x65, 0x96, 0x06, 0xe1,
-	0x8c, 0xe7, 0x4c, 0x49, 0x6f, 0xa5, 0x55, 0x69, 0x57, 0x3b, 0x4f, 0x6f, 0xf0, 0xb0, 0x5d, 0xa6,
-	0xce, 0xa7, 0xcd, 0x5d, 0x9b, 0x63, 0x81, 0xce, 0x8f, 0x6a, 0xce, 0xf2, 0xd8, 0x19, 0xde, 0x56,
-	0xc0, 0xde, 0x21, 0x95, 0x4a, 0xd0, 0x7e, 0xae, 0xab, 0xdd, 0x13, 0x7c, 0xc4, 0x85, 0x5e, 0x49,
-	0xf8, 0x1d, 0xa8, 0xa5, 0x02, 0x33, 0x25, 0x75, 0xa5, 0x53, 0x81, 0xb3, 0x25, 0xcb, 0xbc, 0x69,
-	0x59, 0x7a, 0x96, 0x04, 0x32, 0x50, 0x8b, 0x79, 0x96, 0xe5, 0x8c, 0xaa, 0x09, 0x1a, 0x71, 0x3e,
-	0x34, 0x45, 0xa9, 0x76, 0x9e, 0xdc, 0x8c, 0xf6, 0x7c, 0xda, 0xdc, 0xb1, 0x97, 0x9c, 0x67, 0xf3,
-	0xa3, 0xcd, 0x99, 0xa1, 0xc7, 0xf9, 0x10, 0x7e, 0x0f, 0xb6, 0x72, 0x89, 0x53, 0x82, 0x74, 0x7b,
-	0x30, 0x45, 0xc7, 0xc4, 0x94, 0xf3, 0xe6, 0xf7, 0xa8, 0x19, 0x9a, 0x6e, 0xc1, 0x02, 0x9f, 0x82,
-	0x75, 0xa9, 0xf0, 0x09, 0x65, 0xa9, 0xb7, 0xba, 0x14, 0x61, 0x11, 0x0e, 0x7f, 0x04, 0xb7, 0x12,
-	0x32, 0x26, 0x43, 0x3e, 0x22, 0x02, 0x09, 0xf2, 0x0a, 0x8b, 0x44, 0x7a, 0xef, 0x2d, 0xc5, 0xb9,
-	0x3d, 0x23, 0x8a, 0x2c, 0x8f, 0xff, 0xf7, 0x3a, 0x58, 0xeb, 0x61, 0x81, 0x33, 0x09, 0x3f, 0x02,
-	0x40, 0xcb, 0x08, 0x4a, 0x08, 0xe3, 0xae, 0x9a, 0x51, 0x55, 0x5b, 0x0e, 0xb5, 0x01, 0x0e, 0x80,
-	0xe7, 0x06, 0x0b, 0x5d, 0x9a, 0xf0, 0x95, 0xa5, 0x4e, 0xb3, 0xeb, 0xf8, 0x3a, 0xf3, 0x83, 0x0e,
-	0xbf, 0x00, 0xfb, 0x82, 0x24, 0x79, 0xac, 0x1b, 0xed, 0xba, 0x69, 0xdb, 0x9b, 0x79, 0xcc, 0x8f,
-	0x9b, 0x16, 0xa0, 0xff, 0x83, 0x8f, 0x71, 0xac, 0xb8, 0x58, 0xb2, 0x00, 0x5b, 0x33, 0x9e, 0xaf,
-	0x0d, 0x0d, 0xe4, 0xc0, 0x4b, 0x2e, 0x4c, 0x83, 0x7e, 0x80, 0x62, 0x1c, 0x4c, 0x3d, 0x36, 0x1e,
-	0x05, 0xc1, 0x95, 0x8a, 0x1c, 0x5c, 0x33, 0x44, 0x9d, 0x55, 0x7d, 0xa4, 0x68, 0x2f, 0xb9, 0x66,
-	0xc6, 0x7e, 0x2d, 0x83, 0x3b, 0xaf, 0x08, 0x4d, 0x07, 0x5a, 0xa2, 0x2e, 0xf5, 0x00, 0x12, 0x24,
-	0x26, 0x74, 0x4c, 0x84, 0xf4, 0xd6, 0x5a, 0x95, 0xf6, 0xc6, 0xa3, 0x7b, 0xd7, 0x64, 0xbf, 0x52,
-	0x7d, 0x5c, 0xee, 0x8f, 0x0b, 0xfe, 0xc3, 0x85, 0xce, 0x88, 0x0a, 0x72, 0xf8, 0x39, 0xd8, 0x5b,
-	0x18, 0x11, 0x54, 0xc8, 0xd6, 0xba, 0x69, 0x92, 0x9d, 0xf9, 0xd6, 0x2f, 0xe4, 0xed, 0x33, 0xb0,
-	0x3b, 0xaf, 0x10, 0xb3, 0xb0, 0xf7, 0x4d, 0x58, 0x7d, 0x6e, 0xf2, 0x8b, 0xa8, 0x07, 0xa0, 0xae,
-	0x08, 0xce, 0x90, 0x20, 0x92, 0x88, 0x0b, 0xa9, 0xaa, 0x26, 0x06, 0x6a, 0x2c, 0xb2, 0x50, 0x11,
-	0xf1, 0x02, 0xb4, 0xf5, 0x75, 0x29, 0x4b, 0x67, 0x2f, 0x33, 0x57, 0x26, 0xa3, 0xf1, 0x4e, 0xe1,
-	0x81, 0x69, 0x9e, 0x3b, 0xce, 0xdf, 0x5d, 0xf5, 0x62, 0x75, 0x8c, 0xec, 0x5b, 0xd1, 0xbf, 0x0b,
-	0xec, 0x27, 0x48, 0xea, 0x1e, 0x44, 0x13, 0x82, 0x85, 0xb7, 0xd1, 0x2a, 0xb7, 0x57, 0xa3, 0x4d,
-	0x6b, 0xee, 0x11, 0xf1, 0x92, 0x60, 0x01, 0x7f, 0x01, 0x50, 0x71, 0x85, 0x87, 0xa8, 0x9f, 0x0b,
-	0xa6, 0x9c, 0x9a, 0x7a, 0x1f, 0x18, 0x6d, 0xfe, 0xc6, 0xf5, 0xdc, 0x27, 0xef, 0xd0, 0x73, 0x5f,
-	0x72, 0xca, 0xce, 0xa7, 0xcd, 0xdb, 0x56, 0xb7, 0x2e, 0x53, 0xfa, 0xd1, 0xb6, 0x31, 0x76, 0xb4,
-	0xcd, 0x4a, 0xf4, 0xc1, 0xea, 0xef, 0x7f, 0x34, 0x4b, 0x9d, 0xee, 0xeb, 0xd3, 0x46, 0xf9, 0xcd,
-	0x69, 0xa3, 0xfc, 0xf6, 0xb4, 0x51, 0xfe, 0xed, 0xac, 0x51, 0x7a, 0x73, 0xd6, 0x28, 0xfd, 0x73,
-	0xd6, 0x28, 0xfd, 0x10, 0x5e, 0xc8, 0x7b, 0xf4, 0x55, 0xd4, 0x3d, 0xfa, 0x36, 0xea, 0x86, 0x45,
-	0x97, 0xdc, 0x8f, 0x07, 0x98, 0xb2, 0xf0, 0x67, 0xfb, 0xff, 0xc2, 0x1c, 0xa2, 0xbf, 0x66, 0x3e,
-	0xe8, 0x9f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xea, 0x1c, 0x58, 0x7d, 0x08, 0x00, 0x00,
+func (m *Params) GetDistributionRecipients() []DistributionRecipient {
+	if m != nil {
+		return m.DistributionRecipients
+	}
+	return nil
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetDistributionEpochBlocks() uint64 {
+	if m != nil {
+		return m.DistributionEpochBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetHookContracts() []string {
+	if m != nil {
+		return m.HookContracts
+	}
+	return nil
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BlockProvisions.Size()
-		i -= size
-		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+func (m *Params) GetLockedAddresses() []string {
+	if m != nil {
+		return m.LockedAddresses
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TeamVestingMonthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetFeeAprWindowBlocks() uint64 {
+	if m != nil {
+		return m.FeeAprWindowBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TeamVestingMonthInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetInflationSnapshotIntervalBlocks() uint64 {
+	if m != nil {
+		return m.InflationSnapshotIntervalBlocks
+	}
+	return 0
 }
 
-func (m *TeamVestingMonthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OneMonthPeriodInBlocks != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.OneMonthPeriodInBlocks))
-		i--
-		dAtA[i] = 0x18
+func (m *Params) GetInflationSnapshotRetentionBlocks() uint64 {
+	if m != nil {
+		return m.InflationSnapshotRetentionBlocks
 	}
-	if m.MonthStartedBlock != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.MonthStartedBlock))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Params) GetFeeBurnEpochBlocks() uint64 {
+	if m != nil {
+		return m.FeeBurnEpochBlocks
 	}
-	if m.MonthsSinceGenesis != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.MonthsSinceGenesis))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Params) GetGuardianAddress() string {
+	if m != nil {
+		return m.GuardianAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MonthlyVestingAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetFeeBurnRetentionEpochs() uint64 {
+	if m != nil {
+		return m.FeeBurnRetentionEpochs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MonthlyVestingAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BurnRecord is a single entry of the burn history.
+type BurnRecord struct {
+	// sequence number of the burn
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account that burnt the tokens
+	Burner string `protobuf:"bytes,2,opt,name=burner,proto3" json:"burner,omitempty"`
+	// amount of tokens burnt
+	Amount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// height of the block the burn was executed in
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *MonthlyVestingAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MonthlyAmounts) > 0 {
-		for iNdEx := len(m.MonthlyAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MonthlyAmounts[iNdEx].Size()
-				i -= size
-				if _, err := m.MonthlyAmounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *BurnRecord) Reset()         { *m = BurnRecord{} }
+func (m *BurnRecord) String() string { return proto.CompactTextString(m) }
+func (*BurnRecord) ProtoMessage()    {}
+func (*BurnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{6}
+}
+func (m *BurnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *BurnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnRecord.Merge(m, src)
+}
+func (m *BurnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnRecord.DiscardUnknown(m)
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BurnRecord proto.InternalMessageInfo
+
+func (m *BurnRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BurnRecord) GetBurner() string {
+	if m != nil {
+		return m.Burner
+	}
+	return ""
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.DeveloperRewards.Size()
-		i -= size
-		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+func (m *BurnRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Staking.Size()
-		i -= size
-		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// BurnerTotal holds the cumulative amount of tokens burnt by an account.
+type BurnerTotal struct {
+	Address string                                    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *BurnerTotal) Reset()         { *m = BurnerTotal{} }
+func (m *BurnerTotal) String() string { return proto.CompactTextString(m) }
+func (*BurnerTotal) ProtoMessage()    {}
+func (*BurnerTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{7}
+}
+func (m *BurnerTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnerTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnerTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UsageIncentive.Size()
-		i -= size
-		if _, err := m.UsageIncentive.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CommunityPool.Size()
-		i -= size
-		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.GrantsProgram.Size()
-		i -= size
-		if _, err := m.GrantsProgram.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BurnerTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnerTotal.Merge(m, src)
+}
+func (m *BurnerTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnerTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnerTotal.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BurnerTotal proto.InternalMessageInfo
+
+func (m *BurnerTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DistributionTotals holds the cumulative amounts of minted denom ever minted
+// and ever sent to each distribution bucket.
+type DistributionTotals struct {
+	// total amount minted
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// total amount allocated to each distribution recipient
+	Recipients []DistributionRecipientTotal `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients"`
+	// total amount vested to each developer rewards receiver
+	DeveloperVesting []DeveloperVestingTotal `protobuf:"bytes,5,rep,name=developer_vesting,json=developerVesting,proto3" json:"developer_vesting"`
+	// total amount sent to the team reserve address
+	TeamReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=team_reserve,json=teamReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"team_reserve"`
+	// total amount sent to the community pool, including truncation remainders
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalBurntAmount) > 0 {
-		for iNdEx := len(m.TotalBurntAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TotalBurntAmount[iNdEx].Size()
-				i -= size
-				if _, err := m.TotalBurntAmount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MintingRewardsDistributionStartBlock != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartBlock))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.TeamReserveAddress) > 0 {
-		i -= len(m.TeamReserveAddress)
-		copy(dAtA[i:], m.TeamReserveAddress)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.TeamReserveAddress)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.GrantsProgramAddress) > 0 {
-		i -= len(m.GrantsProgramAddress)
-		copy(dAtA[i:], m.GrantsProgramAddress)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.GrantsProgramAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.UsageIncentiveAddress) > 0 {
-		i -= len(m.UsageIncentiveAddress)
-		copy(dAtA[i:], m.UsageIncentiveAddress)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.UsageIncentiveAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
-		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+func (m *DistributionTotals) Reset()         { *m = DistributionTotals{} }
+func (m *DistributionTotals) String() string { return proto.CompactTextString(m) }
+func (*DistributionTotals) ProtoMessage()    {}
+func (*DistributionTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{8}
+}
+func (m *DistributionTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.ReductionFactor.Size()
-		i -= size
-		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ReductionPeriodInBlocks != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.ReductionPeriodInBlocks))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.GenesisBlockProvisions.Size()
-		i -= size
-		if _, err := m.GenesisBlockProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DistributionTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTotals.Merge(m, src)
+}
+func (m *DistributionTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTotals.DiscardUnknown(m)
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_DistributionTotals proto.InternalMessageInfo
+
+func (m *DistributionTotals) GetRecipients() []DistributionRecipientTotal {
+	if m != nil {
+		return m.Recipients
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DistributionTotals) GetDeveloperVesting() []DeveloperVestingTotal {
+	if m != nil {
+		return m.DeveloperVesting
 	}
-	var l int
-	_ = l
-	l = m.BlockProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	return nil
 }
 
-func (m *TeamVestingMonthInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MonthsSinceGenesis != 0 {
-		n += 1 + sovMint(uint64(m.MonthsSinceGenesis))
-	}
-	if m.MonthStartedBlock != 0 {
-		n += 1 + sovMint(uint64(m.MonthStartedBlock))
-	}
-	if m.OneMonthPeriodInBlocks != 0 {
-		n += 1 + sovMint(uint64(m.OneMonthPeriodInBlocks))
-	}
-	return n
+// DistributionRecipientTotal holds the cumulative amount allocated to a
+// distribution recipient.
+type DistributionRecipientTotal struct {
+	Name   string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *MonthlyVestingAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	if len(m.MonthlyAmounts) > 0 {
-		for _, e := range m.MonthlyAmounts {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+func (m *DistributionRecipientTotal) Reset()         { *m = DistributionRecipientTotal{} }
+func (m *DistributionRecipientTotal) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipientTotal) ProtoMessage()    {}
+func (*DistributionRecipientTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{9}
+}
+func (m *DistributionRecipientTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecipientTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecipientTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DistributionRecipientTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipientTotal.Merge(m, src)
+}
+func (m *DistributionRecipientTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecipientTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipientTotal.DiscardUnknown(m)
 }
 
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DistributionRecipientTotal proto.InternalMessageInfo
+
+func (m *DistributionRecipientTotal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = m.GrantsProgram.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.UsageIncentive.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.Staking.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.DeveloperRewards.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	return ""
 }
 
-func (m *Params) Size() (n int) {
+// DeveloperVestingTotal holds the cumulative amount vested to a developer
+// rewards receiver.
+type DeveloperVestingTotal struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *DeveloperVestingTotal) Reset()         { *m = DeveloperVestingTotal{} }
+func (m *DeveloperVestingTotal) String() string { return proto.CompactTextString(m) }
+func (*DeveloperVestingTotal) ProtoMessage()    {}
+func (*DeveloperVestingTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{10}
+}
+func (m *DeveloperVestingTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperVestingTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperVestingTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperVestingTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperVestingTotal.Merge(m, src)
+}
+func (m *DeveloperVestingTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperVestingTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperVestingTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperVestingTotal proto.InternalMessageInfo
+
+func (m *DeveloperVestingTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// VestingRewards holds the developer rewards vested to a developer rewards
+// receiver, which the receiver claims through MsgClaimVestedRewards.
+type VestingRewards struct {
+	// receiver address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// vested amount not claimed yet
+	Claimable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=claimable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable"`
+	// cumulative amount claimed
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
+}
+
+func (m *VestingRewards) Reset()         { *m = VestingRewards{} }
+func (m *VestingRewards) String() string { return proto.CompactTextString(m) }
+func (*VestingRewards) ProtoMessage()    {}
+func (*VestingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{11}
+}
+func (m *VestingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingRewards.Merge(m, src)
+}
+func (m *VestingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingRewards proto.InternalMessageInfo
+
+func (m *VestingRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// VestingReceiverRotation is a change of the address of a developer rewards
+// receiver slot, made by the receiver through MsgRotateVestingReceiver.
+type VestingReceiverRotation struct {
+	// unique id of the rotation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// index of the slot in the weighted developer rewards receivers
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// address of the slot before the rotation
+	PreviousAddress string `protobuf:"bytes,3,opt,name=previous_address,json=previousAddress,proto3" json:"previous_address,omitempty"`
+	// address of the slot after the rotation
+	NewAddress string `protobuf:"bytes,4,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	// height of the block the rotation happened in
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *VestingReceiverRotation) Reset()         { *m = VestingReceiverRotation{} }
+func (m *VestingReceiverRotation) String() string { return proto.CompactTextString(m) }
+func (*VestingReceiverRotation) ProtoMessage()    {}
+func (*VestingReceiverRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{12}
+}
+func (m *VestingReceiverRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingReceiverRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingReceiverRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingReceiverRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingReceiverRotation.Merge(m, src)
+}
+func (m *VestingReceiverRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingReceiverRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingReceiverRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingReceiverRotation proto.InternalMessageInfo
+
+func (m *VestingReceiverRotation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VestingReceiverRotation) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *VestingReceiverRotation) GetPreviousAddress() string {
+	if m != nil {
+		return m.PreviousAddress
+	}
+	return ""
+}
+
+func (m *VestingReceiverRotation) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *VestingReceiverRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EmissionCheckpoint is the state the emission invariant projects the minted
+// amount from in block mode. It is reset at genesis, on parameters updates and
+// on upgrades.
+type EmissionCheckpoint struct {
+	// first block the projection covers
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// minted amount of the distribution totals before the block
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+	// block provisions of the minter before the block
+	BlockProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=block_provisions,json=blockProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_provisions"`
+	// last reduction block before the block
+	LastReductionBlock int64 `protobuf:"varint,4,opt,name=last_reduction_block,json=lastReductionBlock,proto3" json:"last_reduction_block,omitempty"`
+	// block provisions not minted since the checkpoint, because of the max supply
+	// or of a failure to mint
+	Clipped github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=clipped,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"clipped"`
+}
+
+func (m *EmissionCheckpoint) Reset()         { *m = EmissionCheckpoint{} }
+func (m *EmissionCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EmissionCheckpoint) ProtoMessage()    {}
+func (*EmissionCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{13}
+}
+func (m *EmissionCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCheckpoint.Merge(m, src)
+}
+func (m *EmissionCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCheckpoint proto.InternalMessageInfo
+
+func (m *EmissionCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EmissionCheckpoint) GetLastReductionBlock() int64 {
+	if m != nil {
+		return m.LastReductionBlock
+	}
+	return 0
+}
+
+// FeeInflowWindow holds the transaction fees collected over the fee APR window.
+type FeeInflowWindow struct {
+	// sum of the fees of the blocks of the window
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// height at which the fees started being tracked
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *FeeInflowWindow) Reset()         { *m = FeeInflowWindow{} }
+func (m *FeeInflowWindow) String() string { return proto.CompactTextString(m) }
+func (*FeeInflowWindow) ProtoMessage()    {}
+func (*FeeInflowWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{14}
+}
+func (m *FeeInflowWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeInflowWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeInflowWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeInflowWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeInflowWindow.Merge(m, src)
+}
+func (m *FeeInflowWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeInflowWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeInflowWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeInflowWindow proto.InternalMessageInfo
+
+func (m *FeeInflowWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// InflationSnapshot records the emission and staking figures at a height.
+type InflationSnapshot struct {
+	// height of the block the snapshot was taken at, after minting
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// block provisions of the minter
+	BlockProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=block_provisions,json=blockProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_provisions"`
+	// total supply of the mint denom
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	// total bonded tokens
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens"`
+	// yearly provisions over the total supply
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// staking APR net of the community tax, including the average transaction
+	// fees when they are tracked
+	StakingApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=staking_apr,json=stakingApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_apr"`
+}
+
+func (m *InflationSnapshot) Reset()         { *m = InflationSnapshot{} }
+func (m *InflationSnapshot) String() string { return proto.CompactTextString(m) }
+func (*InflationSnapshot) ProtoMessage()    {}
+func (*InflationSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{15}
+}
+func (m *InflationSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSnapshot.Merge(m, src)
+}
+func (m *InflationSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSnapshot proto.InternalMessageInfo
+
+func (m *InflationSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InflationSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// FeeBurnEpoch records the transaction fees collected and burnt during a fee
+// burn statistics epoch.
+type FeeBurnEpoch struct {
+	// height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// height of the last block recorded in the epoch
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// transaction fees of the mint denom collected
+	Collected github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=collected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collected"`
+	// part of the collected fees burnt
+	Burnt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=burnt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burnt"`
+}
+
+func (m *FeeBurnEpoch) Reset()         { *m = FeeBurnEpoch{} }
+func (m *FeeBurnEpoch) String() string { return proto.CompactTextString(m) }
+func (*FeeBurnEpoch) ProtoMessage()    {}
+func (*FeeBurnEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{16}
+}
+func (m *FeeBurnEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBurnEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBurnEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBurnEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBurnEpoch.Merge(m, src)
+}
+func (m *FeeBurnEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBurnEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBurnEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBurnEpoch proto.InternalMessageInfo
+
+func (m *FeeBurnEpoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FeeBurnEpoch) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// MintingPause is the state of the minting circuit breaker.
+type MintingPause struct {
+	// whether minting and distribution are paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// height of the block minting was paused at, while paused
+	PausedHeight int64 `protobuf:"varint,2,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	// time of the block minting was paused at, while paused
+	PausedTime time.Time `protobuf:"bytes,3,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time"`
+	// number of blocks minting was paused for, over the past pauses
+	TotalPausedBlocks int64 `protobuf:"varint,4,opt,name=total_paused_blocks,json=totalPausedBlocks,proto3" json:"total_paused_blocks,omitempty"`
+	// block time minting was paused for, over the past pauses
+	TotalPausedDuration time.Duration `protobuf:"bytes,5,opt,name=total_paused_duration,json=totalPausedDuration,proto3,stdduration" json:"total_paused_duration"`
+}
+
+func (m *MintingPause) Reset()         { *m = MintingPause{} }
+func (m *MintingPause) String() string { return proto.CompactTextString(m) }
+func (*MintingPause) ProtoMessage()    {}
+func (*MintingPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{17}
+}
+func (m *MintingPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintingPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintingPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintingPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintingPause.Merge(m, src)
+}
+func (m *MintingPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintingPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintingPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintingPause proto.InternalMessageInfo
+
+func (m *MintingPause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MintingPause) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
+	}
+	return 0
+}
+
+func (m *MintingPause) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
+func (m *MintingPause) GetTotalPausedBlocks() int64 {
+	if m != nil {
+		return m.TotalPausedBlocks
+	}
+	return 0
+}
+
+func (m *MintingPause) GetTotalPausedDuration() time.Duration {
+	if m != nil {
+		return m.TotalPausedDuration
+	}
+	return 0
+}
+
+// MintFault records a failure to mint or distribute the coins of a block.
+type MintFault struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// distribution bucket that could not be paid: a distribution recipient name,
+	// developer_vesting, team_reserve, community_pool, or mint when the coins
+	// could not be minted
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// error of the failure
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// amount sent to the community pool instead
+	Redirected github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=redirected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redirected"`
+}
+
+func (m *MintFault) Reset()         { *m = MintFault{} }
+func (m *MintFault) String() string { return proto.CompactTextString(m) }
+func (*MintFault) ProtoMessage()    {}
+func (*MintFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c07847a6b41ff6df, []int{18}
+}
+func (m *MintFault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintFault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintFault.Merge(m, src)
+}
+func (m *MintFault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintFault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintFault proto.InternalMessageInfo
+
+func (m *MintFault) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintFault) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *MintFault) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("teritori.mint.v1beta1.ReductionMode", ReductionMode_name, ReductionMode_value)
+	proto.RegisterEnum("teritori.mint.v1beta1.DistributionTargetType", DistributionTargetType_name, DistributionTargetType_value)
+	proto.RegisterType((*Minter)(nil), "teritori.mint.v1beta1.Minter")
+	proto.RegisterType((*TeamVestingMonthInfo)(nil), "teritori.mint.v1beta1.TeamVestingMonthInfo")
+	proto.RegisterType((*MonthlyVestingAddress)(nil), "teritori.mint.v1beta1.MonthlyVestingAddress")
+	proto.RegisterType((*DistributionProportions)(nil), "teritori.mint.v1beta1.DistributionProportions")
+	proto.RegisterType((*DistributionRecipient)(nil), "teritori.mint.v1beta1.DistributionRecipient")
+	proto.RegisterType((*Params)(nil), "teritori.mint.v1beta1.Params")
+	proto.RegisterType((*BurnRecord)(nil), "teritori.mint.v1beta1.BurnRecord")
+	proto.RegisterType((*BurnerTotal)(nil), "teritori.mint.v1beta1.BurnerTotal")
+	proto.RegisterType((*DistributionTotals)(nil), "teritori.mint.v1beta1.DistributionTotals")
+	proto.RegisterType((*DistributionRecipientTotal)(nil), "teritori.mint.v1beta1.DistributionRecipientTotal")
+	proto.RegisterType((*DeveloperVestingTotal)(nil), "teritori.mint.v1beta1.DeveloperVestingTotal")
+	proto.RegisterType((*VestingRewards)(nil), "teritori.mint.v1beta1.VestingRewards")
+	proto.RegisterType((*VestingReceiverRotation)(nil), "teritori.mint.v1beta1.VestingReceiverRotation")
+	proto.RegisterType((*EmissionCheckpoint)(nil), "teritori.mint.v1beta1.EmissionCheckpoint")
+	proto.RegisterType((*FeeInflowWindow)(nil), "teritori.mint.v1beta1.FeeInflowWindow")
+	proto.RegisterType((*InflationSnapshot)(nil), "teritori.mint.v1beta1.InflationSnapshot")
+	proto.RegisterType((*FeeBurnEpoch)(nil), "teritori.mint.v1beta1.FeeBurnEpoch")
+	proto.RegisterType((*MintingPause)(nil), "teritori.mint.v1beta1.MintingPause")
+	proto.RegisterType((*MintFault)(nil), "teritori.mint.v1beta1.MintFault")
+}
+
+func init() { proto.RegisterFile("teritori/mint/v1beta1/mint.proto", fileDescriptor_c07847a6b41ff6df) }
+
+var fileDescriptor_c07847a6b41ff6df = []byte{
+	// 2398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0xff, 0x48, 0x96, 0x9e, 0x24, 0x8a, 0x1a, 0xeb, 0xcf, 0x8a, 0x4d, 0x44, 0x66, 0x63,
+	0x38, 0x8e, 0x11, 0x53, 0x89, 0x7b, 0x09, 0xdc, 0x4b, 0x45, 0x89, 0xb2, 0xe9, 0x5a, 0x12, 0x3b,
+	0xa2, 0x6c, 0xb8, 0x3d, 0x6c, 0x57, 0xdc, 0x11, 0xb5, 0x10, 0x77, 0x67, 0x33, 0xbb, 0x94, 0xac,
+	0xa0, 0x3d, 0xf5, 0xd0, 0x42, 0x40, 0x81, 0x5c, 0x0a, 0x04, 0x28, 0x04, 0xb4, 0xe8, 0x57, 0xe8,
+	0xa1, 0xe7, 0x9e, 0x02, 0xf4, 0x62, 0xf4, 0xd4, 0xe6, 0xc0, 0x06, 0x76, 0x3f, 0x81, 0xfa, 0x05,
+	0x8a, 0xf9, 0xb3, 0xe4, 0x2e, 0x29, 0x3a, 0xd1, 0x3a, 0x3d, 0x89, 0x33, 0xef, 0xbd, 0xdf, 0x9b,
+	0x99, 0xf7, 0xe6, 0x37, 0xef, 0xad, 0xa0, 0x14, 0x10, 0x66, 0x07, 0x94, 0xd9, 0x6b, 0x8e, 0xed,
+	0x06, 0x6b, 0x27, 0x9f, 0x1c, 0x90, 0xc0, 0xfc, 0x44, 0x0c, 0xca, 0x1e, 0xa3, 0x01, 0x45, 0x8b,
+	0xa1, 0x46, 0x59, 0x4c, 0x2a, 0x8d, 0xc2, 0x42, 0x8b, 0xb6, 0xa8, 0xd0, 0x58, 0xe3, 0xbf, 0xa4,
+	0x72, 0xa1, 0xd8, 0xa2, 0xb4, 0xd5, 0x26, 0x6b, 0x62, 0x74, 0xd0, 0x39, 0x5c, 0x0b, 0x6c, 0x87,
+	0xf8, 0x81, 0xe9, 0x78, 0x4a, 0x61, 0x65, 0x50, 0xc1, 0x74, 0xcf, 0x94, 0x68, 0x75, 0x50, 0x64,
+	0x75, 0x98, 0x19, 0xd8, 0xd4, 0x95, 0x72, 0xbd, 0x09, 0x13, 0xdb, 0xb6, 0x1b, 0x10, 0x86, 0x9e,
+	0x43, 0xfe, 0xa0, 0x4d, 0x9b, 0xc7, 0x86, 0xc7, 0xe8, 0x89, 0xed, 0xdb, 0xd4, 0xf5, 0xb5, 0x54,
+	0x29, 0x75, 0x67, 0xaa, 0x52, 0xfe, 0xaa, 0x5b, 0x1c, 0xfb, 0xba, 0x5b, 0xbc, 0xdd, 0xb2, 0x83,
+	0xa3, 0xce, 0x41, 0xb9, 0x49, 0x9d, 0xb5, 0x26, 0xf5, 0x1d, 0xea, 0xab, 0x3f, 0xf7, 0x7c, 0xeb,
+	0x78, 0x2d, 0x38, 0xf3, 0x88, 0x5f, 0xde, 0x24, 0x4d, 0x3c, 0x27, 0x70, 0xea, 0x3d, 0x18, 0xfd,
+	0x2f, 0x29, 0x58, 0x68, 0x10, 0xd3, 0x79, 0x4a, 0xfc, 0xc0, 0x76, 0x5b, 0xdb, 0xd4, 0x0d, 0x8e,
+	0x6a, 0xee, 0x21, 0x45, 0x1f, 0xc3, 0x82, 0xc3, 0x07, 0xbe, 0xe1, 0xdb, 0x6e, 0x93, 0x18, 0x2d,
+	0xe2, 0x12, 0xdf, 0x96, 0x7e, 0x33, 0x18, 0x49, 0xd9, 0x1e, 0x17, 0x3d, 0x94, 0x12, 0x54, 0x86,
+	0x9b, 0x62, 0xd6, 0xf0, 0x03, 0x93, 0x05, 0xc4, 0x32, 0x84, 0x2f, 0x2d, 0x2d, 0x0c, 0xe6, 0x85,
+	0x68, 0x4f, 0x4a, 0x2a, 0x5c, 0x80, 0x1e, 0x40, 0x81, 0xba, 0xc4, 0x90, 0x36, 0x1e, 0x61, 0x36,
+	0xb5, 0x0c, 0xdb, 0x95, 0x56, 0xbe, 0x96, 0x11, 0x66, 0x4b, 0xd4, 0x25, 0x62, 0x4d, 0x75, 0x21,
+	0xaf, 0xb9, 0xc2, 0xd4, 0xd7, 0xff, 0x9a, 0x82, 0x45, 0x31, 0xdf, 0x3e, 0x53, 0x2b, 0x5f, 0xb7,
+	0x2c, 0x46, 0x7c, 0x1f, 0x7d, 0x04, 0x37, 0x4c, 0xf9, 0x53, 0x1d, 0x11, 0xba, 0xec, 0x16, 0x73,
+	0x67, 0xa6, 0xd3, 0x7e, 0xa0, 0x2b, 0x81, 0x8e, 0x43, 0x15, 0xf4, 0x19, 0xcc, 0x39, 0x12, 0xc6,
+	0x30, 0x1d, 0xda, 0x71, 0x03, 0x5f, 0x4b, 0x97, 0x32, 0x77, 0xa6, 0x2a, 0x8f, 0xae, 0x71, 0xb0,
+	0x35, 0x37, 0xb8, 0xec, 0x16, 0x97, 0xa4, 0x8f, 0x01, 0x38, 0x1d, 0xe7, 0xd4, 0xcc, 0xba, 0x9a,
+	0xf8, 0x26, 0x03, 0xcb, 0x9b, 0xb6, 0x1f, 0x30, 0xfb, 0xa0, 0xc3, 0xa3, 0x5d, 0x67, 0xd4, 0xa3,
+	0x8c, 0xff, 0xf2, 0xd1, 0x3e, 0xe4, 0x5a, 0xcc, 0x74, 0x03, 0x9f, 0x47, 0xba, 0xc5, 0x4c, 0x27,
+	0x61, 0x98, 0x67, 0x25, 0x4a, 0x5d, 0x82, 0x20, 0x17, 0x72, 0x4d, 0xea, 0x38, 0x1d, 0xd7, 0x0e,
+	0xce, 0x0c, 0x8f, 0xd2, 0xb6, 0x08, 0xca, 0x54, 0xe5, 0xe1, 0xf5, 0x60, 0x2f, 0xbb, 0xc5, 0x45,
+	0xb9, 0xc9, 0x38, 0x9a, 0x8e, 0x67, 0x7b, 0x13, 0x75, 0x4a, 0xdb, 0xe8, 0x19, 0xcc, 0x75, 0x7c,
+	0xb3, 0x45, 0x0c, 0x9e, 0x1e, 0x6e, 0x60, 0x9f, 0x10, 0x2d, 0x93, 0x68, 0x1f, 0x39, 0x01, 0x53,
+	0x0b, 0x51, 0xd0, 0x23, 0xb8, 0xe1, 0x07, 0xe6, 0xb1, 0xed, 0xb6, 0xb4, 0x6c, 0x22, 0xc0, 0xd0,
+	0x1c, 0xfd, 0x1c, 0xe6, 0x2d, 0x72, 0x42, 0xda, 0xd4, 0x23, 0xcc, 0x60, 0xe4, 0xd4, 0x64, 0x96,
+	0xaf, 0x8d, 0x27, 0xc2, 0xcc, 0xf7, 0x80, 0xb0, 0xc4, 0xd1, 0xff, 0x9b, 0x82, 0xc5, 0x68, 0x88,
+	0x31, 0x69, 0xda, 0x9e, 0x4d, 0xdc, 0x00, 0x21, 0xc8, 0xba, 0xa6, 0x43, 0x64, 0x58, 0xb1, 0xf8,
+	0x8d, 0x0e, 0x61, 0x3a, 0x30, 0x59, 0x8b, 0x04, 0x06, 0xc7, 0x14, 0xa1, 0xc9, 0xdd, 0xbf, 0x57,
+	0xbe, 0x92, 0x86, 0xca, 0x51, 0xd8, 0x86, 0xb0, 0x6a, 0x9c, 0x79, 0xa4, 0xb2, 0x74, 0xd9, 0x2d,
+	0x22, 0x19, 0x9b, 0x08, 0x96, 0x8e, 0x21, 0xe8, 0xe9, 0xa0, 0x25, 0x98, 0x90, 0x23, 0x19, 0x0c,
+	0xac, 0x46, 0x68, 0x0b, 0x26, 0x4e, 0x89, 0xdd, 0x3a, 0x0a, 0x12, 0x9e, 0xa9, 0xb2, 0xd6, 0xff,
+	0x85, 0x60, 0xa2, 0x6e, 0x32, 0xd3, 0xf1, 0xd1, 0xbb, 0x00, 0x7c, 0xd5, 0x86, 0x45, 0x5c, 0xaa,
+	0x72, 0x18, 0x4f, 0xf1, 0x99, 0x4d, 0x3e, 0x81, 0x8e, 0x40, 0x53, 0x74, 0x62, 0x0c, 0xf1, 0x5a,
+	0x3a, 0xd1, 0x1a, 0x96, 0x14, 0x5e, 0x25, 0x4e, 0x6f, 0xe8, 0x47, 0x50, 0x60, 0xc4, 0xea, 0x34,
+	0xf9, 0x71, 0x8d, 0xe2, 0x98, 0xe5, 0x9e, 0x46, 0x9c, 0x64, 0x38, 0xed, 0xf6, 0x8d, 0x0f, 0xcd,
+	0x66, 0x40, 0x59, 0xc2, 0x23, 0x9a, 0xeb, 0xe1, 0x6c, 0x09, 0x18, 0xf4, 0x19, 0x68, 0x56, 0x24,
+	0x92, 0x86, 0xd7, 0x27, 0x01, 0x91, 0x85, 0xd3, 0xf7, 0xcb, 0xdf, 0x21, 0x01, 0x22, 0xd4, 0x51,
+	0x99, 0xd0, 0x52, 0x7c, 0x51, 0x78, 0xd9, 0x1a, 0xc1, 0x2d, 0xbf, 0x4e, 0xc1, 0x2d, 0x19, 0x29,
+	0x62, 0x19, 0x43, 0xb9, 0x6f, 0x30, 0xd2, 0x24, 0xf6, 0x09, 0x61, 0xbe, 0x36, 0x51, 0xca, 0xdc,
+	0x99, 0xbe, 0xff, 0xd1, 0x08, 0xff, 0x57, 0xb2, 0x6e, 0x25, 0x2b, 0x7c, 0xbf, 0x17, 0xe2, 0x6f,
+	0x0e, 0xdc, 0x08, 0x1c, 0x82, 0xa3, 0x07, 0xb0, 0x3c, 0x40, 0x0d, 0x46, 0x48, 0xd7, 0x37, 0xc4,
+	0xd1, 0xa6, 0xb5, 0x14, 0x5e, 0x8c, 0x5f, 0xfb, 0x90, 0xda, 0x3f, 0x85, 0xa5, 0x38, 0x3b, 0xf6,
+	0x4c, 0x27, 0x7b, 0xa6, 0x0b, 0x31, 0xe6, 0x0b, 0x2d, 0x3f, 0x86, 0x85, 0x80, 0x98, 0x8e, 0xc1,
+	0x88, 0x4f, 0x58, 0xc4, 0xe5, 0x94, 0xc8, 0x4c, 0xc4, 0x65, 0x58, 0x8a, 0x42, 0x8b, 0xa7, 0x70,
+	0x87, 0x6f, 0xdb, 0x76, 0x5b, 0xbd, 0x13, 0x8a, 0x05, 0x4c, 0xbc, 0x71, 0xea, 0x85, 0x03, 0x91,
+	0x46, 0xb7, 0x94, 0xbe, 0xda, 0x72, 0x34, 0x4e, 0xe2, 0xd9, 0x93, 0x8f, 0xde, 0x6d, 0x90, 0x4f,
+	0xb0, 0xcf, 0xb3, 0xd1, 0x38, 0x23, 0x26, 0xd3, 0xa6, 0x4b, 0xa9, 0x3b, 0x59, 0x3c, 0x2b, 0xa7,
+	0xeb, 0x84, 0x3d, 0x27, 0x26, 0x43, 0xbf, 0x04, 0x14, 0xd0, 0xc0, 0x6c, 0x1b, 0x07, 0x1d, 0xe6,
+	0x06, 0xea, 0x35, 0xd1, 0x66, 0xc4, 0xdb, 0xb4, 0x23, 0x43, 0xfd, 0x75, 0xb7, 0xf8, 0xc1, 0x77,
+	0xc8, 0xbf, 0x0d, 0x6a, 0xbb, 0x97, 0xdd, 0xe2, 0x8a, 0x62, 0x87, 0x21, 0x50, 0x1d, 0xe7, 0xc5,
+	0x64, 0x85, 0xcf, 0xc9, 0x47, 0x0a, 0x1d, 0x42, 0xae, 0x9f, 0xf9, 0x0e, 0xb5, 0x88, 0x36, 0x2b,
+	0x58, 0xe9, 0xd6, 0x88, 0xa4, 0xc0, 0xa1, 0xf2, 0x36, 0xb5, 0x48, 0x65, 0xa5, 0xff, 0x50, 0xc4,
+	0x51, 0x74, 0x3c, 0xcb, 0xa2, 0x9a, 0x3c, 0x27, 0x57, 0x86, 0xee, 0x67, 0x58, 0x06, 0x69, 0x39,
+	0x71, 0x11, 0x56, 0xca, 0xb2, 0x4e, 0x2a, 0x87, 0x75, 0x52, 0x79, 0x53, 0x29, 0x54, 0x3e, 0xe2,
+	0xc7, 0x70, 0xd9, 0x2d, 0x96, 0x06, 0x9d, 0x0d, 0x20, 0xe9, 0x5f, 0xfe, 0xbb, 0x98, 0x1a, 0xba,
+	0xe7, 0x21, 0x0c, 0xfa, 0x5d, 0x0a, 0x62, 0xb7, 0xc6, 0x60, 0x21, 0x5f, 0xfb, 0xda, 0xdc, 0x1b,
+	0x2f, 0xc3, 0x95, 0x24, 0x5f, 0xb9, 0xad, 0x96, 0xb5, 0x2a, 0x97, 0x35, 0x02, 0x5a, 0xc7, 0x4b,
+	0xd6, 0x55, 0xe6, 0x3e, 0xfa, 0x05, 0xac, 0xc4, 0x6c, 0x88, 0x47, 0x9b, 0x47, 0x21, 0x67, 0xe5,
+	0x79, 0xb6, 0x54, 0x6e, 0xf5, 0x77, 0x3d, 0x52, 0x55, 0x8f, 0x73, 0x41, 0x95, 0x8b, 0x14, 0xb3,
+	0xfd, 0x18, 0x72, 0x47, 0x94, 0x1e, 0x1b, 0x4d, 0xea, 0x06, 0xcc, 0x6c, 0x06, 0xbe, 0x36, 0x2f,
+	0x32, 0x2b, 0x12, 0xb9, 0xb8, 0x5c, 0xc7, 0xb3, 0x7c, 0x62, 0x23, 0x1c, 0xa3, 0x2d, 0xc8, 0x73,
+	0x28, 0x62, 0x85, 0x77, 0x89, 0xf8, 0x1a, 0x12, 0x18, 0x3f, 0xb8, 0xec, 0x16, 0x97, 0x25, 0xc6,
+	0xa0, 0x86, 0x8e, 0xe7, 0xe4, 0xd4, 0x7a, 0x38, 0x83, 0xf6, 0x60, 0xf1, 0x90, 0x10, 0xc3, 0xf4,
+	0x98, 0x71, 0x6a, 0xbb, 0x16, 0x3d, 0x0d, 0xf7, 0x79, 0x53, 0xec, 0xb3, 0x74, 0xd9, 0x2d, 0xbe,
+	0x23, 0xc1, 0xae, 0x54, 0xd3, 0x31, 0x3a, 0x24, 0x64, 0xdd, 0x63, 0xcf, 0xc4, 0xac, 0xda, 0xde,
+	0xe7, 0xa0, 0xdb, 0xee, 0x61, 0xdb, 0x94, 0x37, 0xd5, 0x35, 0x3d, 0xff, 0x88, 0x06, 0x86, 0xa8,
+	0xa5, 0x4f, 0x78, 0xf6, 0x4b, 0x0f, 0x0b, 0xc2, 0xc3, 0xbd, 0xcb, 0x6e, 0xf1, 0x43, 0xe9, 0xe1,
+	0xdb, 0x6d, 0x74, 0x5c, 0xec, 0x29, 0xed, 0x29, 0x9d, 0x9a, 0x52, 0x51, 0xbe, 0x7f, 0x05, 0xef,
+	0x5f, 0x81, 0xc3, 0x48, 0xc0, 0xb9, 0x8c, 0xf6, 0x9e, 0x9e, 0x45, 0xe1, 0xbc, 0x7c, 0xd9, 0x2d,
+	0xde, 0x1d, 0xe9, 0x7c, 0xd0, 0x48, 0xc7, 0xa5, 0x21, 0xef, 0x38, 0xd4, 0x51, 0xee, 0x0f, 0x00,
+	0x1c, 0xf3, 0x85, 0xe1, 0x77, 0x3c, 0xaf, 0x7d, 0xa6, 0x2d, 0x09, 0x5e, 0xdc, 0xb8, 0x76, 0x2d,
+	0x3b, 0xaf, 0x6a, 0xd9, 0x1e, 0x92, 0x8e, 0xa7, 0x1c, 0xf3, 0xc5, 0x9e, 0xf8, 0x8d, 0x1c, 0xc8,
+	0xf1, 0x60, 0x70, 0x12, 0x31, 0xc4, 0x15, 0xd2, 0x96, 0xdf, 0xae, 0x9c, 0x8c, 0xa3, 0xe9, 0x78,
+	0xe6, 0x90, 0x10, 0x4e, 0x47, 0x98, 0x0f, 0xc3, 0x14, 0x11, 0x0a, 0xb1, 0xab, 0xa0, 0x5d, 0x95,
+	0x22, 0x43, 0x6a, 0x32, 0x45, 0x38, 0x5c, 0xf4, 0x06, 0x6c, 0x41, 0xbe, 0xd5, 0x31, 0x99, 0x65,
+	0x9b, 0x6e, 0xef, 0x35, 0x58, 0x29, 0xa5, 0xe2, 0xf9, 0x3b, 0xa8, 0xa1, 0xe3, 0xb9, 0x70, 0x2a,
+	0x7c, 0x27, 0x0c, 0x58, 0xe9, 0xaf, 0xbe, 0x17, 0x2f, 0xe1, 0xdf, 0xd7, 0x0a, 0x83, 0x77, 0x75,
+	0xa4, 0xaa, 0x8e, 0x97, 0xc2, 0x3d, 0x87, 0x12, 0xb1, 0x5a, 0xff, 0x41, 0xf6, 0xcb, 0x3f, 0x16,
+	0xc7, 0xf4, 0xdf, 0xa7, 0x00, 0xa4, 0xb4, 0x49, 0x99, 0x85, 0x72, 0x90, 0xb6, 0x2d, 0x51, 0x57,
+	0x65, 0x71, 0xda, 0xb6, 0x78, 0x69, 0xc7, 0x61, 0x09, 0x93, 0xe5, 0x13, 0x56, 0x23, 0xf4, 0x10,
+	0x26, 0xd4, 0xcb, 0x91, 0x11, 0x77, 0x73, 0xed, 0x9a, 0xef, 0x06, 0x56, 0xe6, 0xdc, 0xc1, 0x51,
+	0xbf, 0x46, 0xcc, 0x60, 0x35, 0xd2, 0x3d, 0x98, 0xae, 0x08, 0x57, 0x0d, 0xfe, 0x84, 0x20, 0x6d,
+	0xa0, 0xf9, 0xea, 0x37, 0x5a, 0xfd, 0x95, 0xa4, 0xdf, 0x6a, 0x25, 0xfa, 0x3f, 0x32, 0x80, 0x62,
+	0x45, 0x30, 0x77, 0xcc, 0xe3, 0x39, 0xc1, 0x99, 0x99, 0x58, 0x09, 0x3a, 0xa6, 0x9a, 0x1b, 0x60,
+	0x65, 0x8d, 0x9e, 0x01, 0x44, 0xd8, 0x7f, 0x52, 0xb0, 0xff, 0x27, 0xd7, 0x61, 0x7f, 0xb1, 0x1e,
+	0x55, 0x0f, 0x45, 0xa0, 0x90, 0x11, 0x6d, 0x38, 0x4e, 0x64, 0xf5, 0xa4, 0x8d, 0xbf, 0xf9, 0x75,
+	0x09, 0xf5, 0x55, 0xb1, 0x15, 0x85, 0xce, 0x5b, 0x03, 0x42, 0xf4, 0x53, 0x98, 0x89, 0xd6, 0x38,
+	0xda, 0x44, 0xa2, 0x73, 0x98, 0x8e, 0xd4, 0x42, 0xbc, 0x1d, 0x1d, 0xe8, 0x1b, 0x6f, 0x24, 0x02,
+	0x8d, 0xb7, 0x87, 0x8f, 0xb3, 0x93, 0xe9, 0x7c, 0xe6, 0x71, 0x76, 0x32, 0x93, 0xcf, 0x3e, 0xce,
+	0x4e, 0x66, 0xf3, 0xe3, 0xfa, 0x0b, 0x28, 0x8c, 0x3e, 0xcc, 0x2b, 0x9b, 0xa6, 0xad, 0x48, 0x3e,
+	0x25, 0x8a, 0xb7, 0x4a, 0xa7, 0x33, 0x58, 0xbc, 0xf2, 0x98, 0xdf, 0x90, 0xca, 0xdf, 0x97, 0xeb,
+	0xbf, 0xa5, 0x20, 0xa7, 0x5c, 0xaa, 0x9a, 0xf1, 0x0d, 0x4e, 0x9f, 0xc0, 0x54, 0xb3, 0x6d, 0xda,
+	0x8e, 0x79, 0xd0, 0x26, 0x09, 0xfd, 0xf6, 0x01, 0x78, 0x1f, 0x2d, 0x06, 0xc4, 0xd2, 0x32, 0x89,
+	0xb0, 0x42, 0x73, 0xfd, 0x4f, 0x29, 0x58, 0xee, 0x6d, 0x42, 0x16, 0xf9, 0x98, 0x06, 0xb2, 0xae,
+	0x1a, 0x64, 0x29, 0x04, 0x59, 0xbf, 0x4d, 0xe5, 0xb1, 0x65, 0xb1, 0xf8, 0x8d, 0x3e, 0x84, 0xbc,
+	0xc7, 0xc8, 0x89, 0x4d, 0x3b, 0x7e, 0x8f, 0x87, 0x65, 0x7b, 0x3a, 0x17, 0xce, 0x87, 0x54, 0x5b,
+	0x84, 0x69, 0x97, 0x9c, 0xf6, 0xb4, 0x44, 0x27, 0x86, 0xc1, 0x25, 0xa7, 0xa1, 0x42, 0x9f, 0xa4,
+	0xc6, 0x63, 0x24, 0xf5, 0xf7, 0x34, 0xa0, 0xaa, 0x63, 0xfb, 0xbe, 0x4d, 0xdd, 0x8d, 0x23, 0xd2,
+	0x3c, 0xf6, 0xa8, 0x1d, 0xe3, 0xb4, 0x54, 0x54, 0x3d, 0x42, 0x25, 0xe9, 0xb7, 0xa2, 0x92, 0xab,
+	0xbe, 0xda, 0x65, 0xbe, 0x97, 0xaf, 0x76, 0xbc, 0x9f, 0x69, 0x9b, 0x7e, 0x60, 0xf4, 0x2a, 0x5a,
+	0xd5, 0x89, 0x48, 0x72, 0x46, 0x5c, 0xd6, 0x2b, 0xc9, 0x65, 0xdf, 0x21, 0x22, 0x6e, 0x7b, 0x1e,
+	0xb1, 0xb4, 0xf1, 0x44, 0xbb, 0x0a, 0xcd, 0xf5, 0xcf, 0x61, 0x6e, 0x8b, 0x90, 0x9a, 0x7b, 0xd8,
+	0xa6, 0xa7, 0xb2, 0xea, 0x42, 0x9b, 0x30, 0x2e, 0x5a, 0x88, 0x84, 0xdc, 0x2b, 0x8d, 0xd1, 0x7b,
+	0x30, 0x23, 0xbb, 0x2a, 0x15, 0x15, 0xf9, 0xe1, 0x70, 0x5a, 0xcc, 0x3d, 0x92, 0x91, 0xfc, 0x4d,
+	0x16, 0xe6, 0x6b, 0x83, 0x25, 0xd0, 0xc8, 0x40, 0x7e, 0x0a, 0xd9, 0xc0, 0x76, 0xe4, 0x75, 0x99,
+	0xbe, 0x5f, 0x18, 0xea, 0x23, 0x1a, 0xe1, 0xb7, 0xda, 0xca, 0x24, 0x5f, 0xf1, 0x17, 0xbc, 0x49,
+	0x10, 0x16, 0xff, 0xcf, 0xd0, 0x71, 0x9a, 0x16, 0x3d, 0x98, 0x2a, 0xd1, 0xb2, 0x09, 0x69, 0x9a,
+	0x63, 0xa8, 0x7a, 0x6c, 0x0f, 0x66, 0x0f, 0xa8, 0x6b, 0x11, 0xcb, 0x08, 0xe8, 0x31, 0x71, 0xfd,
+	0x84, 0x11, 0x9e, 0x91, 0x20, 0x0d, 0x81, 0xc1, 0x09, 0xa7, 0x57, 0x6c, 0x6a, 0x13, 0x89, 0xf6,
+	0xde, 0x07, 0x40, 0xbb, 0x30, 0xad, 0xbe, 0xbc, 0xf1, 0x1a, 0x5e, 0xbb, 0x91, 0x08, 0x0f, 0x14,
+	0xc4, 0xba, 0xc7, 0xf4, 0xff, 0xa4, 0x60, 0x66, 0x2b, 0x52, 0xd6, 0x0d, 0x65, 0x4f, 0x6a, 0x28,
+	0x7b, 0xf8, 0x57, 0x29, 0xe2, 0x5a, 0xf1, 0xf4, 0x9a, 0x22, 0xae, 0xa5, 0xc4, 0x9c, 0x62, 0x69,
+	0xbb, 0x4d, 0x9a, 0x41, 0x62, 0x5a, 0xec, 0x03, 0xf0, 0x3b, 0x21, 0xba, 0xec, 0x84, 0x01, 0x96,
+	0xc6, 0xfa, 0x1f, 0xd2, 0x30, 0xb3, 0x2d, 0xbf, 0x2b, 0xd4, 0xcd, 0x8e, 0x2f, 0x3e, 0xe2, 0x79,
+	0xfc, 0x87, 0xe4, 0xd5, 0x49, 0xac, 0x46, 0xe8, 0x7d, 0x98, 0x95, 0xbf, 0xe2, 0xdb, 0x9b, 0x91,
+	0x93, 0x6a, 0x87, 0x55, 0x98, 0x56, 0x4a, 0xe2, 0x5e, 0x64, 0xae, 0x71, 0x2f, 0x40, 0x1a, 0x72,
+	0x11, 0xff, 0xd0, 0x2f, 0x53, 0x58, 0x81, 0xa9, 0x72, 0x5c, 0x92, 0xcf, 0xbc, 0x10, 0x89, 0xc5,
+	0x5a, 0xaa, 0xd6, 0x7e, 0x06, 0x8b, 0x31, 0xfd, 0x5e, 0x83, 0x3f, 0xfe, 0x6d, 0x0d, 0xbe, 0xf0,
+	0x2f, 0x9a, 0xf7, 0x9b, 0x11, 0xd8, 0x50, 0xcc, 0x1f, 0x9f, 0x29, 0x7e, 0x3a, 0x5b, 0x66, 0xa7,
+	0x3d, 0x9a, 0x06, 0x44, 0x71, 0xdc, 0x3c, 0x26, 0x41, 0xbf, 0x38, 0xe6, 0x23, 0xb4, 0x00, 0xe3,
+	0x84, 0x31, 0xca, 0xd4, 0x7b, 0x23, 0x07, 0x68, 0x87, 0x17, 0x80, 0x96, 0xcd, 0x64, 0x1a, 0x24,
+	0x0b, 0x5e, 0x04, 0xe1, 0xee, 0x19, 0xcc, 0xc6, 0xbe, 0x8e, 0xa0, 0xfb, 0xb0, 0x88, 0xab, 0x9b,
+	0xfb, 0x1b, 0x8d, 0xda, 0xee, 0x8e, 0xb1, 0xbd, 0xbb, 0x59, 0x35, 0x2a, 0x4f, 0x76, 0x37, 0x7e,
+	0xb2, 0x97, 0x1f, 0x2b, 0x2c, 0x9f, 0x5f, 0x94, 0x6e, 0xc6, 0xb4, 0xd5, 0x09, 0x96, 0xe1, 0xe6,
+	0x80, 0x4d, 0xa3, 0xb6, 0x5d, 0xcd, 0xa7, 0x0a, 0x8b, 0xe7, 0x17, 0xa5, 0xf9, 0x98, 0x05, 0x8f,
+	0x50, 0x21, 0xfb, 0xdb, 0x3f, 0xaf, 0x8e, 0xdd, 0x7d, 0x99, 0x86, 0xa5, 0xab, 0xbf, 0x17, 0xa3,
+	0x0d, 0x28, 0x6d, 0xd6, 0xf6, 0x1a, 0xb8, 0x56, 0xd9, 0x17, 0x98, 0x8d, 0x75, 0xfc, 0xb0, 0xda,
+	0x30, 0x1a, 0xcf, 0xeb, 0x55, 0x63, 0x7d, 0x63, 0x63, 0x77, 0x7f, 0xa7, 0x91, 0x1f, 0x2b, 0xbc,
+	0x7b, 0x7e, 0x51, 0x5a, 0x19, 0x46, 0x58, 0x6f, 0x36, 0x45, 0x53, 0xb0, 0x0e, 0xc5, 0x91, 0x20,
+	0xdb, 0xbb, 0x9b, 0xfb, 0x4f, 0xf8, 0x0a, 0xdf, 0x39, 0xbf, 0x28, 0x69, 0xc3, 0x18, 0xdb, 0xd4,
+	0xea, 0xb4, 0x09, 0xaa, 0xc3, 0x07, 0x23, 0x21, 0x36, 0x76, 0xb7, 0xb7, 0xf7, 0x77, 0x6a, 0x8d,
+	0xe7, 0x46, 0x7d, 0x77, 0xf7, 0x49, 0x3e, 0x5d, 0x78, 0xff, 0xfc, 0xa2, 0x54, 0x1c, 0x86, 0xda,
+	0x88, 0xfd, 0xef, 0xe1, 0x29, 0xdc, 0x1d, 0x89, 0xb8, 0x59, 0x7d, 0x5a, 0x7d, 0xb2, 0x5b, 0xaf,
+	0x62, 0xe3, 0x69, 0x75, 0xaf, 0x51, 0xdb, 0x79, 0x98, 0xcf, 0x14, 0x6e, 0x9f, 0x5f, 0x94, 0xf4,
+	0x61, 0xd0, 0xc1, 0xa2, 0x50, 0x1e, 0x69, 0xa5, 0xf6, 0xb3, 0xb5, 0x48, 0x0e, 0x34, 0xaa, 0xb8,
+	0xd6, 0xd8, 0xc5, 0xb5, 0xb5, 0xb0, 0x7e, 0xbf, 0xd7, 0x3c, 0x32, 0x6d, 0x77, 0xed, 0x85, 0xfc,
+	0xe7, 0xa2, 0x48, 0x88, 0xaf, 0x5e, 0xad, 0xa6, 0x5e, 0xbe, 0x5a, 0x4d, 0x7d, 0xf3, 0x6a, 0x35,
+	0xf5, 0xc5, 0xeb, 0xd5, 0xb1, 0x97, 0xaf, 0x57, 0xc7, 0xfe, 0xf9, 0x7a, 0x75, 0xec, 0x60, 0x42,
+	0xa4, 0xfb, 0x0f, 0xff, 0x37, 0x00, 0x4d, 0x70, 0xab, 0x84, 0x92, 0x1c, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamVestingMonthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamVestingMonthInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamVestingMonthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OneMonthPeriodInBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.OneMonthPeriodInBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MonthStartedBlock != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MonthStartedBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MonthsSinceGenesis != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MonthsSinceGenesis))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MonthlyVestingAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonthlyVestingAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonthlyVestingAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MonthlyAmounts) > 0 {
+		for iNdEx := len(m.MonthlyAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MonthlyAmounts[iNdEx].Size()
+				i -= size
+				if _, err := m.MonthlyAmounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeveloperRewards.Size()
+		i -= size
+		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UsageIncentive.Size()
+		i -= size
+		if _, err := m.UsageIncentive.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GrantsProgram.Size()
+		i -= size
+		if _, err := m.GrantsProgram.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.TargetType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeBurnRetentionEpochs != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FeeBurnRetentionEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.GuardianAddress) > 0 {
+		i -= len(m.GuardianAddress)
+		copy(dAtA[i:], m.GuardianAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.GuardianAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.FeeBurnEpochBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FeeBurnEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.FeeBurnRatio.Size()
+		i -= size
+		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.InflationSnapshotRetentionBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSnapshotRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.InflationSnapshotIntervalBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSnapshotIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.FeeAprWindowBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.FeeAprWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.LockedAddresses) > 0 {
+		for iNdEx := len(m.LockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedAddresses[iNdEx])
+			copy(dAtA[i:], m.LockedAddresses[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.LockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.HookContracts) > 0 {
+		for iNdEx := len(m.HookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HookContracts[iNdEx])
+			copy(dAtA[i:], m.HookContracts[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.HookContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.DistributionEpochBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.DistributionEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.DistributionRecipients) > 0 {
+		for iNdEx := len(m.DistributionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReductionPeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReductionPeriodDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	if m.ReductionMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionMode))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TotalBurntAmount) > 0 {
+		for iNdEx := len(m.TotalBurntAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TotalBurntAmount[iNdEx].Size()
+				i -= size
+				if _, err := m.TotalBurntAmount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MintingRewardsDistributionStartBlock != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TeamReserveAddress) > 0 {
+		i -= len(m.TeamReserveAddress)
+		copy(dAtA[i:], m.TeamReserveAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.TeamReserveAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.GrantsProgramAddress) > 0 {
+		i -= len(m.GrantsProgramAddress)
+		copy(dAtA[i:], m.GrantsProgramAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.GrantsProgramAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UsageIncentiveAddress) > 0 {
+		i -= len(m.UsageIncentiveAddress)
+		copy(dAtA[i:], m.UsageIncentiveAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.UsageIncentiveAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReductionFactor.Size()
+		i -= size
+		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReductionPeriodInBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ReductionPeriodInBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GenesisBlockProvisions.Size()
+		i -= size
+		if _, err := m.GenesisBlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnerTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnerTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnerTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TeamReserve.Size()
+		i -= size
+		if _, err := m.TeamReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DeveloperVesting) > 0 {
+		for iNdEx := len(m.DeveloperVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecipientTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecipientTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecipientTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeveloperVestingTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperVestingTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperVestingTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingReceiverRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingReceiverRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingReceiverRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousAddress) > 0 {
+		i -= len(m.PreviousAddress)
+		copy(dAtA[i:], m.PreviousAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.PreviousAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Clipped.Size()
+		i -= size
+		if _, err := m.Clipped.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LastReductionBlock != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.LastReductionBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeInflowWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeInflowWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeInflowWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StakingApr.Size()
+		i -= size
+		if _, err := m.StakingApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeBurnEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBurnEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBurnEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Collected.Size()
+		i -= size
+		if _, err := m.Collected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintingPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintingPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintingPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TotalPausedDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalPausedDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalPausedBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.TotalPausedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMint(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.PausedHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Redirected.Size()
+		i -= size
+		if _, err := m.Redirected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bucket) > 0 {
+		i -= len(m.Bucket)
+		copy(dAtA[i:], m.Bucket)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Bucket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *TeamVestingMonthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MonthsSinceGenesis != 0 {
+		n += 1 + sovMint(uint64(m.MonthsSinceGenesis))
+	}
+	if m.MonthStartedBlock != 0 {
+		n += 1 + sovMint(uint64(m.MonthStartedBlock))
+	}
+	if m.OneMonthPeriodInBlocks != 0 {
+		n += 1 + sovMint(uint64(m.OneMonthPeriodInBlocks))
+	}
+	return n
+}
+
+func (m *MonthlyVestingAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.MonthlyAmounts) > 0 {
+		for _, e := range m.MonthlyAmounts {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GrantsProgram.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.UsageIncentive.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DeveloperRewards.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.TargetType != 0 {
+		n += 1 + sovMint(uint64(m.TargetType))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.GenesisBlockProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ReductionPeriodInBlocks != 0 {
+		n += 1 + sovMint(uint64(m.ReductionPeriodInBlocks))
+	}
+	l = m.ReductionFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for _, e := range m.WeightedDeveloperRewardsReceivers {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = len(m.UsageIncentiveAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.GrantsProgramAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.TeamReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.MintingRewardsDistributionStartBlock != 0 {
+		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartBlock))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if len(m.TotalBurntAmount) > 0 {
+		for _, e := range m.TotalBurntAmount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.ReductionMode != 0 {
+		n += 1 + sovMint(uint64(m.ReductionMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReductionPeriodDuration)
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DistributionRecipients) > 0 {
+		for _, e := range m.DistributionRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.DistributionEpochBlocks != 0 {
+		n += 2 + sovMint(uint64(m.DistributionEpochBlocks))
+	}
+	if len(m.HookContracts) > 0 {
+		for _, s := range m.HookContracts {
+			l = len(s)
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.LockedAddresses) > 0 {
+		for _, s := range m.LockedAddresses {
+			l = len(s)
+			n += 2 + l + sovMint(uint64(l))
+		}
+	}
+	if m.FeeAprWindowBlocks != 0 {
+		n += 2 + sovMint(uint64(m.FeeAprWindowBlocks))
+	}
+	if m.InflationSnapshotIntervalBlocks != 0 {
+		n += 2 + sovMint(uint64(m.InflationSnapshotIntervalBlocks))
+	}
+	if m.InflationSnapshotRetentionBlocks != 0 {
+		n += 2 + sovMint(uint64(m.InflationSnapshotRetentionBlocks))
+	}
+	l = m.MaxSupply.Size()
+	n += 2 + l + sovMint(uint64(l))
+	l = m.FeeBurnRatio.Size()
+	n += 2 + l + sovMint(uint64(l))
+	if m.FeeBurnEpochBlocks != 0 {
+		n += 2 + sovMint(uint64(m.FeeBurnEpochBlocks))
+	}
+	l = len(m.GuardianAddress)
+	if l > 0 {
+		n += 2 + l + sovMint(uint64(l))
+	}
+	if m.FeeBurnRetentionEpochs != 0 {
+		n += 2 + sovMint(uint64(m.FeeBurnRetentionEpochs))
+	}
+	return n
+}
+
+func (m *BurnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMint(uint64(m.Id))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BurnerTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DeveloperVesting) > 0 {
+		for _, e := range m.DeveloperVesting {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.TeamReserve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionRecipientTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DeveloperVestingTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *VestingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Claimable.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *VestingReceiverRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMint(uint64(m.Id))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovMint(uint64(m.Slot))
+	}
+	l = len(m.PreviousAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EmissionCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.LastReductionBlock != 0 {
+		n += 1 + sovMint(uint64(m.LastReductionBlock))
+	}
+	l = m.Clipped.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *FeeInflowWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *InflationSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.StakingApr.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *FeeBurnEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	l = m.Collected.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Burnt.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintingPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PausedHeight != 0 {
+		n += 1 + sovMint(uint64(m.PausedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.TotalPausedBlocks != 0 {
+		n += 1 + sovMint(uint64(m.TotalPausedBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TotalPausedDuration)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintFault) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Redirected.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamVestingMonthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamVestingMonthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsSinceGenesis", wireType)
+			}
+			m.MonthsSinceGenesis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsSinceGenesis |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthStartedBlock", wireType)
+			}
+			m.MonthStartedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthStartedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthPeriodInBlocks", wireType)
+			}
+			m.OneMonthPeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OneMonthPeriodInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonthlyVestingAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonthlyVestingAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonthlyVestingAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyAmounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MonthlyAmounts = append(m.MonthlyAmounts, v)
+			if err := m.MonthlyAmounts[len(m.MonthlyAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsProgram", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GrantsProgram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsageIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
+			}
+			m.TargetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetType |= DistributionTargetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisBlockProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisBlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInBlocks", wireType)
+			}
+			m.ReductionPeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionPeriodInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, MonthlyVestingAddress{})
+			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentiveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageIncentiveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantsProgramAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantsProgramAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartBlock", wireType)
+			}
+			m.MintingRewardsDistributionStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintingRewardsDistributionStartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurntAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.TotalBurntAmount = append(m.TotalBurntAmount, v)
+			if err := m.TotalBurntAmount[len(m.TotalBurntAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionMode", wireType)
+			}
+			m.ReductionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReductionMode |= ReductionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ReductionPeriodDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecipients = append(m.DistributionRecipients, DistributionRecipient{})
+			if err := m.DistributionRecipients[len(m.DistributionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEpochBlocks", wireType)
+			}
+			m.DistributionEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookContracts = append(m.HookContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAddresses = append(m.LockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAprWindowBlocks", wireType)
+			}
+			m.FeeAprWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeAprWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSnapshotIntervalBlocks", wireType)
+			}
+			m.InflationSnapshotIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSnapshotIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSnapshotRetentionBlocks", wireType)
+			}
+			m.InflationSnapshotRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSnapshotRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnEpochBlocks", wireType)
+			}
+			m.FeeBurnEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBurnEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRetentionEpochs", wireType)
+			}
+			m.FeeBurnRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBurnRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnerTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnerTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnerTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperVesting = append(m.DeveloperVesting, DeveloperVestingTotal{})
+			if err := m.DeveloperVesting[len(m.DeveloperVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TeamReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, DistributionRecipientTotal{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecipientTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecipientTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecipientTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeveloperVestingTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperVestingTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperVestingTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.GenesisBlockProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.ReductionPeriodInBlocks != 0 {
-		n += 1 + sovMint(uint64(m.ReductionPeriodInBlocks))
+	return nil
+}
+func (m *VestingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.ReductionFactor.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
-		for _, e := range m.WeightedDeveloperRewardsReceivers {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingReceiverRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingReceiverRotation: wiretype end group for non-group")
 		}
-	}
-	l = len(m.UsageIncentiveAddress)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	l = len(m.GrantsProgramAddress)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	l = len(m.TeamReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	if m.MintingRewardsDistributionStartBlock != 0 {
-		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartBlock))
-	}
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
-	}
-	if len(m.TotalBurntAmount) > 0 {
-		for _, e := range m.TotalBurntAmount {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingReceiverRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *EmissionCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -885,19 +5790,125 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReductionBlock", wireType)
+			}
+			m.LastReductionBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReductionBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clipped", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -925,7 +5936,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clipped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -950,7 +5961,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
+func (m *FeeInflowWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -973,17 +5984,17 @@ func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeamVestingMonthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeInflowWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeamVestingMonthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeInflowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthsSinceGenesis", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.MonthsSinceGenesis = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -993,35 +6004,31 @@ func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthsSinceGenesis |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthStartedBlock", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
 			}
-			m.MonthStartedBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonthStartedBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthPeriodInBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.OneMonthPeriodInBlocks = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1031,7 +6038,7 @@ func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OneMonthPeriodInBlocks |= int64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1057,7 +6064,7 @@ func (m *TeamVestingMonthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonthlyVestingAddress) Unmarshal(dAtA []byte) error {
+func (m *InflationSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,15 +6087,67 @@ func (m *MonthlyVestingAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonthlyVestingAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonthlyVestingAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,11 +6175,13 @@ func (m *MonthlyVestingAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1148,65 +6209,47 @@ func (m *MonthlyVestingAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MonthlyAmounts = append(m.MonthlyAmounts, v)
-			if err := m.MonthlyAmounts[len(m.MonthlyAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMint(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMint
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMint
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrantsProgram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1234,13 +6277,13 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GrantsProgram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingApr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1268,15 +6311,65 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentive", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBurnEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBurnEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBurnEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1286,29 +6379,33 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if err := m.UsageIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1336,13 +6433,13 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1370,7 +6467,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeveloperRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1395,7 +6492,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MintingPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1418,17 +6515,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintingPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintingPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1438,63 +6535,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisBlockProvisions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GenesisBlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionPeriodInBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
 			}
-			m.ReductionPeriodInBlocks = 0
+			m.PausedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1504,16 +6555,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReductionPeriodInBlocks |= int64(b&0x7F) << shift
+				m.PausedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1523,31 +6574,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPausedBlocks", wireType)
 			}
-			var msglen int
+			m.TotalPausedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1557,28 +6607,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalPausedBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeightedDeveloperRewardsReceivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPausedDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1605,16 +6641,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WeightedDeveloperRewardsReceivers = append(m.WeightedDeveloperRewardsReceivers, MonthlyVestingAddress{})
-			if err := m.WeightedDeveloperRewardsReceivers[len(m.WeightedDeveloperRewardsReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TotalPausedDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentiveAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintFault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintFault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintFault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -1624,27 +6709,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UsageIncentiveAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrantsProgramAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1672,11 +6744,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GrantsProgramAddress = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1704,49 +6776,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeamReserveAddress = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingRewardsDistributionStartBlock", wireType)
-			}
-			m.MintingRewardsDistributionStartBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MintingRewardsDistributionStartBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
-			}
-			m.BlocksPerYear = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurntAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redirected", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1774,9 +6808,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Coin
-			m.TotalBurntAmount = append(m.TotalBurntAmount, v)
-			if err := m.TotalBurntAmount[len(m.TotalBurntAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redirected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -52,3 +53,27 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.BlockProvisions
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// MaxScaledBlockTimeFactor bounds the time a single block provisions are
+// scaled by, as a multiple of the nominal block time, so that a chain halt
+// does not mint the emission of the whole halt in one block.
+const MaxScaledBlockTimeFactor = 10
+
+// ScaledBlockProvision returns the provisions for a block in time mode. The
+// block provisions are scaled by the time elapsed since the previous block
+// relative to the nominal block time of a reduction period, so that a period
+// emits the same amount whatever the actual block time is.
+func (m Minter) ScaledBlockProvision(params Params, elapsed time.Duration) sdk.Coin {
+	if elapsed <= 0 {
+		return sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	}
+	if maxElapsed := MaxScaledBlockTimeFactor * params.NominalBlockTime(); elapsed > maxElapsed {
+		elapsed = maxElapsed
+	}
+
+	provisionAmt := m.BlockProvisions.
+		MulInt64(int64(elapsed)).
+		MulInt64(params.ReductionPeriodInBlocks).
+		QuoInt64(int64(params.ReductionPeriodDuration))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
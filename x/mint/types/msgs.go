@@ -1,7 +1,9 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ sdk.Msg = &MsgBurnTokens{}
@@ -31,6 +33,14 @@ func (m *MsgBurnTokens) ValidateBasic() error {
 		return ErrEmptyAddress
 	}
 
+	amount := sdk.Coins(m.Amount)
+	if err := amount.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if amount.Empty() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "burn amount cannot be empty")
+	}
+
 	return nil
 }
 
@@ -48,3 +58,198 @@ func (m *MsgBurnTokens) GetSigners() []sdk.AccAddress {
 		sender,
 	}
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+var MsgTypeUpdateParams = "update_params"
+
+func NewMsgUpdateParams(
+	authority string,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m *MsgUpdateParams) Route() string {
+	return ModuleName
+}
+
+func (m *MsgUpdateParams) Type() string {
+	return MsgTypeUpdateParams
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		authority,
+	}
+}
+
+var _ sdk.Msg = &MsgClaimVestedRewards{}
+
+var MsgTypeClaimVestedRewards = "claim_vested_rewards"
+
+func NewMsgClaimVestedRewards(
+	receiver string,
+) *MsgClaimVestedRewards {
+	return &MsgClaimVestedRewards{
+		Receiver: receiver,
+	}
+}
+
+func (m *MsgClaimVestedRewards) Route() string {
+	return ModuleName
+}
+
+func (m *MsgClaimVestedRewards) Type() string {
+	return MsgTypeClaimVestedRewards
+}
+
+func (m *MsgClaimVestedRewards) ValidateBasic() error {
+	if m.Receiver == "" {
+		return ErrEmptyAddress
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return errors.Wrap(err, "invalid receiver address")
+	}
+
+	return nil
+}
+
+func (m *MsgClaimVestedRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgClaimVestedRewards) GetSigners() []sdk.AccAddress {
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		receiver,
+	}
+}
+
+var _ sdk.Msg = &MsgRotateVestingReceiver{}
+
+var MsgTypeRotateVestingReceiver = "rotate_vesting_receiver"
+
+func NewMsgRotateVestingReceiver(
+	receiver string,
+	newReceiver string,
+) *MsgRotateVestingReceiver {
+	return &MsgRotateVestingReceiver{
+		Receiver:    receiver,
+		NewReceiver: newReceiver,
+	}
+}
+
+func (m *MsgRotateVestingReceiver) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRotateVestingReceiver) Type() string {
+	return MsgTypeRotateVestingReceiver
+}
+
+func (m *MsgRotateVestingReceiver) ValidateBasic() error {
+	if m.Receiver == "" || m.NewReceiver == "" {
+		return ErrEmptyAddress
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return errors.Wrap(err, "invalid receiver address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewReceiver); err != nil {
+		return errors.Wrap(err, "invalid new receiver address")
+	}
+	if m.Receiver == m.NewReceiver {
+		return errors.Wrap(ErrInvalidVestingReceiver, "new receiver must differ from the receiver")
+	}
+
+	return nil
+}
+
+func (m *MsgRotateVestingReceiver) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRotateVestingReceiver) GetSigners() []sdk.AccAddress {
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		receiver,
+	}
+}
+
+var _ sdk.Msg = &MsgSetMintingPaused{}
+
+var MsgTypeSetMintingPaused = "set_minting_paused"
+
+func NewMsgSetMintingPaused(
+	authority string,
+	paused bool,
+	extendSchedule bool,
+) *MsgSetMintingPaused {
+	return &MsgSetMintingPaused{
+		Authority:      authority,
+		Paused:         paused,
+		ExtendSchedule: extendSchedule,
+	}
+}
+
+func (m *MsgSetMintingPaused) Route() string {
+	return ModuleName
+}
+
+func (m *MsgSetMintingPaused) Type() string {
+	return MsgTypeSetMintingPaused
+}
+
+func (m *MsgSetMintingPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if m.Paused && m.ExtendSchedule {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "the schedule can only be extended on resume")
+	}
+
+	return nil
+}
+
+func (m *MsgSetMintingPaused) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetMintingPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		authority,
+	}
+}
@@ -1,43 +1,21 @@
 package types
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
-	"cosmossdk.io/math"
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// Parameter store keys.
-var (
-	KeyMintDenom                            = []byte("MintDenom")
-	KeyGenesisBlockProvisions               = []byte("GenesisBlockProvisions")
-	KeyReductionPeriodInBlocks              = []byte("ReductionPeriodInBlocks")
-	KeyReductionFactor                      = []byte("ReductionFactor")
-	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
-	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
-	KeyMintingRewardsDistributionStartBlock = []byte("MintingRewardsDistributionStartBlock")
-	KeyUsageIncentiveAddress                = []byte("UsageIncentiveAddress")
-	KeyGrantsProgramAddress                 = []byte("GrantsProgramAddress")
-	KeyTeamReserveAddress                   = []byte("TeamReserveAddress")
-	KeyBlocksPerYear                        = []byte("BlocksPerYear")
-	KeyTotalBurntAmount                     = []byte("TotalBurntAmount")
-)
-
-// ParamTable for minting module.
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
-}
-
 // NewParams returns new mint module parameters initialized to the given values.
 func NewParams(
 	mintDenom string, genesisBlockProvisions sdk.Dec,
-	ReductionFactor sdk.Dec, reductionPeriodInBlocks int64, distrProportions DistributionProportions,
+	ReductionFactor sdk.Dec, reductionPeriodInBlocks int64, distrRecipients []DistributionRecipient,
 	weightedDevRewardsReceivers []MonthlyVestingAddress, MintingRewardsDistributionStartBlock int64,
 	blocksPerYear uint64, totalBurntAmount sdk.Coins,
 ) Params {
@@ -46,80 +24,33 @@ func NewParams(
 		GenesisBlockProvisions:               genesisBlockProvisions,
 		ReductionPeriodInBlocks:              reductionPeriodInBlocks,
 		ReductionFactor:                      ReductionFactor,
-		DistributionProportions:              distrProportions,
+		DistributionProportions:              zeroDistributionProportions(),
+		DistributionRecipients:               distrRecipients,
 		WeightedDeveloperRewardsReceivers:    weightedDevRewardsReceivers,
 		MintingRewardsDistributionStartBlock: MintingRewardsDistributionStartBlock,
 		BlocksPerYear:                        blocksPerYear,
 		TotalBurntAmount:                     totalBurntAmount,
+		MaxSupply:                            sdk.ZeroInt(),
+		FeeBurnRatio:                         sdk.ZeroDec(),
 	}
 }
 
-func addressTable() map[string]string {
-	addressJSON := `{
-		"adress1": "tori1zyakv8ny9p5esrpv3rgls707rd9anjzla2q7vj",
-		"adress2": "tori10rp3k6jh8nxmrvdxaf6vwcv6z0ad6p7azkv690",
-		"adress3": "tori16n36a4xryrcaf4vtk9nuqq0lzrs3qkmjxvvuaf",
-		"adress4": "tori1s8qa7466v6pnc7mqhntnzx0kukf3nl52ks9eyl",
-		"adress5": "tori1xwmdtmhmtx0vsz6vd6yjn6z26rwj6c59cz0vfj",
-		"adress6": "tori1g7ryul6kv8wv7p032c3shede4yps74h0qlq0a0",
-		"adress7": "tori184vpdnt4pzkz70ery009l9ac5p8sel7swjewpz",
-		"adress8": "tori17nqxtdm7nrj0ne0jumkkmsjghxytdv8lqtyuss",
-		"adress9": "tori1843zuxx87tfy0rxlfv4ulgvxqt5kk3jjkgln27",
-		"adress10": "tori1f9dkjdelh3nnmpkahztdxpt2vas5a8jxcjl3p8",
-		"adress11": "tori1hv4wp790e47y4aw2rrk4s0e35ta4nfrzmcgxtl",
-		"adress12": "tori10tm5wcdkvvzyhmjd44aeg4r7zlfpwyufnqfemd",
-		"adress13": "tori15vc2563rxqzulsjzt89ugyqae063ezrftj4kay",
-		"adress14": "tori1v47dvyflzgatgdul52vgxy6fv8rlgmtw3snrvu",
-		"adress15": "tori1wue905yydrxfysqq6ewgpsx0zdsdspn0szuv9f",
-		"adress16": "tori1mq05ml4zmg4eus96k72re3n06ghuz0txvg5zpd",
-		"adress17": "tori1negrycg7hsaumjedue8my9xhr688guav8e7k52",
-		"adress18": "tori1pv6n9f8eml89rmzxnuzz70936hm60a3970ks84",
-		"adress19": "tori1nm50zycnm9yf33rv8n6lpks24usxzahk4s7muh",
-		"adress20": "tori1znhgcje2np5v34nk7j7t4jes4f8mu6al9t4f6r",
-		"adress21": "tori1uwr8dn8h3qsrwt2pew57r577qhzk9w5w2nmcfm",
-		"adress22": "tori18cgtgz6q7ly4suukk744cjep4uxhm5z3artxft",
-		"adress23": "tori1jtz7h88hzufhwz4pnwaagv6j7czddcz65fvtq2",
-		"adress24": "tori12dgvzxvd339paqvu83vx9wq36j0w3zyxsy3uar",
-		"adress25": "tori1nra74gcsqy88m9xe5r6jpgyfr6w7zj390ek3w8",
-		"adress26": "tori1shfq05pu5x8lwm4rng44v7qt888hg78wf4g97x",
-		"adress27": "tori1l3ggmanvvmm3ph66tw04gdpyd0qwm7pkjrjvjr",
-		"adress28": "tori10y7y3rrmawsfx7n57qxjst6gd6zreuqpplccwq"
-	}`
-
-	var addressMap map[string]string
-	err := json.Unmarshal([]byte(addressJSON), &addressMap)
-	if err != nil {
-		panic(err)
-	}
-	return addressMap
-}
-
-func parseMonthlyVesting() []MonthlyVestingAddress {
-	records := [][]string{}
-	lines := strings.Split(vestingStr, "\n")
-	for _, line := range lines {
-		records = append(records, strings.Split(line, ","))
-	}
-
-	addressMap := addressTable()
-	vAddrs := []MonthlyVestingAddress{}
-	for _, addr := range records[0] {
-		vAddrs = append(vAddrs, MonthlyVestingAddress{
-			Address:        addressMap[addr],
-			MonthlyAmounts: []math.Int{},
-		})
-	}
+// Names of the distribution recipients of the historical distribution buckets.
+const (
+	RecipientGrantsProgram    = "grants_program"
+	RecipientUsageIncentive   = "usage_incentive"
+	RecipientStaking          = "staking"
+	RecipientDeveloperRewards = "developer_rewards"
+	RecipientCommunityPool    = "community_pool"
+)
 
-	for _, line := range records[1:] {
-		for index, amountStr := range line {
-			amountDec := sdk.MustNewDecFromStr(amountStr)
-			amountInt := amountDec.Mul(sdk.NewDec(1000_000)).TruncateInt()
-			vAddrs[index].MonthlyAmounts = append(vAddrs[index].MonthlyAmounts, amountInt)
-		}
-	}
+// DefaultReductionPeriodDuration is the default length of a reduction period
+// in time mode, matching the default reduction period in blocks.
+const DefaultReductionPeriodDuration = 365 * 24 * time.Hour
 
-	return vAddrs
-}
+// MinReductionPeriodDuration is the shortest reduction period allowed in time
+// mode.
+const MinReductionPeriodDuration = time.Hour
 
 // DefaultParams returns the default minting module parameters.
 func DefaultParams() Params {
@@ -128,20 +59,46 @@ func DefaultParams() Params {
 		GenesisBlockProvisions:  sdk.NewDec(47000000),        //  300 million /  6307200 * 10 ^ 6
 		ReductionPeriodInBlocks: 6307200,                     // 1 year - 86400 x 365 / 5
 		ReductionFactor:         sdk.NewDecWithPrec(6666, 4), // 0.6666
-		DistributionProportions: DistributionProportions{
-			GrantsProgram:    sdk.NewDecWithPrec(10, 2), // 10%
-			CommunityPool:    sdk.NewDecWithPrec(10, 2), // 10%
-			UsageIncentive:   sdk.NewDecWithPrec(25, 2), // 25%
-			Staking:          sdk.NewDecWithPrec(40, 2), // 40%
-			DeveloperRewards: sdk.NewDecWithPrec(15, 2), // 15%
+		DistributionProportions: zeroDistributionProportions(),
+		DistributionRecipients: []DistributionRecipient{
+			{
+				Name:       RecipientGrantsProgram,
+				TargetType: DistributionTargetAccount,
+				Target:     "tori1a28lq0usqrma2tn5t7vmdg3jnglh3v3qln4ky0",
+				Weight:     sdk.NewDecWithPrec(10, 2), // 10%
+			},
+			{
+				Name:       RecipientUsageIncentive,
+				TargetType: DistributionTargetAccount,
+				Target:     "tori1at6zkjpxleg8nd8u67542fprzgsev6jh5lfzne",
+				Weight:     sdk.NewDecWithPrec(25, 2), // 25%
+			},
+			{
+				Name:       RecipientStaking,
+				TargetType: DistributionTargetModule,
+				Target:     authtypes.FeeCollectorName,
+				Weight:     sdk.NewDecWithPrec(40, 2), // 40%
+			},
+			{
+				Name:       RecipientDeveloperRewards,
+				TargetType: DistributionTargetDeveloperVesting,
+				Weight:     sdk.NewDecWithPrec(15, 2), // 15%
+			},
+			{
+				Name:       RecipientCommunityPool,
+				TargetType: DistributionTargetCommunityPool,
+				Weight:     sdk.NewDecWithPrec(10, 2), // 10%
+			},
 		},
-		WeightedDeveloperRewardsReceivers:    parseMonthlyVesting(),
-		UsageIncentiveAddress:                "tori1at6zkjpxleg8nd8u67542fprzgsev6jh5lfzne",
-		GrantsProgramAddress:                 "tori1a28lq0usqrma2tn5t7vmdg3jnglh3v3qln4ky0",
+		WeightedDeveloperRewardsReceivers:    nil, // set in the genesis file with `mint vesting import`
 		TeamReserveAddress:                   "tori1efcnw3j074urqryseyx4weahr2p5at9lhwcaju",
 		MintingRewardsDistributionStartBlock: 0,
 		BlocksPerYear:                        5733818,
 		TotalBurntAmount:                     sdk.Coins{},
+		ReductionMode:                        ReductionModeBlocks,
+		ReductionPeriodDuration:              DefaultReductionPeriodDuration,
+		MaxSupply:                            sdk.ZeroInt(),
+		FeeBurnRatio:                         sdk.ZeroDec(),
 	}
 }
 
@@ -160,64 +117,128 @@ func (p Params) Validate() error {
 	if err := validateReductionFactor(p.ReductionFactor); err != nil {
 		return err
 	}
-	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+	if err := validateDistributionRecipients(p.DistributionRecipients); err != nil {
 		return err
 	}
 
-	if err := validateAddress(p.UsageIncentiveAddress); err != nil {
+	if err := validateAddress(p.TeamReserveAddress); err != nil {
 		return err
 	}
 
-	if err := validateAddress(p.GrantsProgramAddress); err != nil {
+	if err := validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
+		return err
+	}
+	if err := validateMintingRewardsDistributionStartBlock(p.MintingRewardsDistributionStartBlock); err != nil {
 		return err
 	}
 
-	if err := validateAddress(p.TeamReserveAddress); err != nil {
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
 
-	if err := validateWeightedDeveloperRewardsReceivers(p.WeightedDeveloperRewardsReceivers); err != nil {
+	if err := validateTotalBurntAmount(p.TotalBurntAmount); err != nil {
 		return err
 	}
-	if err := validateMintingRewardsDistributionStartBlock(p.MintingRewardsDistributionStartBlock); err != nil {
+
+	if err := validateReductionMode(p.ReductionMode); err != nil {
 		return err
 	}
 
-	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+	if err := validateReductionPeriodDuration(p.ReductionPeriodDuration); err != nil {
 		return err
 	}
 
-	if err := validateTotalBurntAmount(p.TotalBurntAmount); err != nil {
+	if err := validateHookContracts(p.HookContracts); err != nil {
+		return err
+	}
+
+	if err := validateLockedAddresses(p.LockedAddresses); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	if err := validateFeeBurnRatio(p.FeeBurnRatio); err != nil {
 		return err
 	}
+
+	if err := validateGuardianAddress(p.GuardianAddress); err != nil {
+		return err
+	}
+
+	// the duration is only required once the time mode is enabled
+	if p.ReductionMode == ReductionModeTime {
+		if p.ReductionPeriodDuration < MinReductionPeriodDuration {
+			return fmt.Errorf("reduction period duration must be at least %s: %s", MinReductionPeriodDuration, p.ReductionPeriodDuration)
+		}
+		if p.NominalBlockTime() <= 0 {
+			return errors.New("reduction period duration is too short for the reduction period in blocks")
+		}
+	}
 	return nil
 }
 
+// ModuleWeight returns the proportion of the minted denom sent to a module
+// account.
+func (p Params) ModuleWeight(moduleName string) sdk.Dec {
+	weight := sdk.ZeroDec()
+	for _, r := range p.DistributionRecipients {
+		if r.TargetType == DistributionTargetModule && r.Target == moduleName {
+			weight = weight.Add(r.Weight)
+		}
+	}
+	return weight
+}
+
+// DistributionRecipient returns the distribution recipient of the given name.
+func (p Params) DistributionRecipient(name string) (DistributionRecipient, bool) {
+	for _, r := range p.DistributionRecipients {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return DistributionRecipient{}, false
+}
+
+// SupplyLockedAddresses returns the accounts, other than the module accounts,
+// whose tokens are excluded from the circulating supply: the team reserve, the
+// distribution recipient accounts and the locked addresses.
+func (p Params) SupplyLockedAddresses() []string {
+	seen := make(map[string]bool)
+	addrs := []string{}
+	add := func(addr string) {
+		if addr != "" && !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+
+	add(p.TeamReserveAddress)
+	for _, r := range p.DistributionRecipients {
+		if r.TargetType == DistributionTargetAccount {
+			add(r.Target)
+		}
+	}
+	for _, addr := range p.LockedAddresses {
+		add(addr)
+	}
+	return addrs
+}
+
+// NominalBlockTime returns the block time the reduction period parameters
+// assume, used to convert between the block and the time reduction modes.
+func (p Params) NominalBlockTime() time.Duration {
+	return p.ReductionPeriodDuration / time.Duration(p.ReductionPeriodInBlocks)
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
 
-// Implements params.ParamSet.
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-
-	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
-		paramtypes.NewParamSetPair(KeyGenesisBlockProvisions, &p.GenesisBlockProvisions, validateGenesisBlockProvisions),
-		paramtypes.NewParamSetPair(KeyReductionPeriodInBlocks, &p.ReductionPeriodInBlocks, validateReductionPeriodInBlocks),
-		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
-		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
-		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
-		paramtypes.NewParamSetPair(KeyUsageIncentiveAddress, &p.UsageIncentiveAddress, validateAddress),
-		paramtypes.NewParamSetPair(KeyGrantsProgramAddress, &p.GrantsProgramAddress, validateAddress),
-		paramtypes.NewParamSetPair(KeyTeamReserveAddress, &p.TeamReserveAddress, validateAddress),
-		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartBlock, &p.MintingRewardsDistributionStartBlock, validateMintingRewardsDistributionStartBlock),
-		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
-		paramtypes.NewParamSetPair(KeyTotalBurntAmount, &p.TotalBurntAmount, validateTotalBurntAmount),
-	}
-}
-
 func validateMintDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -277,6 +298,74 @@ func validateReductionFactor(i interface{}) error {
 	return nil
 }
 
+// zeroDistributionProportions returns the value of the deprecated distribution
+// proportions, superseded by the distribution recipients.
+func zeroDistributionProportions() DistributionProportions {
+	return DistributionProportions{
+		GrantsProgram:    sdk.ZeroDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		UsageIncentive:   sdk.ZeroDec(),
+		Staking:          sdk.ZeroDec(),
+		DeveloperRewards: sdk.ZeroDec(),
+	}
+}
+
+func validateDistributionRecipients(i interface{}) error {
+	v, ok := i.([]DistributionRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool)
+	developerVesting := false
+	totalWeight := sdk.ZeroDec()
+	for _, r := range v {
+		if strings.TrimSpace(r.Name) == "" {
+			return errors.New("distribution recipient name cannot be blank")
+		}
+		if names[r.Name] {
+			return fmt.Errorf("duplicate distribution recipient %s", r.Name)
+		}
+		names[r.Name] = true
+
+		if r.Weight.IsNil() || r.Weight.IsNegative() {
+			return fmt.Errorf("distribution recipient %s weight should not be negative", r.Name)
+		}
+		totalWeight = totalWeight.Add(r.Weight)
+
+		switch r.TargetType {
+		case DistributionTargetAccount:
+			if _, err := sdk.AccAddressFromBech32(r.Target); err != nil {
+				return fmt.Errorf("distribution recipient %s: %w", r.Name, err)
+			}
+		case DistributionTargetModule:
+			if strings.TrimSpace(r.Target) == "" {
+				return fmt.Errorf("distribution recipient %s module name cannot be blank", r.Name)
+			}
+		case DistributionTargetCommunityPool:
+			if r.Target != "" {
+				return fmt.Errorf("distribution recipient %s targets the community pool and cannot have a target", r.Name)
+			}
+		case DistributionTargetDeveloperVesting:
+			if r.Target != "" {
+				return fmt.Errorf("distribution recipient %s targets the developer vesting and cannot have a target", r.Name)
+			}
+			if developerVesting {
+				return errors.New("only one distribution recipient can target the developer vesting")
+			}
+			developerVesting = true
+		default:
+			return fmt.Errorf("distribution recipient %s has an invalid target type: %d", r.Name, r.TargetType)
+		}
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return errors.New("total distribution recipients weight should be 1")
+	}
+
+	return nil
+}
+
 func validateDistributionProportions(i interface{}) error {
 	v, ok := i.(DistributionProportions)
 	if !ok {
@@ -318,9 +407,24 @@ func validateWeightedDeveloperRewardsReceivers(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	// fund community pool when rewards address is empty
-	if len(v) == 0 {
-		return nil
+	seen := make(map[string]bool)
+	for i, w := range v {
+		// the rewards of a slot without address fund the community pool
+		if w.Address != "" {
+			if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+				return fmt.Errorf("invalid developer rewards receiver %d: %w", i, err)
+			}
+			if seen[w.Address] {
+				return fmt.Errorf("duplicate developer rewards receiver %s", w.Address)
+			}
+			seen[w.Address] = true
+		}
+
+		for month, amount := range w.MonthlyAmounts {
+			if amount.IsNil() || amount.IsNegative() {
+				return fmt.Errorf("developer rewards receiver %d amount of month %d must be non-negative", i, month)
+			}
+		}
 	}
 
 	return nil
@@ -371,3 +475,117 @@ func validateTotalBurntAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateReductionMode(i interface{}) error {
+	v, ok := i.(ReductionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ReductionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid reduction mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateReductionPeriodDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("reduction period duration must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHookContracts(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, contract := range v {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid hook contract %s: %w", contract, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate hook contract %s", contract)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
+
+func validateLockedAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid locked address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate locked address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset max supply disables the cap
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max supply must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset ratio burns no fees
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn ratio must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateGuardianAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// no guardian, minting only being paused by governance
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid guardian address: %w", err)
+	}
+
+	return nil
+}
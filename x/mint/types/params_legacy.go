@@ -0,0 +1,66 @@
+package types
+
+import (
+	"reflect"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+//
+// Deprecated: the mint parameters are kept in the module store. The legacy
+// keys, key table and ParamSetPairs are only used to migrate them out of the
+// x/params subspace.
+var (
+	KeyMintDenom                            = []byte("MintDenom")
+	KeyGenesisBlockProvisions               = []byte("GenesisBlockProvisions")
+	KeyReductionPeriodInBlocks              = []byte("ReductionPeriodInBlocks")
+	KeyReductionFactor                      = []byte("ReductionFactor")
+	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
+	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
+	KeyMintingRewardsDistributionStartBlock = []byte("MintingRewardsDistributionStartBlock")
+	KeyUsageIncentiveAddress                = []byte("UsageIncentiveAddress")
+	KeyGrantsProgramAddress                 = []byte("GrantsProgramAddress")
+	KeyTeamReserveAddress                   = []byte("TeamReserveAddress")
+	KeyBlocksPerYear                        = []byte("BlocksPerYear")
+	KeyTotalBurntAmount                     = []byte("TotalBurntAmount")
+)
+
+// ParamTable for minting module.
+//
+// Deprecated: only used by the v2 migration.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeyGenesisBlockProvisions, &p.GenesisBlockProvisions, validateGenesisBlockProvisions),
+		paramtypes.NewParamSetPair(KeyReductionPeriodInBlocks, &p.ReductionPeriodInBlocks, validateReductionPeriodInBlocks),
+		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
+		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
+		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
+		paramtypes.NewParamSetPair(KeyUsageIncentiveAddress, &p.UsageIncentiveAddress, validateAddress),
+		paramtypes.NewParamSetPair(KeyGrantsProgramAddress, &p.GrantsProgramAddress, validateAddress),
+		paramtypes.NewParamSetPair(KeyTeamReserveAddress, &p.TeamReserveAddress, validateAddress),
+		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartBlock, &p.MintingRewardsDistributionStartBlock, validateMintingRewardsDistributionStartBlock),
+		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyTotalBurntAmount, &p.TotalBurntAmount, validateTotalBurntAmount),
+	}
+}
+
+// ValidateLegacy validates the parameters of the legacy param set, the ones
+// held by the x/params subspace before the v2 migration.
+//
+// Deprecated: only used by the migrations preceding the weighted distribution
+// recipients, which Validate requires.
+func (p Params) ValidateLegacy() error {
+	for _, pair := range p.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import "fmt"
+
+// Validate performs a basic validation of the minting circuit breaker state.
+func (p MintingPause) Validate() error {
+	if p.Paused && p.PausedHeight <= 0 {
+		return fmt.Errorf("invalid minting pause height %d", p.PausedHeight)
+	}
+	if !p.Paused && (p.PausedHeight != 0 || !p.PausedTime.IsZero()) {
+		return fmt.Errorf("minting pause height or time set while minting is not paused")
+	}
+	if p.TotalPausedBlocks < 0 {
+		return fmt.Errorf("invalid total paused blocks %d", p.TotalPausedBlocks)
+	}
+	if p.TotalPausedDuration < 0 {
+		return fmt.Errorf("invalid total paused duration %s", p.TotalPausedDuration)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -271,6 +272,8 @@ var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
 // QueryStakingAPRRequest is the request type for the Query/StakingAPR RPC
 // method.
 type QueryStakingAPRRequest struct {
+	// include the trailing average of the transaction fees
+	IncludeFees bool `protobuf:"varint,1,opt,name=include_fees,json=includeFees,proto3" json:"include_fees,omitempty"`
 }
 
 func (m *QueryStakingAPRRequest) Reset()         { *m = QueryStakingAPRRequest{} }
@@ -306,6 +309,13 @@ func (m *QueryStakingAPRRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryStakingAPRRequest proto.InternalMessageInfo
 
+func (m *QueryStakingAPRRequest) GetIncludeFees() bool {
+	if m != nil {
+		return m.IncludeFees
+	}
+	return false
+}
+
 // QueryStakingAPRResponse is the response type for the Query/StakingAPR RPC
 // method.
 type QueryStakingAPRResponse struct {
@@ -345,574 +355,7951 @@ func (m *QueryStakingAPRResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryStakingAPRResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "teritori.mint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "teritori.mint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryBlockProvisionsRequest)(nil), "teritori.mint.v1beta1.QueryBlockProvisionsRequest")
-	proto.RegisterType((*QueryBlockProvisionsResponse)(nil), "teritori.mint.v1beta1.QueryBlockProvisionsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "teritori.mint.v1beta1.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "teritori.mint.v1beta1.QueryInflationResponse")
-	proto.RegisterType((*QueryStakingAPRRequest)(nil), "teritori.mint.v1beta1.QueryStakingAPRRequest")
-	proto.RegisterType((*QueryStakingAPRResponse)(nil), "teritori.mint.v1beta1.QueryStakingAPRResponse")
+// QueryValidatorAPRRequest is the request type for the Query/ValidatorAPR RPC
+// method.
+type QueryValidatorAPRRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// include the trailing average of the transaction fees
+	IncludeFees bool `protobuf:"varint,2,opt,name=include_fees,json=includeFees,proto3" json:"include_fees,omitempty"`
 }
 
-func init() { proto.RegisterFile("teritori/mint/v1beta1/query.proto", fileDescriptor_740cbd1a178ea227) }
-
-var fileDescriptor_740cbd1a178ea227 = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x63, 0x28, 0x91, 0xfa, 0x82, 0x54, 0x74, 0xb4, 0xb4, 0x32, 0x8d, 0x13, 0x2c, 0xfe,
-	0xb4, 0x15, 0xb9, 0x53, 0xd3, 0x91, 0x05, 0x22, 0x18, 0x22, 0x21, 0x11, 0x4c, 0x17, 0x60, 0xa8,
-	0x2e, 0xe6, 0xea, 0x9e, 0x92, 0xf8, 0x5c, 0xdf, 0xa5, 0x22, 0x2b, 0x03, 0x33, 0x82, 0x81, 0x0f,
-	0xc1, 0x17, 0xe9, 0x58, 0x89, 0x05, 0x31, 0x54, 0x28, 0x61, 0xe5, 0x3b, 0x20, 0x9f, 0xcf, 0x29,
-	0x4d, 0x9c, 0x28, 0xed, 0x64, 0xeb, 0xde, 0xe7, 0x7d, 0x9e, 0x9f, 0x7c, 0x8f, 0x0c, 0x77, 0x15,
-	0x8b, 0xb9, 0x12, 0x31, 0x27, 0x5d, 0x1e, 0x2a, 0x72, 0xb4, 0xdd, 0x62, 0x8a, 0x6e, 0x93, 0xc3,
-	0x1e, 0x8b, 0xfb, 0x38, 0x8a, 0x85, 0x12, 0x68, 0x25, 0x93, 0xe0, 0x44, 0x82, 0x8d, 0xc4, 0x5e,
-	0x0e, 0x44, 0x20, 0xb4, 0x82, 0x24, 0x6f, 0xa9, 0xd8, 0x5e, 0x0f, 0x84, 0x08, 0x3a, 0x8c, 0xd0,
-	0x88, 0x13, 0x1a, 0x86, 0x42, 0x51, 0xc5, 0x45, 0x28, 0xcd, 0xb4, 0x92, 0x9f, 0xa6, 0x7d, 0xb5,
-	0xc2, 0x5d, 0x06, 0xf4, 0x2a, 0xc9, 0x6e, 0xd2, 0x98, 0x76, 0xa5, 0xc7, 0x0e, 0x7b, 0x4c, 0x2a,
-	0xd7, 0x83, 0x5b, 0xe7, 0x4e, 0x65, 0x24, 0x42, 0xc9, 0xd0, 0x63, 0x28, 0x46, 0xfa, 0x64, 0xcd,
-	0xaa, 0x58, 0x1b, 0xd7, 0x6b, 0x25, 0x9c, 0x8b, 0x8a, 0xd3, 0xb5, 0xfa, 0xc2, 0xf1, 0x69, 0xb9,
-	0xe0, 0x99, 0x15, 0xb7, 0x04, 0x77, 0xb4, 0x67, 0xbd, 0x23, 0xfc, 0x76, 0x33, 0x16, 0x47, 0x5c,
-	0x26, 0xa4, 0x59, 0x64, 0x1f, 0xd6, 0xf3, 0xc7, 0x26, 0xfb, 0x0d, 0xdc, 0x6c, 0x25, 0xa3, 0xbd,
-	0x68, 0x34, 0xd3, 0x14, 0x37, 0xea, 0x38, 0x89, 0xf9, 0x75, 0x5a, 0x7e, 0x10, 0x70, 0x75, 0xd0,
-	0x6b, 0x61, 0x5f, 0x74, 0x89, 0x2f, 0x64, 0x57, 0x48, 0xf3, 0xa8, 0xca, 0xf7, 0x6d, 0xa2, 0xfa,
-	0x11, 0x93, 0xf8, 0x19, 0xf3, 0xbd, 0xa5, 0xd6, 0xf9, 0x08, 0x77, 0x15, 0x56, 0x74, 0x74, 0x23,
-	0xdc, 0xef, 0xe8, 0xcf, 0x97, 0x31, 0xed, 0xc3, 0xed, 0xf1, 0x81, 0xa1, 0x79, 0x01, 0x8b, 0x3c,
-	0x3b, 0xbc, 0x24, 0xc6, 0x99, 0x81, 0xbb, 0x66, 0x72, 0x5e, 0x2b, 0xda, 0xe6, 0x61, 0xf0, 0xb4,
-	0xe9, 0x65, 0x04, 0xef, 0x60, 0x75, 0x62, 0x62, 0x10, 0x9e, 0xc0, 0x55, 0x1a, 0xc5, 0x97, 0x0c,
-	0x4f, 0x56, 0x6b, 0x7f, 0x17, 0xe0, 0x9a, 0x76, 0x47, 0x9f, 0x2c, 0x28, 0xa6, 0x97, 0x86, 0x36,
-	0xa7, 0xdc, 0xe9, 0x64, 0x4b, 0xec, 0xad, 0x79, 0xa4, 0x29, 0xad, 0x7b, 0xff, 0xe3, 0x8f, 0x3f,
-	0x5f, 0xaf, 0x94, 0x51, 0x89, 0xe4, 0x57, 0x32, 0x2d, 0x09, 0xfa, 0x6e, 0xc1, 0xd2, 0x58, 0x03,
-	0x50, 0x6d, 0x56, 0x4c, 0x7e, 0x9b, 0xec, 0x9d, 0x0b, 0xed, 0x18, 0x46, 0xa2, 0x19, 0x37, 0xd1,
-	0xc3, 0x29, 0x8c, 0xe3, 0xfd, 0x43, 0x5f, 0x2c, 0x58, 0x1c, 0x75, 0x03, 0x3d, 0x9a, 0x95, 0x39,
-	0xde, 0x2d, 0xbb, 0x3a, 0xa7, 0xda, 0xb0, 0x6d, 0x68, 0x36, 0x17, 0x55, 0xa6, 0xb0, 0x8d, 0xca,
-	0x84, 0xbe, 0x59, 0x00, 0x67, 0x75, 0x41, 0x33, 0x73, 0x26, 0x0a, 0x67, 0xe3, 0x79, 0xe5, 0x86,
-	0x6b, 0x4b, 0x73, 0xdd, 0x43, 0xee, 0x14, 0x2e, 0x99, 0xae, 0xec, 0xd1, 0x28, 0xae, 0x37, 0x8e,
-	0x07, 0x8e, 0x75, 0x32, 0x70, 0xac, 0xdf, 0x03, 0xc7, 0xfa, 0x3c, 0x74, 0x0a, 0x27, 0x43, 0xa7,
-	0xf0, 0x73, 0xe8, 0x14, 0xde, 0x92, 0xff, 0x6a, 0xbb, 0xfb, 0xdc, 0x6b, 0xec, 0xbe, 0xf4, 0x1a,
-	0x23, 0xc3, 0xaa, 0x7f, 0x40, 0x79, 0x48, 0x3e, 0xa4, 0xc6, 0xba, 0xc3, 0xad, 0xa2, 0xfe, 0x7b,
-	0xed, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x72, 0x72, 0x88, 0x4f, 0x05, 0x00, 0x00,
+func (m *QueryValidatorAPRRequest) Reset()         { *m = QueryValidatorAPRRequest{} }
+func (m *QueryValidatorAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAPRRequest) ProtoMessage()    {}
+func (*QueryValidatorAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{8}
+}
+func (m *QueryValidatorAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAPRRequest.Merge(m, src)
+}
+func (m *QueryValidatorAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAPRRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryValidatorAPRRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BlockProvisions current minting epoch provisions value.
-	BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
-	// StakingAPR returns the current staking APR value.
-	StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error)
+func (m *QueryValidatorAPRRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryValidatorAPRRequest) GetIncludeFees() bool {
+	if m != nil {
+		return m.IncludeFees
+	}
+	return false
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryValidatorAPRResponse is the response type for the Query/ValidatorAPR
+// RPC method.
+type QueryValidatorAPRResponse struct {
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorAPRResponse) Reset()         { *m = QueryValidatorAPRResponse{} }
+func (m *QueryValidatorAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAPRResponse) ProtoMessage()    {}
+func (*QueryValidatorAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{9}
+}
+func (m *QueryValidatorAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAPRResponse.Merge(m, src)
+}
+func (m *QueryValidatorAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAPRResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error) {
-	out := new(QueryBlockProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/BlockProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryValidatorAPRResponse proto.InternalMessageInfo
+
+// QueryTotalBurntRequest is the request type for the Query/TotalBurnt RPC
+// method.
+type QueryTotalBurntRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTotalBurntRequest) Reset()         { *m = QueryTotalBurntRequest{} }
+func (m *QueryTotalBurntRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurntRequest) ProtoMessage()    {}
+func (*QueryTotalBurntRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{10}
+}
+func (m *QueryTotalBurntRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurntRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurntRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTotalBurntRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurntRequest.Merge(m, src)
+}
+func (m *QueryTotalBurntRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurntRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurntRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error) {
-	out := new(QueryStakingAPRResponse)
-	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/StakingAPR", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalBurntRequest proto.InternalMessageInfo
+
+func (m *QueryTotalBurntRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BlockProvisions current minting epoch provisions value.
-	BlockProvisions(context.Context, *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
-	// StakingAPR returns the current staking APR value.
-	StakingAPR(context.Context, *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error)
+// QueryTotalBurntResponse is the response type for the Query/TotalBurnt RPC
+// method.
+type QueryTotalBurntResponse struct {
+	Amount     []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Pagination *query.PageResponse                       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTotalBurntResponse) Reset()         { *m = QueryTotalBurntResponse{} }
+func (m *QueryTotalBurntResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurntResponse) ProtoMessage()    {}
+func (*QueryTotalBurntResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{11}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTotalBurntResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BlockProvisions(ctx context.Context, req *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockProvisions not implemented")
+func (m *QueryTotalBurntResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurntResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+func (m *QueryTotalBurntResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurntResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StakingAPR(ctx context.Context, req *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakingAPR not implemented")
+func (m *QueryTotalBurntResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTotalBurntResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurntResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/teritori.mint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryTotalBurntResponse proto.InternalMessageInfo
+
+func (m *QueryTotalBurntResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlockProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockProvisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/teritori.mint.v1beta1.Query/BlockProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockProvisions(ctx, req.(*QueryBlockProvisionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryBurntByAddressRequest is the request type for the Query/BurntByAddress
+// RPC method.
+type QueryBurntByAddressRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/teritori.mint.v1beta1.Query/Inflation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+func (m *QueryBurntByAddressRequest) Reset()         { *m = QueryBurntByAddressRequest{} }
+func (m *QueryBurntByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntByAddressRequest) ProtoMessage()    {}
+func (*QueryBurntByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{12}
+}
+func (m *QueryBurntByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBurntByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntByAddressRequest.Merge(m, src)
+}
+func (m *QueryBurntByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntByAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_StakingAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingAPRRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StakingAPR(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/teritori.mint.v1beta1.Query/StakingAPR",
+var xxx_messageInfo_QueryBurntByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBurntByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StakingAPR(ctx, req.(*QueryStakingAPRRequest))
+	return ""
+}
+
+func (m *QueryBurntByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "teritori.mint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BlockProvisions",
-			Handler:    _Query_BlockProvisions_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-		{
-			MethodName: "StakingAPR",
-			Handler:    _Query_StakingAPR_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "teritori/mint/v1beta1/query.proto",
+// QueryBurntByAddressResponse is the response type for the
+// Query/BurntByAddress RPC method.
+type QueryBurntByAddressResponse struct {
+	Amount     []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Pagination *query.PageResponse                       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurntByAddressResponse) Reset()         { *m = QueryBurntByAddressResponse{} }
+func (m *QueryBurntByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntByAddressResponse) ProtoMessage()    {}
+func (*QueryBurntByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{13}
+}
+func (m *QueryBurntByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurntByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntByAddressResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurntByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurntByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBurntByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnHistoryRequest is the request type for the Query/BurnHistory RPC
+// method.
+type QueryBurnHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBurnHistoryRequest) Reset()         { *m = QueryBurnHistoryRequest{} }
+func (m *QueryBurnHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryRequest) ProtoMessage()    {}
+func (*QueryBurnHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{14}
+}
+func (m *QueryBurnHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBlockProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryBlockProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBlockProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlockProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnHistoryResponse is the response type for the Query/BurnHistory RPC
+// method.
+type QueryBurnHistoryResponse struct {
+	Records    []BurnRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlockProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BlockProvisions.Size()
-		i -= size
-		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnHistoryResponse) Reset()         { *m = QueryBurnHistoryResponse{} }
+func (m *QueryBurnHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnHistoryResponse) ProtoMessage()    {}
+func (*QueryBurnHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{15}
+}
+func (m *QueryBurnHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnHistoryResponse.Merge(m, src)
+}
+func (m *QueryBurnHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBurnHistoryResponse) GetRecords() []BurnRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryTotalSupplyRequest is the request type for the Query/TotalSupply RPC
+// method.
+type QueryTotalSupplyRequest struct {
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest{} }
+func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyRequest) ProtoMessage()    {}
+func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{16}
+}
+func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTotalSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyRequest.Merge(m, src)
+}
+func (m *QueryTotalSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalSupplyRequest proto.InternalMessageInfo
+
+// QueryTotalSupplyResponse is the response type for the Query/TotalSupply RPC
+// method.
+type QueryTotalSupplyResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyResponse{} }
+func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSupplyResponse) ProtoMessage()    {}
+func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{17}
+}
+func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStakingAPRRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSupplyResponse.Merge(m, src)
 }
-
-func (m *QueryStakingAPRRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSupplyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryTotalSupplyResponse proto.InternalMessageInfo
+
+// QueryCirculatingSupplyRequest is the request type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyRequest struct {
 }
 
-func (m *QueryStakingAPRResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCirculatingSupplyRequest) Reset()         { *m = QueryCirculatingSupplyRequest{} }
+func (m *QueryCirculatingSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyRequest) ProtoMessage()    {}
+func (*QueryCirculatingSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{18}
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryStakingAPRResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCirculatingSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyRequest.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Apr.Size()
-		i -= size
-		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+var xxx_messageInfo_QueryCirculatingSupplyRequest proto.InternalMessageInfo
+
+// QueryCirculatingSupplyResponse is the response type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *QueryCirculatingSupplyResponse) Reset()         { *m = QueryCirculatingSupplyResponse{} }
+func (m *QueryCirculatingSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyResponse) ProtoMessage()    {}
+func (*QueryCirculatingSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{19}
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyResponse.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCirculatingSupplyResponse proto.InternalMessageInfo
+
+// QueryBurntSupplyRequest is the request type for the Query/BurntSupply RPC
+// method.
+type QueryBurntSupplyRequest struct {
+}
+
+func (m *QueryBurntSupplyRequest) Reset()         { *m = QueryBurntSupplyRequest{} }
+func (m *QueryBurntSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntSupplyRequest) ProtoMessage()    {}
+func (*QueryBurntSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{20}
+}
+func (m *QueryBurntSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurntSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurntSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurntSupplyRequest proto.InternalMessageInfo
+
+// QueryBurntSupplyResponse is the response type for the Query/BurntSupply RPC
+// method.
+type QueryBurntSupplyResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *QueryBurntSupplyResponse) Reset()         { *m = QueryBurntSupplyResponse{} }
+func (m *QueryBurntSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurntSupplyResponse) ProtoMessage()    {}
+func (*QueryBurntSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{21}
+}
+func (m *QueryBurntSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurntSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurntSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryBurntSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurntSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurntSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurntSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurntSupplyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBurntSupplyResponse proto.InternalMessageInfo
+
+// QueryEmissionScheduleRequest is the request type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleRequest struct {
+	// first period to return, the current reduction period being 0
+	FromPeriod uint64 `protobuf:"varint,1,opt,name=from_period,json=fromPeriod,proto3" json:"from_period,omitempty"`
+	// number of periods to return
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryEmissionScheduleRequest) Reset()         { *m = QueryEmissionScheduleRequest{} }
+func (m *QueryEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleRequest) ProtoMessage()    {}
+func (*QueryEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{22}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleRequest.Merge(m, src)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleRequest) GetFromPeriod() uint64 {
+	if m != nil {
+		return m.FromPeriod
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryBlockProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionScheduleRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	var l int
-	_ = l
-	l = m.BlockProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEmissionScheduleResponse is the response type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleResponse struct {
+	Periods []EmissionPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *QueryEmissionScheduleResponse) Reset()         { *m = QueryEmissionScheduleResponse{} }
+func (m *QueryEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleResponse) ProtoMessage()    {}
+func (*QueryEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{23}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleResponse.Merge(m, src)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleResponse) GetPeriods() []EmissionPeriod {
+	if m != nil {
+		return m.Periods
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryStakingAPRRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// EmissionPeriod is the projected emission of a reduction period.
+type EmissionPeriod struct {
+	// index of the period, the current reduction period being 0
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// height of the first block of the period
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// provisions minted per block during the period
+	BlockProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=block_provisions,json=blockProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_provisions"`
+	// total amount minted during the period
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted"`
+	// amount allocated to each distribution recipient
+	Recipients []DistributionRecipientTotal `protobuf:"bytes,11,rep,name=recipients,proto3" json:"recipients"`
+	// amount vested to the developer rewards receivers
+	DeveloperVesting github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=developer_vesting,json=developerVesting,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"developer_vesting"`
+	// remainder of the developer rewards sent to the team reserve address
+	TeamReserve github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=team_reserve,json=teamReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"team_reserve"`
+	// amount sent to the community pool, including truncation remainders
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool"`
+}
+
+func (m *EmissionPeriod) Reset()         { *m = EmissionPeriod{} }
+func (m *EmissionPeriod) String() string { return proto.CompactTextString(m) }
+func (*EmissionPeriod) ProtoMessage()    {}
+func (*EmissionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{24}
+}
+func (m *EmissionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionPeriod.Merge(m, src)
+}
+func (m *EmissionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionPeriod proto.InternalMessageInfo
+
+func (m *EmissionPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *EmissionPeriod) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EmissionPeriod) GetRecipients() []DistributionRecipientTotal {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// QueryDistributionTotalsRequest is the request type for the
+// Query/DistributionTotals RPC method.
+type QueryDistributionTotalsRequest struct {
+}
+
+func (m *QueryDistributionTotalsRequest) Reset()         { *m = QueryDistributionTotalsRequest{} }
+func (m *QueryDistributionTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionTotalsRequest) ProtoMessage()    {}
+func (*QueryDistributionTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{25}
+}
+func (m *QueryDistributionTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionTotalsRequest.Merge(m, src)
+}
+func (m *QueryDistributionTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionTotalsRequest proto.InternalMessageInfo
+
+// QueryDistributionTotalsResponse is the response type for the
+// Query/DistributionTotals RPC method.
+type QueryDistributionTotalsResponse struct {
+	Totals DistributionTotals `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *QueryDistributionTotalsResponse) Reset()         { *m = QueryDistributionTotalsResponse{} }
+func (m *QueryDistributionTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionTotalsResponse) ProtoMessage()    {}
+func (*QueryDistributionTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{26}
+}
+func (m *QueryDistributionTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionTotalsResponse.Merge(m, src)
+}
+func (m *QueryDistributionTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionTotalsResponse) GetTotals() DistributionTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return DistributionTotals{}
+}
+
+// QueryPendingDistributionRequest is the request type for the
+// Query/PendingDistribution RPC method.
+type QueryPendingDistributionRequest struct {
+}
+
+func (m *QueryPendingDistributionRequest) Reset()         { *m = QueryPendingDistributionRequest{} }
+func (m *QueryPendingDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDistributionRequest) ProtoMessage()    {}
+func (*QueryPendingDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{27}
+}
+func (m *QueryPendingDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDistributionRequest.Merge(m, src)
+}
+func (m *QueryPendingDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDistributionRequest proto.InternalMessageInfo
+
+// QueryPendingDistributionResponse is the response type for the
+// Query/PendingDistribution RPC method.
+type QueryPendingDistributionResponse struct {
+	Pending DistributionTotals `protobuf:"bytes,1,opt,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryPendingDistributionResponse) Reset()         { *m = QueryPendingDistributionResponse{} }
+func (m *QueryPendingDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDistributionResponse) ProtoMessage()    {}
+func (*QueryPendingDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{28}
+}
+func (m *QueryPendingDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDistributionResponse.Merge(m, src)
+}
+func (m *QueryPendingDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDistributionResponse) GetPending() DistributionTotals {
+	if m != nil {
+		return m.Pending
+	}
+	return DistributionTotals{}
+}
+
+// QueryVestingRewardsRequest is the request type for the Query/VestingRewards
+// RPC method.
+type QueryVestingRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingRewardsRequest) Reset()         { *m = QueryVestingRewardsRequest{} }
+func (m *QueryVestingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRewardsRequest) ProtoMessage()    {}
+func (*QueryVestingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{29}
+}
+func (m *QueryVestingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRewardsRequest.Merge(m, src)
+}
+func (m *QueryVestingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryVestingRewardsResponse is the response type for the
+// Query/VestingRewards RPC method.
+type QueryVestingRewardsResponse struct {
+	Rewards VestingRewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *QueryVestingRewardsResponse) Reset()         { *m = QueryVestingRewardsResponse{} }
+func (m *QueryVestingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRewardsResponse) ProtoMessage()    {}
+func (*QueryVestingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{30}
+}
+func (m *QueryVestingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRewardsResponse.Merge(m, src)
+}
+func (m *QueryVestingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingRewardsResponse) GetRewards() VestingRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return VestingRewards{}
+}
+
+// QueryAllVestingRewardsRequest is the request type for the
+// Query/AllVestingRewards RPC method.
+type QueryAllVestingRewardsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllVestingRewardsRequest) Reset()         { *m = QueryAllVestingRewardsRequest{} }
+func (m *QueryAllVestingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingRewardsRequest) ProtoMessage()    {}
+func (*QueryAllVestingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{31}
+}
+func (m *QueryAllVestingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVestingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllVestingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingRewardsRequest.Merge(m, src)
+}
+func (m *QueryAllVestingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVestingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllVestingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAllVestingRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllVestingRewardsResponse is the response type for the
+// Query/AllVestingRewards RPC method.
+type QueryAllVestingRewardsResponse struct {
+	Rewards    []VestingRewards    `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllVestingRewardsResponse) Reset()         { *m = QueryAllVestingRewardsResponse{} }
+func (m *QueryAllVestingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVestingRewardsResponse) ProtoMessage()    {}
+func (*QueryAllVestingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{32}
+}
+func (m *QueryAllVestingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllVestingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllVestingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllVestingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVestingRewardsResponse.Merge(m, src)
+}
+func (m *QueryAllVestingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllVestingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVestingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllVestingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllVestingRewardsResponse) GetRewards() []VestingRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryAllVestingRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingReceiverRotationsRequest is the request type for the
+// Query/VestingReceiverRotations RPC method.
+type QueryVestingReceiverRotationsRequest struct {
+	// index of the slot in the weighted developer rewards receivers
+	Slot       uint64             `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingReceiverRotationsRequest) Reset()         { *m = QueryVestingReceiverRotationsRequest{} }
+func (m *QueryVestingReceiverRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingReceiverRotationsRequest) ProtoMessage()    {}
+func (*QueryVestingReceiverRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{33}
+}
+func (m *QueryVestingReceiverRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingReceiverRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingReceiverRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingReceiverRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingReceiverRotationsRequest.Merge(m, src)
+}
+func (m *QueryVestingReceiverRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingReceiverRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingReceiverRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingReceiverRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingReceiverRotationsRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *QueryVestingReceiverRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingReceiverRotationsResponse is the response type for the
+// Query/VestingReceiverRotations RPC method.
+type QueryVestingReceiverRotationsResponse struct {
+	Rotations  []VestingReceiverRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingReceiverRotationsResponse) Reset()         { *m = QueryVestingReceiverRotationsResponse{} }
+func (m *QueryVestingReceiverRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingReceiverRotationsResponse) ProtoMessage()    {}
+func (*QueryVestingReceiverRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{34}
+}
+func (m *QueryVestingReceiverRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingReceiverRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingReceiverRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingReceiverRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingReceiverRotationsResponse.Merge(m, src)
+}
+func (m *QueryVestingReceiverRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingReceiverRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingReceiverRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingReceiverRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingReceiverRotationsResponse) GetRotations() []VestingReceiverRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryVestingReceiverRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInflationHistoryRequest is the request type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryRequest struct {
+	// lowest height of the snapshots to return
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// highest height of the snapshots to return, 0 for no limit
+	ToHeight   int64              `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInflationHistoryRequest) Reset()         { *m = QueryInflationHistoryRequest{} }
+func (m *QueryInflationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryRequest) ProtoMessage()    {}
+func (*QueryInflationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{35}
+}
+func (m *QueryInflationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryRequest.Merge(m, src)
+}
+func (m *QueryInflationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryInflationHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryInflationHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryInflationHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInflationHistoryResponse is the response type for the
+// Query/InflationHistory RPC method.
+type QueryInflationHistoryResponse struct {
+	Snapshots  []InflationSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInflationHistoryResponse) Reset()         { *m = QueryInflationHistoryResponse{} }
+func (m *QueryInflationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationHistoryResponse) ProtoMessage()    {}
+func (*QueryInflationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{36}
+}
+func (m *QueryInflationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationHistoryResponse.Merge(m, src)
+}
+func (m *QueryInflationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInflationHistoryResponse) GetSnapshots() []InflationSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QueryInflationHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLastMintFaultRequest is the request type for the Query/LastMintFault RPC
+// method.
+type QueryLastMintFaultRequest struct {
+}
+
+func (m *QueryLastMintFaultRequest) Reset()         { *m = QueryLastMintFaultRequest{} }
+func (m *QueryLastMintFaultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastMintFaultRequest) ProtoMessage()    {}
+func (*QueryLastMintFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{37}
+}
+func (m *QueryLastMintFaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastMintFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastMintFaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastMintFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastMintFaultRequest.Merge(m, src)
+}
+func (m *QueryLastMintFaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastMintFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastMintFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastMintFaultRequest proto.InternalMessageInfo
+
+// QueryLastMintFaultResponse is the response type for the Query/LastMintFault
+// RPC method.
+type QueryLastMintFaultResponse struct {
+	// last fault, unset if minting never failed
+	Fault *MintFault `protobuf:"bytes,1,opt,name=fault,proto3" json:"fault,omitempty"`
+}
+
+func (m *QueryLastMintFaultResponse) Reset()         { *m = QueryLastMintFaultResponse{} }
+func (m *QueryLastMintFaultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastMintFaultResponse) ProtoMessage()    {}
+func (*QueryLastMintFaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{38}
+}
+func (m *QueryLastMintFaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastMintFaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastMintFaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastMintFaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastMintFaultResponse.Merge(m, src)
+}
+func (m *QueryLastMintFaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastMintFaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastMintFaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastMintFaultResponse proto.InternalMessageInfo
+
+func (m *QueryLastMintFaultResponse) GetFault() *MintFault {
+	if m != nil {
+		return m.Fault
+	}
+	return nil
+}
+
+// QueryFeeBurnStatsRequest is the request type for the Query/FeeBurnStats RPC
+// method.
+type QueryFeeBurnStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeBurnStatsRequest) Reset()         { *m = QueryFeeBurnStatsRequest{} }
+func (m *QueryFeeBurnStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBurnStatsRequest) ProtoMessage()    {}
+func (*QueryFeeBurnStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{39}
+}
+func (m *QueryFeeBurnStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBurnStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBurnStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBurnStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBurnStatsRequest.Merge(m, src)
+}
+func (m *QueryFeeBurnStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBurnStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBurnStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBurnStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeBurnStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeBurnStatsResponse is the response type for the Query/FeeBurnStats RPC
+// method.
+type QueryFeeBurnStatsResponse struct {
+	Epochs     []FeeBurnEpoch      `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeBurnStatsResponse) Reset()         { *m = QueryFeeBurnStatsResponse{} }
+func (m *QueryFeeBurnStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBurnStatsResponse) ProtoMessage()    {}
+func (*QueryFeeBurnStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{40}
+}
+func (m *QueryFeeBurnStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBurnStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBurnStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBurnStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBurnStatsResponse.Merge(m, src)
+}
+func (m *QueryFeeBurnStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBurnStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBurnStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBurnStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeBurnStatsResponse) GetEpochs() []FeeBurnEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *QueryFeeBurnStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintingPauseRequest is the request type for the Query/MintingPause RPC
+// method.
+type QueryMintingPauseRequest struct {
+}
+
+func (m *QueryMintingPauseRequest) Reset()         { *m = QueryMintingPauseRequest{} }
+func (m *QueryMintingPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintingPauseRequest) ProtoMessage()    {}
+func (*QueryMintingPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{41}
+}
+func (m *QueryMintingPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintingPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintingPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintingPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintingPauseRequest.Merge(m, src)
+}
+func (m *QueryMintingPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintingPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintingPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintingPauseRequest proto.InternalMessageInfo
+
+// QueryMintingPauseResponse is the response type for the Query/MintingPause RPC
+// method.
+type QueryMintingPauseResponse struct {
+	Pause MintingPause `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause"`
+}
+
+func (m *QueryMintingPauseResponse) Reset()         { *m = QueryMintingPauseResponse{} }
+func (m *QueryMintingPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintingPauseResponse) ProtoMessage()    {}
+func (*QueryMintingPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_740cbd1a178ea227, []int{42}
+}
+func (m *QueryMintingPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintingPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintingPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintingPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintingPauseResponse.Merge(m, src)
+}
+func (m *QueryMintingPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintingPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintingPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintingPauseResponse proto.InternalMessageInfo
+
+func (m *QueryMintingPauseResponse) GetPause() MintingPause {
+	if m != nil {
+		return m.Pause
+	}
+	return MintingPause{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "teritori.mint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "teritori.mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlockProvisionsRequest)(nil), "teritori.mint.v1beta1.QueryBlockProvisionsRequest")
+	proto.RegisterType((*QueryBlockProvisionsResponse)(nil), "teritori.mint.v1beta1.QueryBlockProvisionsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "teritori.mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "teritori.mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryStakingAPRRequest)(nil), "teritori.mint.v1beta1.QueryStakingAPRRequest")
+	proto.RegisterType((*QueryStakingAPRResponse)(nil), "teritori.mint.v1beta1.QueryStakingAPRResponse")
+	proto.RegisterType((*QueryValidatorAPRRequest)(nil), "teritori.mint.v1beta1.QueryValidatorAPRRequest")
+	proto.RegisterType((*QueryValidatorAPRResponse)(nil), "teritori.mint.v1beta1.QueryValidatorAPRResponse")
+	proto.RegisterType((*QueryTotalBurntRequest)(nil), "teritori.mint.v1beta1.QueryTotalBurntRequest")
+	proto.RegisterType((*QueryTotalBurntResponse)(nil), "teritori.mint.v1beta1.QueryTotalBurntResponse")
+	proto.RegisterType((*QueryBurntByAddressRequest)(nil), "teritori.mint.v1beta1.QueryBurntByAddressRequest")
+	proto.RegisterType((*QueryBurntByAddressResponse)(nil), "teritori.mint.v1beta1.QueryBurntByAddressResponse")
+	proto.RegisterType((*QueryBurnHistoryRequest)(nil), "teritori.mint.v1beta1.QueryBurnHistoryRequest")
+	proto.RegisterType((*QueryBurnHistoryResponse)(nil), "teritori.mint.v1beta1.QueryBurnHistoryResponse")
+	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "teritori.mint.v1beta1.QueryTotalSupplyRequest")
+	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "teritori.mint.v1beta1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryCirculatingSupplyRequest)(nil), "teritori.mint.v1beta1.QueryCirculatingSupplyRequest")
+	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "teritori.mint.v1beta1.QueryCirculatingSupplyResponse")
+	proto.RegisterType((*QueryBurntSupplyRequest)(nil), "teritori.mint.v1beta1.QueryBurntSupplyRequest")
+	proto.RegisterType((*QueryBurntSupplyResponse)(nil), "teritori.mint.v1beta1.QueryBurntSupplyResponse")
+	proto.RegisterType((*QueryEmissionScheduleRequest)(nil), "teritori.mint.v1beta1.QueryEmissionScheduleRequest")
+	proto.RegisterType((*QueryEmissionScheduleResponse)(nil), "teritori.mint.v1beta1.QueryEmissionScheduleResponse")
+	proto.RegisterType((*EmissionPeriod)(nil), "teritori.mint.v1beta1.EmissionPeriod")
+	proto.RegisterType((*QueryDistributionTotalsRequest)(nil), "teritori.mint.v1beta1.QueryDistributionTotalsRequest")
+	proto.RegisterType((*QueryDistributionTotalsResponse)(nil), "teritori.mint.v1beta1.QueryDistributionTotalsResponse")
+	proto.RegisterType((*QueryPendingDistributionRequest)(nil), "teritori.mint.v1beta1.QueryPendingDistributionRequest")
+	proto.RegisterType((*QueryPendingDistributionResponse)(nil), "teritori.mint.v1beta1.QueryPendingDistributionResponse")
+	proto.RegisterType((*QueryVestingRewardsRequest)(nil), "teritori.mint.v1beta1.QueryVestingRewardsRequest")
+	proto.RegisterType((*QueryVestingRewardsResponse)(nil), "teritori.mint.v1beta1.QueryVestingRewardsResponse")
+	proto.RegisterType((*QueryAllVestingRewardsRequest)(nil), "teritori.mint.v1beta1.QueryAllVestingRewardsRequest")
+	proto.RegisterType((*QueryAllVestingRewardsResponse)(nil), "teritori.mint.v1beta1.QueryAllVestingRewardsResponse")
+	proto.RegisterType((*QueryVestingReceiverRotationsRequest)(nil), "teritori.mint.v1beta1.QueryVestingReceiverRotationsRequest")
+	proto.RegisterType((*QueryVestingReceiverRotationsResponse)(nil), "teritori.mint.v1beta1.QueryVestingReceiverRotationsResponse")
+	proto.RegisterType((*QueryInflationHistoryRequest)(nil), "teritori.mint.v1beta1.QueryInflationHistoryRequest")
+	proto.RegisterType((*QueryInflationHistoryResponse)(nil), "teritori.mint.v1beta1.QueryInflationHistoryResponse")
+	proto.RegisterType((*QueryLastMintFaultRequest)(nil), "teritori.mint.v1beta1.QueryLastMintFaultRequest")
+	proto.RegisterType((*QueryLastMintFaultResponse)(nil), "teritori.mint.v1beta1.QueryLastMintFaultResponse")
+	proto.RegisterType((*QueryFeeBurnStatsRequest)(nil), "teritori.mint.v1beta1.QueryFeeBurnStatsRequest")
+	proto.RegisterType((*QueryFeeBurnStatsResponse)(nil), "teritori.mint.v1beta1.QueryFeeBurnStatsResponse")
+	proto.RegisterType((*QueryMintingPauseRequest)(nil), "teritori.mint.v1beta1.QueryMintingPauseRequest")
+	proto.RegisterType((*QueryMintingPauseResponse)(nil), "teritori.mint.v1beta1.QueryMintingPauseResponse")
+}
+
+func init() { proto.RegisterFile("teritori/mint/v1beta1/query.proto", fileDescriptor_740cbd1a178ea227) }
+
+var fileDescriptor_740cbd1a178ea227 = []byte{
+	// 2001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdc, 0xc6,
+	0x15, 0xf6, 0x58, 0xbf, 0x9f, 0x14, 0x47, 0x9e, 0x38, 0xf1, 0x8a, 0xb2, 0x7e, 0x98, 0xb6, 0x6c,
+	0x59, 0xb6, 0x96, 0x92, 0xec, 0xb8, 0x41, 0x5d, 0xa0, 0xb1, 0x12, 0x2b, 0x91, 0x91, 0xa2, 0x0a,
+	0xe5, 0xa4, 0x68, 0xd3, 0x82, 0xa5, 0x76, 0x47, 0xbb, 0x8c, 0x77, 0x39, 0x0c, 0x39, 0xab, 0x56,
+	0x30, 0x52, 0x14, 0x39, 0xf4, 0x5c, 0xb4, 0x87, 0x1e, 0x7a, 0x68, 0x0f, 0x41, 0x73, 0xc8, 0x21,
+	0x29, 0x0a, 0xb4, 0x87, 0x5e, 0x7b, 0xc8, 0x31, 0x40, 0x0f, 0x2d, 0x7a, 0x30, 0x0a, 0xbb, 0xb7,
+	0xfe, 0x13, 0x05, 0x67, 0x1e, 0xb9, 0xa4, 0x96, 0xa4, 0xb8, 0x8b, 0x3d, 0xf4, 0x64, 0xef, 0xcc,
+	0xfb, 0xde, 0xfb, 0xe6, 0x9b, 0xe1, 0xbc, 0x79, 0x0f, 0x82, 0xcb, 0x82, 0xf9, 0x8e, 0xe0, 0xbe,
+	0x63, 0xb4, 0x1d, 0x57, 0x18, 0x47, 0x9b, 0x07, 0x4c, 0xd8, 0x9b, 0xc6, 0x47, 0x1d, 0xe6, 0x1f,
+	0x57, 0x3d, 0x9f, 0x0b, 0x4e, 0x5f, 0x8e, 0x4c, 0xaa, 0xa1, 0x49, 0x15, 0x4d, 0xb4, 0x0b, 0x0d,
+	0xde, 0xe0, 0xd2, 0xc2, 0x08, 0xff, 0xa7, 0x8c, 0xb5, 0x4b, 0x0d, 0xce, 0x1b, 0x2d, 0x66, 0xd8,
+	0x9e, 0x63, 0xd8, 0xae, 0xcb, 0x85, 0x2d, 0x1c, 0xee, 0x06, 0x38, 0xbb, 0x56, 0xe3, 0x41, 0x9b,
+	0x07, 0xc6, 0x81, 0x1d, 0x30, 0x15, 0x23, 0x8e, 0xe8, 0xd9, 0x0d, 0xc7, 0x95, 0xc6, 0x68, 0xbb,
+	0x9c, 0xcd, 0x4c, 0x72, 0x90, 0x16, 0xfa, 0x05, 0xa0, 0xef, 0x86, 0x3e, 0xf6, 0x6c, 0xdf, 0x6e,
+	0x07, 0x26, 0xfb, 0xa8, 0xc3, 0x02, 0xa1, 0x9b, 0xf0, 0x52, 0x6a, 0x34, 0xf0, 0xb8, 0x1b, 0x30,
+	0x7a, 0x0f, 0xc6, 0x3d, 0x39, 0x52, 0x21, 0xcb, 0x64, 0x75, 0x7a, 0x6b, 0xa1, 0x9a, 0xb9, 0xac,
+	0xaa, 0x82, 0x6d, 0x8f, 0x7e, 0xf5, 0x74, 0xe9, 0x8c, 0x89, 0x10, 0x7d, 0x01, 0xe6, 0xa5, 0xcf,
+	0xed, 0x16, 0xaf, 0x3d, 0xde, 0xf3, 0xf9, 0x91, 0x13, 0x84, 0xab, 0x8a, 0x42, 0x1e, 0xc3, 0xa5,
+	0xec, 0x69, 0x8c, 0xfd, 0x7d, 0x98, 0x3d, 0x08, 0xa7, 0x2c, 0x2f, 0x9e, 0x93, 0x2c, 0x66, 0xb6,
+	0xab, 0x61, 0x98, 0x7f, 0x3d, 0x5d, 0xba, 0xd6, 0x70, 0x44, 0xb3, 0x73, 0x50, 0xad, 0xf1, 0xb6,
+	0x81, 0x1a, 0xa9, 0x7f, 0xd6, 0x83, 0xfa, 0x63, 0x43, 0x1c, 0x7b, 0x2c, 0xa8, 0xbe, 0xc9, 0x6a,
+	0xe6, 0x8b, 0x07, 0xe9, 0x10, 0xfa, 0x45, 0x78, 0x59, 0x86, 0xde, 0x75, 0x0f, 0x5b, 0x52, 0xbd,
+	0x88, 0xd3, 0x21, 0xbc, 0x72, 0x72, 0x02, 0xd9, 0xbc, 0x03, 0x53, 0x4e, 0x34, 0x38, 0x20, 0x8d,
+	0xae, 0x03, 0xfd, 0x1e, 0xc6, 0xd9, 0x17, 0xf6, 0x63, 0xc7, 0x6d, 0xdc, 0xdf, 0x33, 0x91, 0x01,
+	0xbd, 0x0c, 0x33, 0x8e, 0x5b, 0x6b, 0x75, 0xea, 0xcc, 0x3a, 0x64, 0x4c, 0xad, 0x78, 0xd2, 0x9c,
+	0xc6, 0xb1, 0x1d, 0xc6, 0x02, 0xfd, 0x03, 0xb8, 0xd8, 0x03, 0x46, 0x96, 0xaf, 0xc3, 0x88, 0xed,
+	0xf9, 0x03, 0xf2, 0x0b, 0xa1, 0xfa, 0x87, 0x50, 0x91, 0xce, 0xdf, 0xb7, 0x5b, 0x4e, 0xdd, 0x16,
+	0xdc, 0x4f, 0x70, 0xbb, 0x09, 0xe7, 0x8f, 0xa2, 0x61, 0xcb, 0xae, 0xd7, 0x7d, 0x16, 0x28, 0x82,
+	0x53, 0xe6, 0x6c, 0x3c, 0x71, 0x5f, 0x8d, 0xf7, 0x2c, 0xe4, 0x6c, 0xef, 0x42, 0x7e, 0x04, 0x73,
+	0x19, 0xb1, 0x86, 0xb6, 0x94, 0x1f, 0xa3, 0xc8, 0x8f, 0xb8, 0xb0, 0x5b, 0xdb, 0x1d, 0xdf, 0x15,
+	0xd1, 0x42, 0x76, 0x00, 0xba, 0x5f, 0x0e, 0x1e, 0xed, 0x6b, 0x55, 0xe5, 0xa9, 0x1a, 0x7e, 0x66,
+	0x55, 0xf5, 0x29, 0x77, 0x8f, 0x77, 0x83, 0x21, 0xd6, 0x4c, 0x20, 0xf5, 0xcf, 0x09, 0x5c, 0xec,
+	0x09, 0x81, 0xfc, 0xdf, 0x82, 0x71, 0xbb, 0xcd, 0x3b, 0xae, 0xa8, 0x90, 0xe5, 0x91, 0xd5, 0xa9,
+	0x6d, 0x03, 0x97, 0x70, 0xbd, 0xc4, 0x12, 0xde, 0xe0, 0x8e, 0x6b, 0x22, 0x9c, 0xbe, 0x95, 0x22,
+	0x7b, 0x56, 0x92, 0xbd, 0x7e, 0x2a, 0x59, 0xc5, 0x22, 0xc5, 0xf6, 0x67, 0xa0, 0xa9, 0x0f, 0x2e,
+	0xe4, 0xb9, 0x7d, 0x8c, 0x1b, 0x15, 0x69, 0x52, 0x81, 0x89, 0xf4, 0x96, 0x46, 0x3f, 0xe9, 0x4e,
+	0x06, 0x81, 0x41, 0xd4, 0xfa, 0x82, 0xc0, 0x7c, 0x26, 0x81, 0xff, 0x5b, 0xc5, 0x6c, 0xb8, 0x18,
+	0x13, 0x7e, 0xdb, 0x09, 0x04, 0xf7, 0x8f, 0x87, 0x7d, 0x84, 0xfe, 0x40, 0xa0, 0xd2, 0x1b, 0x03,
+	0x15, 0xb9, 0x0f, 0x13, 0x3e, 0xab, 0x71, 0xbf, 0x1e, 0x48, 0x49, 0xa6, 0xb7, 0x2e, 0xe7, 0xdc,
+	0xbf, 0x21, 0xd8, 0x94, 0x96, 0x78, 0x07, 0x47, 0xb8, 0xe1, 0x69, 0x31, 0x97, 0x3c, 0xea, 0xfb,
+	0x1d, 0xcf, 0x6b, 0x45, 0x5a, 0xe8, 0x07, 0x50, 0xe9, 0x9d, 0xc2, 0x25, 0xec, 0x24, 0x36, 0x95,
+	0xac, 0x4e, 0xf5, 0xf5, 0x25, 0xef, 0xba, 0x22, 0xda, 0x53, 0x7d, 0x09, 0x16, 0x64, 0x8c, 0x37,
+	0x1c, 0xbf, 0xd6, 0x09, 0x6f, 0x51, 0xb7, 0x91, 0x26, 0xd1, 0x84, 0xc5, 0x3c, 0x83, 0x21, 0x53,
+	0x99, 0x4b, 0x9c, 0x0a, 0x91, 0xad, 0x44, 0x6a, 0x6a, 0xc8, 0xe1, 0xdf, 0xc3, 0xbc, 0xf9, 0xa0,
+	0xed, 0x04, 0x81, 0xc3, 0xdd, 0xfd, 0x5a, 0x93, 0xd5, 0x3b, 0xad, 0xe8, 0x74, 0xd1, 0x25, 0x98,
+	0x3e, 0xf4, 0x79, 0xdb, 0xf2, 0x98, 0xef, 0xf0, 0xba, 0x0c, 0x36, 0x6a, 0x42, 0x38, 0xb4, 0x27,
+	0x47, 0xe8, 0x05, 0x18, 0xab, 0x49, 0x1e, 0x67, 0xe5, 0x94, 0xfa, 0xa1, 0x1f, 0xc2, 0x42, 0x8e,
+	0x5b, 0xe4, 0xff, 0x00, 0x26, 0x94, 0xcb, 0xe8, 0x30, 0xae, 0xe4, 0x1c, 0xc6, 0xc8, 0x83, 0x0a,
+	0x17, 0x1d, 0x48, 0xc4, 0xea, 0xff, 0x1d, 0x85, 0x73, 0x69, 0x0b, 0xfa, 0x0a, 0x8c, 0xa7, 0xc8,
+	0xe2, 0xaf, 0x30, 0x85, 0x04, 0xc2, 0xf6, 0x85, 0xd5, 0x64, 0x4e, 0xa3, 0xa9, 0xf8, 0x8e, 0x98,
+	0xd3, 0x72, 0xec, 0x6d, 0x39, 0x94, 0xf9, 0x48, 0x18, 0xe9, 0x5b, 0xde, 0xac, 0x47, 0x02, 0x7d,
+	0x17, 0x66, 0x44, 0x78, 0xa0, 0xad, 0x70, 0x71, 0xac, 0x5e, 0x19, 0x1d, 0x68, 0xd7, 0xa6, 0xa5,
+	0x8f, 0xef, 0x48, 0x17, 0xf4, 0x7b, 0x00, 0x3e, 0xab, 0x39, 0x9e, 0xc3, 0x5c, 0x11, 0x54, 0xa6,
+	0xa5, 0x8a, 0x9b, 0x39, 0x2a, 0xbe, 0xe9, 0x04, 0xc2, 0x77, 0x0e, 0x3a, 0xea, 0x15, 0x82, 0x20,
+	0x95, 0x67, 0x94, 0xa2, 0x09, 0x57, 0xf4, 0x03, 0x38, 0x5f, 0x67, 0x47, 0xac, 0xc5, 0x3d, 0xe6,
+	0x5b, 0x47, 0x2c, 0x08, 0x4f, 0x7f, 0x65, 0x72, 0x20, 0xc2, 0xb3, 0xb1, 0xa3, 0xf7, 0x95, 0x1f,
+	0x29, 0x04, 0xb3, 0xdb, 0x96, 0xcf, 0x02, 0xe6, 0x1f, 0xb1, 0xca, 0xd4, 0x80, 0x42, 0x30, 0xbb,
+	0x6d, 0x2a, 0x17, 0xf4, 0x3d, 0x38, 0x57, 0xe3, 0xed, 0x76, 0xc7, 0x75, 0xc4, 0xb1, 0xe5, 0x71,
+	0xde, 0xaa, 0xc0, 0x40, 0x4e, 0x5f, 0x88, 0xbd, 0xec, 0x71, 0xde, 0x7a, 0x38, 0x3a, 0x39, 0x36,
+	0x3b, 0xfe, 0x70, 0x74, 0x72, 0x7c, 0x76, 0xe2, 0xe1, 0xe8, 0xe4, 0xc4, 0xec, 0xa4, 0xbe, 0x8c,
+	0xb7, 0x42, 0x52, 0x4d, 0x29, 0x62, 0xfc, 0x0c, 0xfd, 0x10, 0x96, 0x72, 0x2d, 0xba, 0x89, 0x49,
+	0xee, 0x62, 0xf4, 0x0a, 0xbe, 0x51, 0x62, 0xcb, 0x94, 0x8b, 0xe8, 0x45, 0xac, 0xe0, 0xfa, 0x65,
+	0x8c, 0xb5, 0xc7, 0xdc, 0xba, 0xe3, 0x36, 0xd2, 0x5b, 0xac, 0xe8, 0xb4, 0x61, 0x39, 0xdf, 0x04,
+	0xf9, 0xec, 0x86, 0x5f, 0xa2, 0x9c, 0x1e, 0x94, 0x50, 0x84, 0xd7, 0xef, 0xe2, 0x9b, 0x00, 0xf7,
+	0xda, 0x64, 0x3f, 0xb1, 0xfd, 0xfa, 0xe9, 0x6f, 0x02, 0xbd, 0x0e, 0xf3, 0x99, 0xb8, 0xee, 0x5d,
+	0xe1, 0xab, 0x21, 0x64, 0x98, 0x77, 0x57, 0xa4, 0xf1, 0xdd, 0xe4, 0x25, 0x7f, 0xea, 0x0d, 0xbc,
+	0x93, 0xee, 0xb7, 0x5a, 0xd9, 0x04, 0x87, 0x95, 0x85, 0xbf, 0x24, 0xb0, 0x98, 0x17, 0x29, 0x6b,
+	0x49, 0x23, 0x83, 0x2e, 0x69, 0x78, 0xf9, 0xf8, 0x13, 0x02, 0x57, 0xd3, 0x5b, 0x50, 0x63, 0xce,
+	0x11, 0xf3, 0xcd, 0xa8, 0x7a, 0x8c, 0x34, 0xa2, 0x30, 0x1a, 0xb4, 0xb8, 0xc0, 0xbb, 0x55, 0xfe,
+	0x7f, 0x68, 0x4f, 0xba, 0xbf, 0x11, 0x58, 0x39, 0x85, 0x04, 0xca, 0x67, 0xc2, 0x94, 0x1f, 0x0d,
+	0xa2, 0x80, 0xd5, 0xd3, 0x04, 0x4c, 0xfb, 0x42, 0x25, 0xbb, 0x6e, 0x86, 0xa7, 0xe5, 0xa7, 0x04,
+	0x2e, 0xa5, 0xeb, 0xbe, 0x13, 0xaf, 0xbd, 0x28, 0xa7, 0x62, 0x22, 0x22, 0x32, 0x11, 0xc9, 0x9c,
+	0x8a, 0x79, 0x68, 0x1e, 0xa6, 0x04, 0x4f, 0xe7, 0xa9, 0x49, 0xc1, 0x71, 0x32, 0xad, 0xf6, 0xc8,
+	0xc0, 0x6a, 0xff, 0x99, 0xc0, 0x42, 0x0e, 0xcd, 0x6e, 0x95, 0x1a, 0xb8, 0xb6, 0x17, 0x34, 0xb9,
+	0x88, 0x54, 0x5e, 0xcd, 0x51, 0x39, 0xf6, 0xb1, 0x8f, 0x80, 0x48, 0xdf, 0xd8, 0xc1, 0xf0, 0xf4,
+	0x9d, 0xc7, 0x42, 0xef, 0x1d, 0x3b, 0x10, 0x61, 0x2a, 0xdc, 0xb1, 0x3b, 0xad, 0xa8, 0x18, 0xd3,
+	0x1f, 0x81, 0x96, 0x35, 0x89, 0x2b, 0xba, 0x0b, 0x63, 0x87, 0xe1, 0x00, 0x7e, 0xdc, 0xcb, 0x39,
+	0xab, 0xe9, 0x02, 0x95, 0x79, 0xfc, 0x12, 0xdb, 0x61, 0x2c, 0x7c, 0x8c, 0xed, 0x0b, 0x5b, 0x0c,
+	0xfd, 0xd6, 0xf8, 0x8c, 0xc0, 0x5c, 0x46, 0x90, 0xf8, 0xf1, 0x3e, 0xce, 0x3c, 0x5e, 0x6b, 0x46,
+	0x1b, 0x71, 0x25, 0x87, 0x3a, 0x82, 0x1f, 0x84, 0xb6, 0x51, 0xbe, 0x50, 0xc0, 0xe1, 0x6d, 0x80,
+	0x86, 0x6a, 0x84, 0x32, 0x39, 0x6e, 0x63, 0xcf, 0xee, 0x04, 0xd1, 0x8a, 0xf4, 0x1f, 0xc2, 0x5c,
+	0xc6, 0x1c, 0x2e, 0xe2, 0xdb, 0x30, 0xe6, 0x85, 0x03, 0xa8, 0xd2, 0x95, 0x02, 0xf9, 0x23, 0x2c,
+	0xae, 0x41, 0xe1, 0xb6, 0x7e, 0x7e, 0x09, 0xc6, 0xa4, 0x7b, 0xfa, 0x0b, 0x02, 0xe3, 0xaa, 0x4f,
+	0x44, 0xf3, 0xf2, 0x55, 0x6f, 0x63, 0x4a, 0x5b, 0x2b, 0x63, 0xaa, 0xc8, 0xea, 0x2b, 0x9f, 0xfc,
+	0xfd, 0x3f, 0xbf, 0x3e, 0xbb, 0x44, 0x17, 0x8c, 0xec, 0x2e, 0x98, 0xea, 0x4b, 0xd1, 0xcf, 0x09,
+	0xbc, 0x78, 0xa2, 0xe9, 0x44, 0xb7, 0x8a, 0xc2, 0x64, 0x37, 0xb0, 0xb4, 0xdb, 0x7d, 0x61, 0x90,
+	0xa3, 0x21, 0x39, 0xde, 0xa0, 0xd7, 0x73, 0x38, 0x9e, 0x7c, 0xcd, 0xd2, 0x5f, 0x11, 0x98, 0x8a,
+	0xbf, 0x55, 0x7a, 0xab, 0x28, 0xe6, 0xc9, 0x76, 0x96, 0xb6, 0x5e, 0xd2, 0x1a, 0xb9, 0xad, 0x4a,
+	0x6e, 0x3a, 0x5d, 0xce, 0xe1, 0x16, 0xf7, 0xaf, 0xe8, 0x6f, 0x08, 0x40, 0xb7, 0xfd, 0x44, 0x0b,
+	0xe3, 0xf4, 0xf4, 0xb8, 0xb4, 0x6a, 0x59, 0x73, 0xe4, 0xb5, 0x26, 0x79, 0x5d, 0xa5, 0x7a, 0x0e,
+	0xaf, 0x40, 0x41, 0x2c, 0xdb, 0xf3, 0xe9, 0x9f, 0x08, 0xcc, 0x24, 0xfb, 0x49, 0xd4, 0x28, 0x0a,
+	0x96, 0xd1, 0xe5, 0xd2, 0x36, 0xca, 0x03, 0x90, 0xdf, 0xeb, 0x92, 0xdf, 0x37, 0xe9, 0x6b, 0x39,
+	0xfc, 0x12, 0x4d, 0x33, 0xcf, 0x37, 0x9e, 0xf4, 0xf4, 0xd0, 0x3e, 0x96, 0x7a, 0x76, 0x7b, 0x48,
+	0xc5, 0x7a, 0xf6, 0xb4, 0xb3, 0xb4, 0x6a, 0x59, 0xf3, 0x92, 0x7a, 0xaa, 0xb2, 0xe7, 0x40, 0x52,
+	0xf9, 0x8c, 0xc0, 0xb9, 0x74, 0xbf, 0x86, 0x6e, 0x16, 0x9e, 0xfb, 0xac, 0xe6, 0x92, 0xb6, 0xd5,
+	0x0f, 0x04, 0x59, 0x56, 0x25, 0xcb, 0x55, 0x7a, 0x2d, 0xef, 0x4b, 0x09, 0x61, 0xc6, 0x93, 0x58,
+	0xc3, 0xdf, 0x12, 0x98, 0x4e, 0x34, 0x51, 0x68, 0xf5, 0xb4, 0x98, 0xe9, 0x1c, 0xaf, 0x19, 0xa5,
+	0xed, 0x91, 0xe0, 0x4d, 0x49, 0x70, 0x85, 0x5e, 0x29, 0x20, 0x68, 0x35, 0x91, 0x4d, 0xc8, 0x2e,
+	0xd1, 0x1f, 0xa1, 0xa7, 0xef, 0x59, 0xaa, 0xb3, 0xa0, 0x19, 0xa5, 0xed, 0x4b, 0xb2, 0x0b, 0xa4,
+	0xb9, 0xda, 0xeb, 0xf0, 0xab, 0x39, 0xdf, 0xd3, 0x38, 0xa1, 0x77, 0x8a, 0x62, 0xe6, 0x35, 0x62,
+	0xb4, 0x57, 0xfb, 0x44, 0x21, 0xdf, 0x4d, 0xc9, 0xf7, 0x26, 0xbd, 0x51, 0xcc, 0xb7, 0xd6, 0x75,
+	0x10, 0xef, 0xb8, 0x28, 0xa3, 0x69, 0x6f, 0xb7, 0x46, 0x33, 0x4a, 0xdb, 0xf7, 0xa7, 0xa9, 0xfa,
+	0x72, 0xbe, 0x20, 0x30, 0x7b, 0xb2, 0x99, 0x42, 0x0b, 0x73, 0x46, 0x4e, 0x47, 0x47, 0xbb, 0xd3,
+	0x1f, 0x08, 0xc9, 0x6e, 0x48, 0xb2, 0x6b, 0x74, 0x35, 0x87, 0x2c, 0x43, 0xa0, 0x15, 0x44, 0xe4,
+	0xfe, 0x42, 0x80, 0xf6, 0x96, 0x8c, 0xb4, 0x70, 0x43, 0x73, 0x0b, 0x6b, 0xed, 0x6e, 0xbf, 0x30,
+	0xe4, 0xbd, 0x25, 0x79, 0xdf, 0xa2, 0x6b, 0x39, 0xbc, 0xeb, 0x09, 0xa8, 0xa5, 0x0a, 0x6b, 0xfa,
+	0x57, 0x02, 0x2f, 0x65, 0x54, 0xcc, 0xb4, 0x90, 0x43, 0x7e, 0x15, 0xae, 0x7d, 0xa3, 0x6f, 0x1c,
+	0x92, 0xbf, 0x2d, 0xc9, 0xaf, 0xd3, 0x9b, 0x79, 0x4f, 0x10, 0x85, 0xb5, 0x92, 0x8b, 0xa0, 0x7f,
+	0x24, 0x70, 0x2e, 0x5d, 0x35, 0x16, 0xdf, 0xb1, 0x99, 0xb5, 0xb0, 0xb6, 0xd5, 0x0f, 0x04, 0xe9,
+	0xbe, 0x26, 0xe9, 0x6e, 0xd1, 0x8d, 0xbc, 0xcc, 0xa5, 0x60, 0x16, 0x56, 0xaf, 0x89, 0xdb, 0xf6,
+	0x4b, 0x02, 0xe7, 0x7b, 0x8a, 0xe5, 0xe2, 0x1b, 0x23, 0xaf, 0x8a, 0xd7, 0x5e, 0xed, 0x13, 0x55,
+	0x32, 0x41, 0x9c, 0x20, 0x4f, 0xff, 0x41, 0xa0, 0x92, 0x57, 0xa7, 0xd2, 0x7b, 0xa5, 0xd4, 0xcb,
+	0x2e, 0xb1, 0xb5, 0x6f, 0x0d, 0x06, 0x2e, 0xfb, 0x7c, 0x88, 0xd7, 0xa1, 0x3c, 0x58, 0x71, 0x05,
+	0x6c, 0x3c, 0x09, 0xab, 0xf9, 0x8f, 0xe5, 0x55, 0x73, 0xb2, 0x26, 0x2c, 0xbe, 0x6a, 0x72, 0x0a,
+	0x5d, 0xed, 0x4e, 0x7f, 0xa0, 0x92, 0x57, 0x4d, 0xfc, 0x70, 0x8c, 0xd3, 0xe1, 0xa7, 0x04, 0x5e,
+	0x48, 0x15, 0x7c, 0xb4, 0xf0, 0xd9, 0x95, 0x55, 0x38, 0x6a, 0x9b, 0x7d, 0x20, 0x4a, 0x1e, 0x99,
+	0x96, 0x1d, 0x08, 0xd9, 0xef, 0xb5, 0x64, 0x15, 0x49, 0x7f, 0x4f, 0x60, 0x26, 0x59, 0xdc, 0x15,
+	0xbf, 0x26, 0x33, 0x6a, 0x4d, 0x6d, 0xa3, 0x3c, 0x00, 0x39, 0xae, 0x4b, 0x8e, 0xd7, 0xe9, 0x4a,
+	0x0e, 0xc7, 0x43, 0xc6, 0xe4, 0xdb, 0xcc, 0x0a, 0x24, 0xa3, 0xdf, 0x11, 0x98, 0x49, 0x96, 0x5f,
+	0xc5, 0x14, 0x33, 0x0a, 0x40, 0x6d, 0xa3, 0x3c, 0x00, 0x29, 0xde, 0x92, 0x14, 0xaf, 0xd1, 0xab,
+	0x46, 0xfe, 0x9f, 0x1b, 0x84, 0x27, 0x56, 0x96, 0x80, 0xdb, 0xbb, 0x5f, 0x3d, 0x5b, 0x24, 0x5f,
+	0x3f, 0x5b, 0x24, 0xff, 0x7e, 0xb6, 0x48, 0x7e, 0xf9, 0x7c, 0xf1, 0xcc, 0xd7, 0xcf, 0x17, 0xcf,
+	0xfc, 0xf3, 0xf9, 0xe2, 0x99, 0x1f, 0x18, 0x89, 0x36, 0xef, 0xa3, 0x07, 0xe6, 0xee, 0xa3, 0xef,
+	0x9a, 0xbb, 0xb1, 0xcb, 0xf5, 0x5a, 0xd3, 0x76, 0x5c, 0xe3, 0xa7, 0xca, 0xb5, 0xec, 0xf9, 0x1e,
+	0x8c, 0xcb, 0xbf, 0x61, 0xb8, 0xfd, 0xbf, 0x01, 0x00, 0x1a, 0x37, 0x21, 0x96, 0x81, 0x21, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlockProvisions current minting epoch provisions value.
+	BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// StakingAPR returns the current staking APR value.
+	StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error)
+	// ValidatorAPR returns the current staking APR of the delegators of a
+	// validator, net of its commission.
+	ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error)
+	// TotalBurnt returns the cumulative amount of burnt tokens per denom.
+	TotalBurnt(ctx context.Context, in *QueryTotalBurntRequest, opts ...grpc.CallOption) (*QueryTotalBurntResponse, error)
+	// BurntByAddress returns the cumulative amount of tokens burnt by an
+	// account.
+	BurntByAddress(ctx context.Context, in *QueryBurntByAddressRequest, opts ...grpc.CallOption) (*QueryBurntByAddressResponse, error)
+	// BurnHistory returns the history of burns ordered by height.
+	BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error)
+	// TotalSupply returns the total supply of the mint denom.
+	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// CirculatingSupply returns the supply of the mint denom that is not
+	// locked.
+	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// BurntSupply returns the cumulative amount of the mint denom burnt.
+	BurntSupply(ctx context.Context, in *QueryBurntSupplyRequest, opts ...grpc.CallOption) (*QueryBurntSupplyResponse, error)
+	// EmissionSchedule returns the projected emissions of the upcoming
+	// reduction periods.
+	EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error)
+	// DistributionTotals returns the cumulative amounts minted and sent to each
+	// distribution bucket.
+	DistributionTotals(ctx context.Context, in *QueryDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryDistributionTotalsResponse, error)
+	// PendingDistribution returns the amounts allocated in the current
+	// distribution epoch and not distributed yet.
+	PendingDistribution(ctx context.Context, in *QueryPendingDistributionRequest, opts ...grpc.CallOption) (*QueryPendingDistributionResponse, error)
+	// VestingRewards returns the claimable and claimed developer rewards of a
+	// receiver.
+	VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error)
+	// AllVestingRewards returns the claimable and claimed developer rewards of
+	// all receivers.
+	AllVestingRewards(ctx context.Context, in *QueryAllVestingRewardsRequest, opts ...grpc.CallOption) (*QueryAllVestingRewardsResponse, error)
+	// VestingReceiverRotations returns the rotation history of a developer
+	// rewards receiver slot.
+	VestingReceiverRotations(ctx context.Context, in *QueryVestingReceiverRotationsRequest, opts ...grpc.CallOption) (*QueryVestingReceiverRotationsResponse, error)
+	// InflationHistory returns the inflation snapshots ordered by height.
+	InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error)
+	// LastMintFault returns the last failure to mint or distribute the minted
+	// coins, if any.
+	LastMintFault(ctx context.Context, in *QueryLastMintFaultRequest, opts ...grpc.CallOption) (*QueryLastMintFaultResponse, error)
+	// FeeBurnStats returns the transaction fees collected and burnt in every fee
+	// burn statistics epoch, ordered by start height.
+	FeeBurnStats(ctx context.Context, in *QueryFeeBurnStatsRequest, opts ...grpc.CallOption) (*QueryFeeBurnStatsResponse, error)
+	// MintingPause returns the state of the minting circuit breaker.
+	MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error) {
+	out := new(QueryBlockProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/BlockProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error) {
+	out := new(QueryStakingAPRResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/StakingAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAPR(ctx context.Context, in *QueryValidatorAPRRequest, opts ...grpc.CallOption) (*QueryValidatorAPRResponse, error) {
+	out := new(QueryValidatorAPRResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/ValidatorAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurnt(ctx context.Context, in *QueryTotalBurntRequest, opts ...grpc.CallOption) (*QueryTotalBurntResponse, error) {
+	out := new(QueryTotalBurntResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/TotalBurnt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurntByAddress(ctx context.Context, in *QueryBurntByAddressRequest, opts ...grpc.CallOption) (*QueryBurntByAddressResponse, error) {
+	out := new(QueryBurntByAddressResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/BurntByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnHistory(ctx context.Context, in *QueryBurnHistoryRequest, opts ...grpc.CallOption) (*QueryBurnHistoryResponse, error) {
+	out := new(QueryBurnHistoryResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/BurnHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error) {
+	out := new(QueryTotalSupplyResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/TotalSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error) {
+	out := new(QueryCirculatingSupplyResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/CirculatingSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurntSupply(ctx context.Context, in *QueryBurntSupplyRequest, opts ...grpc.CallOption) (*QueryBurntSupplyResponse, error) {
+	out := new(QueryBurntSupplyResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/BurntSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error) {
+	out := new(QueryEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/EmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionTotals(ctx context.Context, in *QueryDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryDistributionTotalsResponse, error) {
+	out := new(QueryDistributionTotalsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/DistributionTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDistribution(ctx context.Context, in *QueryPendingDistributionRequest, opts ...grpc.CallOption) (*QueryPendingDistributionResponse, error) {
+	out := new(QueryPendingDistributionResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/PendingDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error) {
+	out := new(QueryVestingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/VestingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllVestingRewards(ctx context.Context, in *QueryAllVestingRewardsRequest, opts ...grpc.CallOption) (*QueryAllVestingRewardsResponse, error) {
+	out := new(QueryAllVestingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/AllVestingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingReceiverRotations(ctx context.Context, in *QueryVestingReceiverRotationsRequest, opts ...grpc.CallOption) (*QueryVestingReceiverRotationsResponse, error) {
+	out := new(QueryVestingReceiverRotationsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/VestingReceiverRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationHistory(ctx context.Context, in *QueryInflationHistoryRequest, opts ...grpc.CallOption) (*QueryInflationHistoryResponse, error) {
+	out := new(QueryInflationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/InflationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastMintFault(ctx context.Context, in *QueryLastMintFaultRequest, opts ...grpc.CallOption) (*QueryLastMintFaultResponse, error) {
+	out := new(QueryLastMintFaultResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/LastMintFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeBurnStats(ctx context.Context, in *QueryFeeBurnStatsRequest, opts ...grpc.CallOption) (*QueryFeeBurnStatsResponse, error) {
+	out := new(QueryFeeBurnStatsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/FeeBurnStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error) {
+	out := new(QueryMintingPauseResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Query/MintingPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlockProvisions current minting epoch provisions value.
+	BlockProvisions(context.Context, *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// StakingAPR returns the current staking APR value.
+	StakingAPR(context.Context, *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error)
+	// ValidatorAPR returns the current staking APR of the delegators of a
+	// validator, net of its commission.
+	ValidatorAPR(context.Context, *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error)
+	// TotalBurnt returns the cumulative amount of burnt tokens per denom.
+	TotalBurnt(context.Context, *QueryTotalBurntRequest) (*QueryTotalBurntResponse, error)
+	// BurntByAddress returns the cumulative amount of tokens burnt by an
+	// account.
+	BurntByAddress(context.Context, *QueryBurntByAddressRequest) (*QueryBurntByAddressResponse, error)
+	// BurnHistory returns the history of burns ordered by height.
+	BurnHistory(context.Context, *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error)
+	// TotalSupply returns the total supply of the mint denom.
+	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// CirculatingSupply returns the supply of the mint denom that is not
+	// locked.
+	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// BurntSupply returns the cumulative amount of the mint denom burnt.
+	BurntSupply(context.Context, *QueryBurntSupplyRequest) (*QueryBurntSupplyResponse, error)
+	// EmissionSchedule returns the projected emissions of the upcoming
+	// reduction periods.
+	EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error)
+	// DistributionTotals returns the cumulative amounts minted and sent to each
+	// distribution bucket.
+	DistributionTotals(context.Context, *QueryDistributionTotalsRequest) (*QueryDistributionTotalsResponse, error)
+	// PendingDistribution returns the amounts allocated in the current
+	// distribution epoch and not distributed yet.
+	PendingDistribution(context.Context, *QueryPendingDistributionRequest) (*QueryPendingDistributionResponse, error)
+	// VestingRewards returns the claimable and claimed developer rewards of a
+	// receiver.
+	VestingRewards(context.Context, *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error)
+	// AllVestingRewards returns the claimable and claimed developer rewards of
+	// all receivers.
+	AllVestingRewards(context.Context, *QueryAllVestingRewardsRequest) (*QueryAllVestingRewardsResponse, error)
+	// VestingReceiverRotations returns the rotation history of a developer
+	// rewards receiver slot.
+	VestingReceiverRotations(context.Context, *QueryVestingReceiverRotationsRequest) (*QueryVestingReceiverRotationsResponse, error)
+	// InflationHistory returns the inflation snapshots ordered by height.
+	InflationHistory(context.Context, *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error)
+	// LastMintFault returns the last failure to mint or distribute the minted
+	// coins, if any.
+	LastMintFault(context.Context, *QueryLastMintFaultRequest) (*QueryLastMintFaultResponse, error)
+	// FeeBurnStats returns the transaction fees collected and burnt in every fee
+	// burn statistics epoch, ordered by start height.
+	FeeBurnStats(context.Context, *QueryFeeBurnStatsRequest) (*QueryFeeBurnStatsResponse, error)
+	// MintingPause returns the state of the minting circuit breaker.
+	MintingPause(context.Context, *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BlockProvisions(ctx context.Context, req *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProvisions not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) StakingAPR(ctx context.Context, req *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingAPR not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAPR(ctx context.Context, req *QueryValidatorAPRRequest) (*QueryValidatorAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAPR not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurnt(ctx context.Context, req *QueryTotalBurntRequest) (*QueryTotalBurntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurnt not implemented")
+}
+func (*UnimplementedQueryServer) BurntByAddress(ctx context.Context, req *QueryBurntByAddressRequest) (*QueryBurntByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurntByAddress not implemented")
+}
+func (*UnimplementedQueryServer) BurnHistory(ctx context.Context, req *QueryBurnHistoryRequest) (*QueryBurnHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnHistory not implemented")
+}
+func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
+}
+func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
+}
+func (*UnimplementedQueryServer) BurntSupply(ctx context.Context, req *QueryBurntSupplyRequest) (*QueryBurntSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurntSupply not implemented")
+}
+func (*UnimplementedQueryServer) EmissionSchedule(ctx context.Context, req *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
+func (*UnimplementedQueryServer) DistributionTotals(ctx context.Context, req *QueryDistributionTotalsRequest) (*QueryDistributionTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionTotals not implemented")
+}
+func (*UnimplementedQueryServer) PendingDistribution(ctx context.Context, req *QueryPendingDistributionRequest) (*QueryPendingDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDistribution not implemented")
+}
+func (*UnimplementedQueryServer) VestingRewards(ctx context.Context, req *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingRewards not implemented")
+}
+func (*UnimplementedQueryServer) AllVestingRewards(ctx context.Context, req *QueryAllVestingRewardsRequest) (*QueryAllVestingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllVestingRewards not implemented")
+}
+func (*UnimplementedQueryServer) VestingReceiverRotations(ctx context.Context, req *QueryVestingReceiverRotationsRequest) (*QueryVestingReceiverRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingReceiverRotations not implemented")
+}
+func (*UnimplementedQueryServer) InflationHistory(ctx context.Context, req *QueryInflationHistoryRequest) (*QueryInflationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationHistory not implemented")
+}
+func (*UnimplementedQueryServer) LastMintFault(ctx context.Context, req *QueryLastMintFaultRequest) (*QueryLastMintFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastMintFault not implemented")
+}
+func (*UnimplementedQueryServer) FeeBurnStats(ctx context.Context, req *QueryFeeBurnStatsRequest) (*QueryFeeBurnStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeBurnStats not implemented")
+}
+func (*UnimplementedQueryServer) MintingPause(ctx context.Context, req *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingPause not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/BlockProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProvisions(ctx, req.(*QueryBlockProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/StakingAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingAPR(ctx, req.(*QueryStakingAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/ValidatorAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAPR(ctx, req.(*QueryValidatorAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurnt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/TotalBurnt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurnt(ctx, req.(*QueryTotalBurntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurntByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurntByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurntByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/BurntByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurntByAddress(ctx, req.(*QueryBurntByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/BurnHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnHistory(ctx, req.(*QueryBurnHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/TotalSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSupply(ctx, req.(*QueryTotalSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/CirculatingSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupply(ctx, req.(*QueryCirculatingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurntSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurntSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurntSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/BurntSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurntSupply(ctx, req.(*QueryBurntSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/EmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*QueryEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/DistributionTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionTotals(ctx, req.(*QueryDistributionTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/PendingDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDistribution(ctx, req.(*QueryPendingDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/VestingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingRewards(ctx, req.(*QueryVestingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllVestingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVestingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllVestingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/AllVestingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllVestingRewards(ctx, req.(*QueryAllVestingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingReceiverRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingReceiverRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingReceiverRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/VestingReceiverRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingReceiverRotations(ctx, req.(*QueryVestingReceiverRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/InflationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationHistory(ctx, req.(*QueryInflationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastMintFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastMintFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastMintFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/LastMintFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastMintFault(ctx, req.(*QueryLastMintFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeBurnStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeBurnStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeBurnStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/FeeBurnStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeBurnStats(ctx, req.(*QueryFeeBurnStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintingPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Query/MintingPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingPause(ctx, req.(*QueryMintingPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "teritori.mint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BlockProvisions",
+			Handler:    _Query_BlockProvisions_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "StakingAPR",
+			Handler:    _Query_StakingAPR_Handler,
+		},
+		{
+			MethodName: "ValidatorAPR",
+			Handler:    _Query_ValidatorAPR_Handler,
+		},
+		{
+			MethodName: "TotalBurnt",
+			Handler:    _Query_TotalBurnt_Handler,
+		},
+		{
+			MethodName: "BurntByAddress",
+			Handler:    _Query_BurntByAddress_Handler,
+		},
+		{
+			MethodName: "BurnHistory",
+			Handler:    _Query_BurnHistory_Handler,
+		},
+		{
+			MethodName: "TotalSupply",
+			Handler:    _Query_TotalSupply_Handler,
+		},
+		{
+			MethodName: "CirculatingSupply",
+			Handler:    _Query_CirculatingSupply_Handler,
+		},
+		{
+			MethodName: "BurntSupply",
+			Handler:    _Query_BurntSupply_Handler,
+		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
+		{
+			MethodName: "DistributionTotals",
+			Handler:    _Query_DistributionTotals_Handler,
+		},
+		{
+			MethodName: "PendingDistribution",
+			Handler:    _Query_PendingDistribution_Handler,
+		},
+		{
+			MethodName: "VestingRewards",
+			Handler:    _Query_VestingRewards_Handler,
+		},
+		{
+			MethodName: "AllVestingRewards",
+			Handler:    _Query_AllVestingRewards_Handler,
+		},
+		{
+			MethodName: "VestingReceiverRotations",
+			Handler:    _Query_VestingReceiverRotations_Handler,
+		},
+		{
+			MethodName: "InflationHistory",
+			Handler:    _Query_InflationHistory_Handler,
+		},
+		{
+			MethodName: "LastMintFault",
+			Handler:    _Query_LastMintFault_Handler,
+		},
+		{
+			MethodName: "FeeBurnStats",
+			Handler:    _Query_FeeBurnStats_Handler,
+		},
+		{
+			MethodName: "MintingPause",
+			Handler:    _Query_MintingPause_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "teritori/mint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeFees {
+		i--
+		if m.IncludeFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeFees {
+		i--
+		if m.IncludeFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurntRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurntRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurntRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurntResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurntResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurntResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TeamReserve.Size()
+		i -= size
+		if _, err := m.TeamReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DeveloperVesting.Size()
+		i -= size
+		if _, err := m.DeveloperVesting.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVestingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVestingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVestingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingReceiverRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingReceiverRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingReceiverRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingReceiverRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingReceiverRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingReceiverRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastMintFaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastMintFaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastMintFaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastMintFaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastMintFaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastMintFaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fault != nil {
+		{
+			size, err := m.Fault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBurnStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBurnStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBurnStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBurnStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBurnStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBurnStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintingPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintingPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintingPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintingPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintingPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintingPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeFees {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStakingAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeFees {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalBurntRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalBurntResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCirculatingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurntSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurntSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.FromPeriod))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DeveloperVesting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TeamReserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pending.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVestingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingReceiverRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovQuery(uint64(m.Slot))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingReceiverRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastMintFaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastMintFaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fault != nil {
+		l = m.Fault.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeBurnStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeBurnStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintingPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintingPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFees = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFees = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurntRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurntRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurntRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalBurntResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalBurntResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalBurntResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BurnRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPeriod", wireType)
+			}
+			m.FromPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, EmissionPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperVesting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TeamReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, DistributionRecipientTotal{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, VestingRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingReceiverRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingReceiverRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingReceiverRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingReceiverRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingReceiverRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingReceiverRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, VestingReceiverRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStakingAPRResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Apr.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -935,12 +8322,86 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -962,7 +8423,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -985,15 +8446,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1020,7 +8481,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, InflationSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1045,7 +8543,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastMintFaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1068,10 +8566,10 @@ func (m *QueryBlockProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastMintFaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastMintFaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1095,7 +8593,7 @@ func (m *QueryBlockProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastMintFaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1118,17 +8616,17 @@ func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastMintFaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastMintFaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fault", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1138,22 +8636,25 @@ func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fault == nil {
+				m.Fault = &MintFault{}
+			}
+			if err := m.Fault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1178,7 +8679,7 @@ func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeBurnStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,12 +8702,48 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeBurnStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeBurnStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1228,7 +8765,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeBurnStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1251,17 +8788,17 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeBurnStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeBurnStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1271,22 +8808,59 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Epochs = append(m.Epochs, FeeBurnEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1311,7 +8885,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingAPRRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintingPauseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,10 +8908,10 @@ func (m *QueryStakingAPRRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingAPRRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintingPauseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintingPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1361,7 +8935,7 @@ func (m *QueryStakingAPRRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingAPRResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintingPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1384,17 +8958,17 @@ func (m *QueryStakingAPRResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingAPRResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintingPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintingPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1404,22 +8978,22 @@ func (m *QueryStakingAPRResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
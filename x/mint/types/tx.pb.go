@@ -113,34 +113,447 @@ func (m *MsgBurnTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnTokensResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines an sdk.Msg type that updates the mint parameters
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/mint parameters to update.
+	// NOTE: all parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgClaimVestedRewards defines an sdk.Msg type that pays the vested rewards
+// of a developer rewards receiver
+type MsgClaimVestedRewards struct {
+	// receiver is the developer rewards receiver address
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MsgClaimVestedRewards) Reset()         { *m = MsgClaimVestedRewards{} }
+func (m *MsgClaimVestedRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedRewards) ProtoMessage()    {}
+func (*MsgClaimVestedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{4}
+}
+func (m *MsgClaimVestedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVestedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedRewards.Merge(m, src)
+}
+func (m *MsgClaimVestedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedRewards proto.InternalMessageInfo
+
+func (m *MsgClaimVestedRewards) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// MsgClaimVestedRewardsResponse defines the Msg/ClaimVestedRewards response
+// type.
+type MsgClaimVestedRewardsResponse struct {
+	// amount claimed
+	Amount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *MsgClaimVestedRewardsResponse) Reset()         { *m = MsgClaimVestedRewardsResponse{} }
+func (m *MsgClaimVestedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimVestedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{5}
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedRewardsResponse proto.InternalMessageInfo
+
+// MsgRotateVestingReceiver defines an sdk.Msg type that moves the future
+// monthly amounts of a developer rewards receiver to a new address
+type MsgRotateVestingReceiver struct {
+	// receiver is the current developer rewards receiver address
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// new_receiver is the address receiving the future monthly amounts
+	NewReceiver string `protobuf:"bytes,2,opt,name=new_receiver,json=newReceiver,proto3" json:"new_receiver,omitempty"`
+}
+
+func (m *MsgRotateVestingReceiver) Reset()         { *m = MsgRotateVestingReceiver{} }
+func (m *MsgRotateVestingReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateVestingReceiver) ProtoMessage()    {}
+func (*MsgRotateVestingReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{6}
+}
+func (m *MsgRotateVestingReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateVestingReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateVestingReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateVestingReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateVestingReceiver.Merge(m, src)
+}
+func (m *MsgRotateVestingReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateVestingReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateVestingReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateVestingReceiver proto.InternalMessageInfo
+
+func (m *MsgRotateVestingReceiver) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgRotateVestingReceiver) GetNewReceiver() string {
+	if m != nil {
+		return m.NewReceiver
+	}
+	return ""
+}
+
+// MsgRotateVestingReceiverResponse defines the Msg/RotateVestingReceiver
+// response type.
+type MsgRotateVestingReceiverResponse struct {
+	// slots rotated to the new receiver
+	Slots []uint64 `protobuf:"varint,1,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (m *MsgRotateVestingReceiverResponse) Reset()         { *m = MsgRotateVestingReceiverResponse{} }
+func (m *MsgRotateVestingReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateVestingReceiverResponse) ProtoMessage()    {}
+func (*MsgRotateVestingReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{7}
+}
+func (m *MsgRotateVestingReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateVestingReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateVestingReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateVestingReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateVestingReceiverResponse.Merge(m, src)
+}
+func (m *MsgRotateVestingReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateVestingReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateVestingReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateVestingReceiverResponse proto.InternalMessageInfo
+
+func (m *MsgRotateVestingReceiverResponse) GetSlots() []uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+// MsgSetMintingPaused defines an sdk.Msg type that pauses or resumes minting
+// and distribution
+type MsgSetMintingPaused struct {
+	// authority is the address of the governance account or of the guardian.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused is whether minting is paused
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// extend_schedule, on resume, delays the reduction schedule and the vesting
+	// months by the pause, so it does not consume schedule time
+	ExtendSchedule bool `protobuf:"varint,3,opt,name=extend_schedule,json=extendSchedule,proto3" json:"extend_schedule,omitempty"`
+}
+
+func (m *MsgSetMintingPaused) Reset()         { *m = MsgSetMintingPaused{} }
+func (m *MsgSetMintingPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintingPaused) ProtoMessage()    {}
+func (*MsgSetMintingPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{8}
+}
+func (m *MsgSetMintingPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintingPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintingPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintingPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintingPaused.Merge(m, src)
+}
+func (m *MsgSetMintingPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintingPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintingPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintingPaused proto.InternalMessageInfo
+
+func (m *MsgSetMintingPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMintingPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetMintingPaused) GetExtendSchedule() bool {
+	if m != nil {
+		return m.ExtendSchedule
+	}
+	return false
+}
+
+// MsgSetMintingPausedResponse defines the Msg/SetMintingPaused response type.
+type MsgSetMintingPausedResponse struct {
+}
+
+func (m *MsgSetMintingPausedResponse) Reset()         { *m = MsgSetMintingPausedResponse{} }
+func (m *MsgSetMintingPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintingPausedResponse) ProtoMessage()    {}
+func (*MsgSetMintingPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf5271f1525b13, []int{9}
+}
+func (m *MsgSetMintingPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintingPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintingPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintingPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintingPausedResponse.Merge(m, src)
+}
+func (m *MsgSetMintingPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintingPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintingPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintingPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBurnTokens)(nil), "teritori.mint.v1beta1.MsgBurnTokens")
 	proto.RegisterType((*MsgBurnTokensResponse)(nil), "teritori.mint.v1beta1.MsgBurnTokensResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "teritori.mint.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "teritori.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgClaimVestedRewards)(nil), "teritori.mint.v1beta1.MsgClaimVestedRewards")
+	proto.RegisterType((*MsgClaimVestedRewardsResponse)(nil), "teritori.mint.v1beta1.MsgClaimVestedRewardsResponse")
+	proto.RegisterType((*MsgRotateVestingReceiver)(nil), "teritori.mint.v1beta1.MsgRotateVestingReceiver")
+	proto.RegisterType((*MsgRotateVestingReceiverResponse)(nil), "teritori.mint.v1beta1.MsgRotateVestingReceiverResponse")
+	proto.RegisterType((*MsgSetMintingPaused)(nil), "teritori.mint.v1beta1.MsgSetMintingPaused")
+	proto.RegisterType((*MsgSetMintingPausedResponse)(nil), "teritori.mint.v1beta1.MsgSetMintingPausedResponse")
 }
 
 func init() { proto.RegisterFile("teritori/mint/v1beta1/tx.proto", fileDescriptor_f2bf5271f1525b13) }
 
 var fileDescriptor_f2bf5271f1525b13 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x6a, 0xf3, 0x30,
-	0x14, 0xc5, 0xed, 0x2f, 0x10, 0x88, 0xe0, 0x5b, 0x4c, 0xd3, 0xa6, 0x19, 0x94, 0x10, 0x0a, 0xcd,
-	0xd0, 0xe8, 0x92, 0xf6, 0x0d, 0x52, 0x4a, 0xf1, 0x10, 0x0a, 0xc6, 0x53, 0xa7, 0xda, 0x8e, 0xaa,
-	0x88, 0xd4, 0xba, 0xc6, 0x92, 0x4b, 0xf2, 0x16, 0x7d, 0xac, 0x8c, 0x19, 0x4b, 0x87, 0x50, 0xec,
-	0x17, 0x29, 0xf1, 0x1f, 0x48, 0xa0, 0x43, 0x27, 0xe9, 0xf2, 0x3b, 0x3a, 0x47, 0x47, 0x22, 0xd4,
-	0xf0, 0x54, 0x1a, 0x4c, 0x25, 0xc4, 0x52, 0x19, 0x78, 0x9f, 0x86, 0xdc, 0x04, 0x53, 0x30, 0x6b,
-	0x96, 0xa4, 0x68, 0xd0, 0xe9, 0x36, 0x9c, 0x1d, 0x38, 0xab, 0x79, 0xff, 0x4c, 0xa0, 0xc0, 0x52,
-	0x01, 0x87, 0x5d, 0x25, 0xee, 0x5f, 0x0a, 0x44, 0xf1, 0xc6, 0xa1, 0x9c, 0xc2, 0xec, 0x15, 0x02,
-	0xb5, 0xa9, 0xd0, 0x28, 0x21, 0xff, 0xe7, 0x5a, 0xcc, 0xb2, 0x54, 0xf9, 0xb8, 0xe2, 0x4a, 0x3b,
-	0xe7, 0xa4, 0xad, 0xb9, 0x5a, 0xf0, 0xb4, 0x67, 0x0f, 0xed, 0x71, 0xc7, 0xab, 0x27, 0xe7, 0x91,
-	0xb4, 0x83, 0x18, 0x33, 0x65, 0x7a, 0xff, 0x86, 0xad, 0x71, 0x67, 0x06, 0xdb, 0xfd, 0xc0, 0xfa,
-	0xda, 0x0f, 0xae, 0x85, 0x34, 0xcb, 0x2c, 0x64, 0x11, 0xc6, 0x10, 0xa1, 0x8e, 0x51, 0xd7, 0xcb,
-	0x44, 0x2f, 0x56, 0x60, 0x36, 0x09, 0xd7, 0xec, 0x1e, 0xa5, 0xf2, 0xea, 0xe3, 0xa3, 0x0b, 0xd2,
-	0x3d, 0x49, 0xf4, 0xb8, 0x4e, 0x50, 0x69, 0x7e, 0x2b, 0x48, 0x6b, 0xae, 0x85, 0xf3, 0x42, 0xc8,
-	0xd1, 0x75, 0xae, 0xd8, 0xaf, 0x45, 0xd9, 0x89, 0x45, 0xff, 0xe6, 0x2f, 0xaa, 0x26, 0x68, 0xe6,
-	0x6e, 0x73, 0x6a, 0xef, 0x72, 0x6a, 0x7f, 0xe7, 0xd4, 0xfe, 0x28, 0xa8, 0xb5, 0x2b, 0xa8, 0xf5,
-	0x59, 0x50, 0xeb, 0x19, 0x8e, 0xca, 0xf8, 0x0f, 0x9e, 0xeb, 0x3f, 0x79, 0x2e, 0x34, 0xd6, 0x93,
-	0x68, 0x19, 0x48, 0x05, 0xeb, 0xea, 0x47, 0xca, 0x66, 0x61, 0xbb, 0x7c, 0xc5, 0xbb, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x63, 0xdd, 0xd9, 0x2d, 0xaf, 0x01, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x02, 0x6e, 0xe0, 0x81, 0x62, 0x2a, 0x3f, 0x96, 0x2a, 0x65, 0x6d, 0x8c, 0x10, 0x23,
+	0x9d, 0x00, 0x26, 0x9a, 0x78, 0x5b, 0x62, 0x0c, 0x87, 0x46, 0x52, 0xd0, 0x44, 0x2f, 0x38, 0xbb,
+	0x7d, 0x74, 0x1b, 0xb6, 0x33, 0x4d, 0x67, 0x0a, 0xcb, 0xd1, 0xff, 0xc0, 0x7f, 0xc9, 0x1b, 0x47,
+	0x8e, 0xc6, 0x03, 0x31, 0xf0, 0x8f, 0x98, 0xce, 0xb6, 0x75, 0x17, 0xba, 0xb8, 0xc4, 0x53, 0xfb,
+	0xe6, 0x7d, 0xdf, 0xfb, 0xbe, 0x79, 0xf3, 0x66, 0xc0, 0x94, 0x18, 0x07, 0x92, 0xc7, 0x01, 0x09,
+	0x03, 0x26, 0xc9, 0xf1, 0x46, 0x13, 0x25, 0xdd, 0x20, 0xb2, 0x6b, 0x47, 0x31, 0x97, 0x5c, 0x9f,
+	0xcf, 0xf3, 0x76, 0x9a, 0xb7, 0xb3, 0xbc, 0x31, 0xe7, 0x73, 0x9f, 0x2b, 0x04, 0x49, 0xff, 0x7a,
+	0x60, 0x63, 0xc9, 0xe7, 0xdc, 0xef, 0x20, 0x51, 0x51, 0x33, 0x39, 0x24, 0x94, 0x9d, 0x66, 0xa9,
+	0x7a, 0xb9, 0x8e, 0x2a, 0xaa, 0x10, 0x56, 0x04, 0xf7, 0x1d, 0xe1, 0x37, 0x92, 0x98, 0xed, 0xf3,
+	0x23, 0x64, 0x42, 0x5f, 0x80, 0xaa, 0x40, 0xe6, 0x61, 0x5c, 0xd3, 0xea, 0xda, 0xda, 0x94, 0x9b,
+	0x45, 0xfa, 0x7b, 0xa8, 0xd2, 0x90, 0x27, 0x4c, 0xd6, 0xc6, 0xea, 0xe3, 0x6b, 0x53, 0x0d, 0x72,
+	0x76, 0xb1, 0x52, 0xf9, 0x75, 0xb1, 0xb2, 0xea, 0x07, 0xb2, 0x9d, 0x34, 0xed, 0x16, 0x0f, 0x49,
+	0x8b, 0x8b, 0x90, 0x8b, 0xec, 0xb3, 0x2e, 0xbc, 0x23, 0x22, 0x4f, 0x23, 0x14, 0xf6, 0x36, 0x0f,
+	0x98, 0x9b, 0xd1, 0xad, 0x45, 0x98, 0x1f, 0x50, 0x74, 0x51, 0x44, 0x9c, 0x09, 0xb4, 0x3a, 0x30,
+	0xeb, 0x08, 0xff, 0x63, 0xe4, 0x51, 0x89, 0xbb, 0x34, 0xa6, 0xa1, 0xd0, 0x9f, 0xc0, 0x14, 0x4d,
+	0x64, 0x9b, 0xc7, 0x81, 0x3c, 0xcd, 0xfc, 0xfc, 0x5d, 0xd0, 0xdf, 0x42, 0x35, 0x52, 0xb8, 0xda,
+	0x58, 0x5d, 0x5b, 0x9b, 0xde, 0x5c, 0xb6, 0x4b, 0xdb, 0x66, 0xf7, 0x8a, 0x35, 0x26, 0x52, 0xc7,
+	0x6e, 0x46, 0xb1, 0x96, 0x60, 0xf1, 0x9a, 0x5a, 0x61, 0x64, 0x4b, 0x39, 0xdc, 0xee, 0xd0, 0x20,
+	0xfc, 0x84, 0x42, 0xa2, 0xe7, 0xe2, 0x09, 0x8d, 0x3d, 0xa1, 0x1b, 0x30, 0x19, 0x63, 0x0b, 0x83,
+	0xe3, 0xa2, 0x3b, 0x45, 0x6c, 0xb5, 0x61, 0xb9, 0x94, 0x94, 0x57, 0xed, 0x6b, 0xa0, 0xf6, 0x7f,
+	0x0d, 0xfc, 0x0c, 0x35, 0x47, 0xf8, 0x2e, 0x97, 0x54, 0x62, 0x2a, 0x15, 0x30, 0xdf, 0xcd, 0x5c,
+	0xdc, 0xe6, 0x50, 0x7f, 0x0a, 0x33, 0x0c, 0x4f, 0x0e, 0x8a, 0xfc, 0x98, 0xca, 0x4f, 0x33, 0x3c,
+	0xc9, 0xe9, 0xd6, 0x1b, 0xa8, 0x0f, 0x2b, 0x5d, 0xec, 0x63, 0x0e, 0xee, 0x89, 0x0e, 0x97, 0x42,
+	0x6d, 0x63, 0xc2, 0xed, 0x05, 0x96, 0x84, 0x47, 0x8e, 0xf0, 0xf7, 0x50, 0x3a, 0x01, 0x4b, 0x69,
+	0xbb, 0x34, 0x11, 0xe8, 0xfd, 0xe3, 0x00, 0x17, 0xd2, 0x03, 0x4c, 0x71, 0xca, 0xcb, 0xa4, 0x9b,
+	0x45, 0xfa, 0x2a, 0xcc, 0x62, 0x57, 0x22, 0xf3, 0x0e, 0x44, 0xab, 0x8d, 0x5e, 0xd2, 0xc1, 0xda,
+	0xb8, 0x02, 0x3c, 0xe8, 0x2d, 0xef, 0x65, 0xab, 0xd6, 0x32, 0x3c, 0x2e, 0x51, 0xcd, 0xad, 0x6e,
+	0xfe, 0x98, 0x80, 0x71, 0x47, 0xf8, 0xfa, 0x57, 0x80, 0xbe, 0x09, 0x7f, 0x36, 0x64, 0x4c, 0x06,
+	0xa6, 0xd2, 0x78, 0x39, 0x0a, 0xaa, 0x68, 0xca, 0x21, 0xcc, 0x0c, 0x0c, 0xee, 0xf3, 0xe1, 0xec,
+	0x7e, 0x9c, 0x61, 0x8f, 0x86, 0x2b, 0x74, 0xba, 0xa0, 0x97, 0xcc, 0xe5, 0x2d, 0x5e, 0x6f, 0xa2,
+	0x8d, 0x57, 0x77, 0x41, 0x17, 0xca, 0xdf, 0x34, 0x98, 0x2f, 0x9f, 0x39, 0x32, 0xbc, 0x5e, 0x29,
+	0xc1, 0x78, 0x7d, 0x47, 0x42, 0xe1, 0x21, 0x86, 0x87, 0x37, 0x26, 0xec, 0xc5, 0xf0, 0x62, 0xd7,
+	0xb1, 0xc6, 0xe6, 0xe8, 0xd8, 0x5c, 0xb3, 0xb1, 0x73, 0x76, 0x69, 0x6a, 0xe7, 0x97, 0xa6, 0xf6,
+	0xfb, 0xd2, 0xd4, 0xbe, 0x5f, 0x99, 0x95, 0xf3, 0x2b, 0xb3, 0xf2, 0xf3, 0xca, 0xac, 0x7c, 0x21,
+	0x7d, 0x17, 0x77, 0xff, 0x9d, 0xbb, 0xb3, 0xff, 0xc1, 0xdd, 0x21, 0xb9, 0xc0, 0x7a, 0xab, 0x4d,
+	0x03, 0x46, 0xba, 0xbd, 0x87, 0x57, 0xdd, 0xe2, 0x66, 0x55, 0x3d, 0xb9, 0x5b, 0x7f, 0x06, 0x00,
+	0x8f, 0xc1, 0xc3, 0x9a, 0xfe, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +570,18 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// BurnTokens defines a method to burn tokens
 	BurnTokens(ctx context.Context, in *MsgBurnTokens, opts ...grpc.CallOption) (*MsgBurnTokensResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint
+	// module parameters. The authority is the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ClaimVestedRewards defines a method for a developer rewards receiver to
+	// claim its vested rewards
+	ClaimVestedRewards(ctx context.Context, in *MsgClaimVestedRewards, opts ...grpc.CallOption) (*MsgClaimVestedRewardsResponse, error)
+	// RotateVestingReceiver defines a method for a developer rewards receiver to
+	// move its future monthly amounts to a new address
+	RotateVestingReceiver(ctx context.Context, in *MsgRotateVestingReceiver, opts ...grpc.CallOption) (*MsgRotateVestingReceiverResponse, error)
+	// SetMintingPaused defines a method for the governance account or the
+	// guardian to pause or resume minting and distribution
+	SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error)
 }
 
 type msgClient struct {
@@ -176,10 +601,58 @@ func (c *msgClient) BurnTokens(ctx context.Context, in *MsgBurnTokens, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVestedRewards(ctx context.Context, in *MsgClaimVestedRewards, opts ...grpc.CallOption) (*MsgClaimVestedRewardsResponse, error) {
+	out := new(MsgClaimVestedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Msg/ClaimVestedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateVestingReceiver(ctx context.Context, in *MsgRotateVestingReceiver, opts ...grpc.CallOption) (*MsgRotateVestingReceiverResponse, error) {
+	out := new(MsgRotateVestingReceiverResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Msg/RotateVestingReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error) {
+	out := new(MsgSetMintingPausedResponse)
+	err := c.cc.Invoke(ctx, "/teritori.mint.v1beta1.Msg/SetMintingPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BurnTokens defines a method to burn tokens
 	BurnTokens(context.Context, *MsgBurnTokens) (*MsgBurnTokensResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint
+	// module parameters. The authority is the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ClaimVestedRewards defines a method for a developer rewards receiver to
+	// claim its vested rewards
+	ClaimVestedRewards(context.Context, *MsgClaimVestedRewards) (*MsgClaimVestedRewardsResponse, error)
+	// RotateVestingReceiver defines a method for a developer rewards receiver to
+	// move its future monthly amounts to a new address
+	RotateVestingReceiver(context.Context, *MsgRotateVestingReceiver) (*MsgRotateVestingReceiverResponse, error)
+	// SetMintingPaused defines a method for the governance account or the
+	// guardian to pause or resume minting and distribution
+	SetMintingPaused(context.Context, *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -189,6 +662,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) BurnTokens(ctx context.Context, req *MsgBurnTokens) (*MsgBurnTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnTokens not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVestedRewards(ctx context.Context, req *MsgClaimVestedRewards) (*MsgClaimVestedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVestedRewards not implemented")
+}
+func (*UnimplementedMsgServer) RotateVestingReceiver(ctx context.Context, req *MsgRotateVestingReceiver) (*MsgRotateVestingReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateVestingReceiver not implemented")
+}
+func (*UnimplementedMsgServer) SetMintingPaused(ctx context.Context, req *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintingPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -212,24 +697,112 @@ func _Msg_BurnTokens_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "teritori.mint.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BurnTokens",
-			Handler:    _Msg_BurnTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "teritori/mint/v1beta1/tx.proto",
-}
-
-func (m *MsgBurnTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVestedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVestedRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVestedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Msg/ClaimVestedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVestedRewards(ctx, req.(*MsgClaimVestedRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateVestingReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateVestingReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateVestingReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Msg/RotateVestingReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateVestingReceiver(ctx, req.(*MsgRotateVestingReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teritori.mint.v1beta1.Msg/SetMintingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintingPaused(ctx, req.(*MsgSetMintingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "teritori.mint.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BurnTokens",
+			Handler:    _Msg_BurnTokens_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ClaimVestedRewards",
+			Handler:    _Msg_ClaimVestedRewards_Handler,
+		},
+		{
+			MethodName: "RotateVestingReceiver",
+			Handler:    _Msg_RotateVestingReceiver_Handler,
+		},
+		{
+			MethodName: "SetMintingPaused",
+			Handler:    _Msg_SetMintingPaused_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "teritori/mint/v1beta1/tx.proto",
+}
+
+func (m *MsgBurnTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -255,89 +828,984 @@ func (m *MsgBurnTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				}
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgBurnTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgBurnTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgBurnTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgBurnTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateVestingReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateVestingReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateVestingReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewReceiver) > 0 {
+		i -= len(m.NewReceiver)
+		copy(dAtA[i:], m.NewReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateVestingReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateVestingReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateVestingReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		dAtA3 := make([]byte, len(m.Slots)*10)
+		var j2 int
+		for _, num := range m.Slots {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintingPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintingPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtendSchedule {
+		i--
+		if m.ExtendSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBurnTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVestedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRotateVestingReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateVestingReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		l = 0
+		for _, e := range m.Slots {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetMintingPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.ExtendSchedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetMintingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgBurnTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateVestingReceiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -360,15 +1828,15 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateVestingReceiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateVestingReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -396,11 +1864,11 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -428,12 +1896,256 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Coin
-			m.Amount = append(m.Amount, v)
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateVestingReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateVestingReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateVestingReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slots = append(m.Slots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Slots) == 0 {
+					m.Slots = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slots = append(m.Slots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintingPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintingPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtendSchedule = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -455,7 +2167,7 @@ func (m *MsgBurnTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMintingPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,10 +2190,10 @@ func (m *MsgBurnTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMintingPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMintingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,52 +1,249 @@
 package types
 
-var vestingStr = `adress1,adress2,adress3,adress4,adress5,adress6,adress7,adress8,adress9,adress10,adress11,adress12,adress13,adress14,adress15,adress16,adress17,adress18,adress19,adress20,adress21,adress22,adress23,adress24,adress25,adress26,adress27,adress28
-55269.23,110538.46,110538.46,110538.46,55269.23,184230.77,55269.23,4053.08,6448.08,5526.92,7369.23,4053.08,3684.62,6448.08,14738.46,4053.08,2763.46,4605.77,7369.23,2763.46,4605.77,11053.85,3684.62,7369.23,7369.23,7369.23,11053.85,3684.62
-61128.55,122257.11,122257.11,122257.11,61128.55,203761.85,61128.55,4482.76,7131.66,6112.86,8150.47,4482.76,4075.24,7131.66,16300.95,4482.76,3056.43,5094.05,8150.47,3056.43,5094.05,12225.71,4075.24,8150.47,8150.47,8150.47,12225.71,4075.24
-67283.12,134566.25,134566.25,134566.25,67283.12,224277.08,67283.12,4934.1,7849.7,6728.31,8971.08,4934.1,4485.54,7849.7,17942.17,4934.1,3364.16,5606.93,8971.08,3364.16,5606.93,13456.62,4485.54,8971.08,8971.08,8971.08,13456.62,4485.54
-73655.12,147310.25,147310.25,147310.25,73655.12,245517.08,73655.12,5401.38,8593.1,7365.51,9820.68,5401.38,4910.34,8593.1,19641.37,5401.38,3682.76,6137.93,9820.68,3682.76,6137.93,14731.02,4910.34,9820.68,9820.68,9820.68,14731.02,4910.34
-80151.42,160302.83,160302.83,160302.83,80151.42,267171.38,80151.42,5877.77,9351,8015.14,10686.86,5877.77,5343.43,9351,21373.71,5877.77,4007.57,6679.28,10686.86,4007.57,6679.28,16030.28,5343.43,10686.86,10686.86,10686.86,16030.28,5343.43
-86656.94,173313.88,173313.88,173313.88,86656.94,288856.46,86656.94,6354.84,10109.98,8665.69,11554.26,6354.84,5777.13,10109.98,23108.52,6354.84,4332.85,7221.41,11554.26,4332.85,7221.41,17331.39,5777.13,11554.26,11554.26,11554.26,17331.39,5777.13
-93036.14,186072.28,186072.28,186072.28,93036.14,310120.46,93036.14,6822.65,10854.22,9303.61,12404.82,6822.65,6202.41,10854.22,24809.64,6822.65,4651.81,7753.01,12404.82,4651.81,7753.01,18607.23,6202.41,12404.82,12404.82,12404.82,18607.23,6202.41
-99136.29,198272.58,198272.58,198272.58,99136.29,330454.31,99136.29,7269.99,11565.9,9913.63,13218.17,7269.99,6609.09,11565.9,26436.34,7269.99,4956.81,8261.36,13218.17,4956.81,8261.36,19827.26,6609.09,13218.17,13218.17,13218.17,19827.26,6609.09
-104793.28,209586.55,209586.55,209586.55,104793.28,349310.92,104793.28,7684.84,12225.88,10479.33,13972.44,7684.84,6986.22,12225.88,27944.87,7684.84,5239.66,8732.77,13972.44,5239.66,8732.77,20958.66,6986.22,13972.44,13972.44,13972.44,20958.66,6986.22
-109838.82,219677.63,219677.63,219677.63,109838.82,366129.38,109838.82,8054.85,12814.53,10983.88,14645.18,8054.85,7322.59,12814.53,29290.35,8054.85,5491.94,9153.23,14645.18,5491.94,9153.23,21967.76,7322.59,14645.18,14645.18,14645.18,21967.76,7322.59
-114110.03,228220.06,228220.06,228220.06,114110.03,380366.77,114110.03,8368.07,13312.84,11411,15214.67,8368.07,7607.34,13312.84,30429.34,8368.07,5705.5,9509.17,15214.67,5705.5,9509.17,22822.01,7607.34,15214.67,15214.67,15214.67,22822.01,7607.34
-117459.46,234918.92,234918.92,234918.92,117459.46,391531.54,117459.46,8613.69,13703.6,11745.95,15661.26,8613.69,7830.63,13703.6,31322.52,8613.69,5872.97,9788.29,15661.26,5872.97,9788.29,23491.89,7830.63,15661.26,15661.26,15661.26,23491.89,7830.63
-117688.52,235377.05,235377.05,235377.05,117688.52,392295.08,117688.52,8630.49,13730.33,11768.85,15691.8,8630.49,7845.9,13730.33,31383.61,8630.49,5884.43,9807.38,15691.8,5884.43,9807.38,23537.7,7845.9,15691.8,15691.8,15691.8,23537.7,7845.9
-118864.11,237728.22,237728.22,237728.22,118864.11,396213.69,118864.11,8716.7,13867.48,11886.41,15848.55,8716.7,7924.27,13867.48,31697.1,8716.7,5943.21,9905.34,15848.55,5943.21,9905.34,23772.82,7924.27,15848.55,15848.55,15848.55,23772.82,7924.27
-118864.11,237728.22,237728.22,237728.22,118864.11,396213.69,118864.11,8716.7,13867.48,11886.41,15848.55,8716.7,7924.27,13867.48,31697.1,8716.7,5943.21,9905.34,15848.55,5943.21,9905.34,23772.82,7924.27,15848.55,15848.55,15848.55,23772.82,7924.27
-117688.52,235377.05,235377.05,235377.05,117688.52,392295.08,117688.52,8630.49,13730.33,11768.85,15691.8,8630.49,7845.9,13730.33,31383.61,8630.49,5884.43,9807.38,15691.8,5884.43,9807.38,23537.7,7845.9,15691.8,15691.8,15691.8,23537.7,7845.9
-115728.69,231457.38,231457.38,231457.38,115728.69,385762.31,115728.69,8486.77,13501.68,11572.87,15430.49,8486.77,7715.25,13501.68,30860.98,8486.77,5786.43,9644.06,15430.49,5786.43,9644.06,23145.74,7715.25,15430.49,15430.49,15430.49,23145.74,7715.25
-114110.03,228220.06,228220.06,228220.06,114110.03,380366.77,114110.03,8368.07,13312.84,11411,15214.67,8368.07,7607.34,13312.84,30429.34,8368.07,5705.5,9509.17,15214.67,5705.5,9509.17,22822.01,7607.34,15214.67,15214.67,15214.67,22822.01,7607.34
-109838.82,219677.63,219677.63,219677.63,109838.82,366129.38,109838.82,8054.85,12814.53,10983.88,14645.18,8054.85,7322.59,12814.53,29290.35,8054.85,5491.94,9153.23,14645.18,5491.94,9153.23,21967.76,7322.59,14645.18,14645.18,14645.18,21967.76,7322.59
-104793.28,209586.55,209586.55,209586.55,104793.28,349310.92,104793.28,7684.84,12225.88,10479.33,13972.44,7684.84,6986.22,12225.88,27944.87,7684.84,5239.66,8732.77,13972.44,5239.66,8732.77,20958.66,6986.22,13972.44,13972.44,13972.44,20958.66,6986.22
-99136.29,198272.58,198272.58,198272.58,99136.29,330454.31,99136.29,7269.99,11565.9,9913.63,13218.17,7269.99,6609.09,11565.9,26436.34,7269.99,4956.81,8261.36,13218.17,4956.81,8261.36,19827.26,6609.09,13218.17,13218.17,13218.17,19827.26,6609.09
-93036.14,186072.28,186072.28,186072.28,93036.14,310120.46,93036.14,6822.65,10854.22,9303.61,12404.82,6822.65,6202.41,10854.22,24809.64,6822.65,4651.81,7753.01,12404.82,4651.81,7753.01,18607.23,6202.41,12404.82,12404.82,12404.82,18607.23,6202.41
-86656.94,173313.88,173313.88,173313.88,86656.94,288856.46,86656.94,6354.84,10109.98,8665.69,11554.26,6354.84,5777.13,10109.98,23108.52,6354.84,4332.85,7221.41,11554.26,4332.85,7221.41,17331.39,5777.13,11554.26,11554.26,11554.26,17331.39,5777.13
-80151.42,160302.83,160302.83,160302.83,80151.42,267171.38,80151.42,5877.77,9351,8015.14,10686.86,5877.77,5343.43,9351,21373.71,5877.77,4007.57,6679.28,10686.86,4007.57,6679.28,16030.28,5343.43,10686.86,10686.86,10686.86,16030.28,5343.43
-73655.12,147310.25,147310.25,147310.25,73655.12,245517.08,73655.12,5401.38,8593.1,7365.51,9820.68,5401.38,4910.34,8593.1,19641.37,5401.38,3682.76,6137.93,9820.68,3682.76,6137.93,14731.02,4910.34,9820.68,9820.68,9820.68,14731.02,4910.34
-67283.12,134566.25,134566.25,134566.25,67283.12,224277.08,67283.12,4934.1,7849.7,6728.31,8971.08,4934.1,4485.54,7849.7,17942.17,4934.1,3364.16,5606.93,8971.08,3364.16,5606.93,13456.62,4485.54,8971.08,8971.08,8971.08,13456.62,4485.54
-61128.55,122257.11,122257.11,122257.11,61128.55,203761.85,61128.55,4482.76,7131.66,6112.86,8150.47,4482.76,4075.24,7131.66,16300.95,4482.76,3056.43,5094.05,8150.47,3056.43,5094.05,12225.71,4075.24,8150.47,8150.47,8150.47,12225.71,4075.24
-55262.77,110525.54,110525.54,110525.54,55262.77,184209.23,55262.77,4052.6,6447.32,5526.28,7368.37,4052.6,3684.18,6447.32,14736.74,4052.6,2763.14,4605.23,7368.37,2763.14,4605.23,11052.55,3684.18,7368.37,7368.37,7368.37,11052.55,3684.18
-49736.82,99473.63,99473.63,99473.63,49736.82,165789.38,49736.82,3647.37,5802.63,4973.68,6631.58,3647.37,3315.79,5802.63,13263.15,3647.37,2486.84,4144.73,6631.58,2486.84,4144.73,9947.36,3315.79,6631.58,6631.58,6631.58,9947.36,3315.79
-44583.42,89166.83,89166.83,89166.83,44583.42,148611.38,44583.42,3269.45,5201.4,4458.34,5944.46,3269.45,2972.23,5201.4,11888.91,3269.45,2229.17,3715.28,5944.46,2229.17,3715.28,8916.68,2972.23,5944.46,5944.46,5944.46,8916.68,2972.23
-39819.88,79639.75,79639.75,79639.75,39819.88,132732.92,39819.88,2920.12,4645.65,3981.99,5309.32,2920.12,2654.66,4645.65,10618.63,2920.12,1990.99,3318.32,5309.32,1990.99,3318.32,7963.98,2654.66,5309.32,5309.32,5309.32,7963.98,2654.66
-35450.72,70901.45,70901.45,70901.45,35450.72,118169.08,35450.72,2599.72,4135.92,3545.07,4726.76,2599.72,2363.38,4135.92,9453.53,2599.72,1772.54,2954.23,4726.76,1772.54,2954.23,7090.14,2363.38,4726.76,4726.76,4726.76,7090.14,2363.38
-31470.46,62940.92,62940.92,62940.92,31470.46,104901.54,31470.46,2307.83,3671.55,3147.05,4196.06,2307.83,2098.03,3671.55,8392.12,2307.83,1573.52,2622.54,4196.06,1573.52,2622.54,6294.09,2098.03,4196.06,4196.06,4196.06,6294.09,2098.03
-27865.85,55731.69,55731.69,55731.69,27865.85,92886.15,27865.85,2043.5,3251.02,2786.58,3715.45,2043.5,1857.72,3251.02,7430.89,2043.5,1393.29,2322.15,3715.45,1393.29,2322.15,5573.17,1857.72,3715.45,3715.45,3715.45,5573.17,1857.72
-24618.46,49236.92,49236.92,49236.92,24618.46,82061.54,24618.46,1805.35,2872.15,2461.85,3282.46,1805.35,1641.23,2872.15,6564.92,1805.35,1230.92,2051.54,3282.46,1230.92,2051.54,4923.69,1641.23,3282.46,3282.46,3282.46,4923.69,1641.23
-21706.15,43412.31,43412.31,43412.31,21706.15,72353.85,21706.15,1591.78,2532.38,2170.62,2894.15,1591.78,1447.08,2532.38,5788.31,1591.78,1085.31,1808.85,2894.15,1085.31,1808.85,4341.23,1447.08,2894.15,2894.15,2894.15,4341.23,1447.08
-19104.69,38209.38,38209.38,38209.38,19104.69,63682.3,19104.69,1401.01,2228.88,1910.47,2547.29,1401.01,1273.65,2228.88,5094.58,1401.01,955.23,1592.06,2547.29,955.23,1592.06,3820.94,1273.65,2547.29,2547.29,2547.29,3820.94,1273.65
-16788.97,33577.94,33577.94,33577.94,16788.97,55963.23,16788.97,1231.19,1958.71,1678.9,2238.53,1231.19,1119.26,1958.71,4477.06,1231.19,839.45,1399.08,2238.53,839.45,1399.08,3357.79,1119.26,2238.53,2238.53,2238.53,3357.79,1119.26
-14733.88,29467.75,29467.75,29467.75,14733.88,49112.92,14733.88,1080.48,1718.95,1473.39,1964.52,1080.48,982.26,1718.95,3929.03,1080.48,736.69,1227.82,1964.52,736.69,1227.82,2946.78,982.26,1964.52,1964.52,1964.52,2946.78,982.26
-12914.91,25829.81,25829.81,25829.81,12914.91,43049.69,12914.91,947.09,1506.74,1291.49,1721.99,947.09,860.99,1506.74,3443.98,947.09,645.75,1076.24,1721.99,645.75,1076.24,2582.98,860.99,1721.99,1721.99,1721.99,2582.98,860.99
-11308.61,22617.23,22617.23,22617.23,11308.61,37695.38,11308.61,829.3,1319.34,1130.86,1507.82,829.3,753.91,1319.34,3015.63,829.3,565.43,942.38,1507.82,565.43,942.38,2261.72,753.91,1507.82,1507.82,1507.82,2261.72,753.91
-9893.03,19786.06,19786.06,19786.06,9893.03,32976.77,9893.03,725.49,1154.19,989.3,1319.07,725.49,659.54,1154.19,2638.14,725.49,494.65,824.42,1319.07,494.65,824.42,1978.61,659.54,1319.07,1319.07,1319.07,1978.61,659.54
-8647.71,17295.41,17295.41,17295.41,8647.71,28825.69,8647.71,634.17,1008.9,864.77,1153.03,634.17,576.51,1008.9,2306.06,634.17,432.39,720.64,1153.03,432.39,720.64,1729.54,576.51,1153.03,1153.03,1153.03,1729.54,576.51
-7553.86,15107.72,15107.72,15107.72,7553.86,25179.54,7553.86,553.95,881.28,755.39,1007.18,553.95,503.59,881.28,2014.36,553.95,377.69,629.49,1007.18,377.69,629.49,1510.77,503.59,1007.18,1007.18,1007.18,1510.77,503.59
-6594.32,13188.65,13188.65,13188.65,6594.32,21981.08,6594.32,483.58,769.34,659.43,879.24,483.58,439.62,769.34,1758.49,483.58,329.72,549.53,879.24,329.72,549.53,1318.86,439.62,879.24,879.24,879.24,1318.86,439.62
-5753.58,11507.17,11507.17,11507.17,5753.58,19178.61,5753.58,421.93,671.25,575.36,767.14,421.93,383.57,671.25,1534.29,421.93,287.68,479.47,767.14,287.68,479.47,1150.72,383.57,767.14,767.14,767.14,1150.72,383.57
-5017.71,10035.41,10035.41,10035.41,5017.71,16725.69,5017.71,367.97,585.4,501.77,669.03,367.97,334.51,585.4,1338.06,367.97,250.89,418.14,669.03,250.89,418.14,1003.54,334.51,669.03,669.03,669.03,1003.54,334.51
-5017.71,10035.41,10035.41,10035.41,5017.71,16725.69,5017.71,367.97,585.4,501.77,669.03,367.97,334.51,585.4,1338.06,367.97,250.89,418.14,669.03,250.89,418.14,1003.54,334.51,669.03,669.03,669.03,1003.54,334.51
-5017.71,10035.41,10035.41,10035.41,5017.71,16725.69,5017.71,367.97,585.4,501.77,669.03,367.97,334.51,585.4,1338.06,367.97,250.89,418.14,669.03,250.89,418.14,1003.54,334.51,669.03,669.03,669.03,1003.54,334.51`
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A vesting schedule CSV has one column per developer rewards receiver slot,
+// headed by the receiver address, and one row per month, holding the monthly
+// amounts in display units.
+
+// ParseVestingScheduleCSV parses a vesting schedule CSV into developer rewards
+// receivers. Amounts are converted to base units by multiplying them by 10 to
+// the given exponent and truncating. An empty amount is a zero amount.
+func ParseVestingScheduleCSV(r io.Reader, exponent uint32) ([]MonthlyVestingAddress, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.TrimLeadingSpace = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("vesting schedule has no header")
+	}
+
+	multiplier := sdk.NewDecFromInt(math.NewIntWithDecimal(1, int(exponent)))
+	receivers := make([]MonthlyVestingAddress, 0, len(records[0]))
+	for slot, addr := range records[0] {
+		addr = strings.TrimSpace(addr)
+		if addr != "" {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, fmt.Errorf("slot %d: invalid address %s: %w", slot, addr, err)
+			}
+		}
+		receivers = append(receivers, MonthlyVestingAddress{
+			Address:        addr,
+			MonthlyAmounts: make([]math.Int, 0, len(records)-1),
+		})
+	}
+
+	for month, line := range records[1:] {
+		for slot, amountStr := range line {
+			amountStr = strings.TrimSpace(amountStr)
+			if amountStr == "" {
+				receivers[slot].MonthlyAmounts = append(receivers[slot].MonthlyAmounts, math.ZeroInt())
+				continue
+			}
+			amountDec, err := sdk.NewDecFromStr(amountStr)
+			if err != nil {
+				return nil, fmt.Errorf("month %d, slot %d: invalid amount %s: %w", month, slot, amountStr, err)
+			}
+			if amountDec.IsNegative() {
+				return nil, fmt.Errorf("month %d, slot %d: negative amount %s", month, slot, amountStr)
+			}
+			receivers[slot].MonthlyAmounts = append(receivers[slot].MonthlyAmounts, amountDec.Mul(multiplier).TruncateInt())
+		}
+	}
+
+	return receivers, nil
+}
+
+// WriteVestingScheduleCSV writes developer rewards receivers as a vesting
+// schedule CSV, dividing the amounts by 10 to the given exponent. The months a
+// receiver has no amount for are left empty.
+func WriteVestingScheduleCSV(w io.Writer, receivers []MonthlyVestingAddress, exponent uint32) error {
+	months := 0
+	header := make([]string, 0, len(receivers))
+	for _, receiver := range receivers {
+		header = append(header, receiver.Address)
+		if len(receiver.MonthlyAmounts) > months {
+			months = len(receiver.MonthlyAmounts)
+		}
+	}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+	for month := 0; month < months; month++ {
+		line := make([]string, 0, len(receivers))
+		for _, receiver := range receivers {
+			if month >= len(receiver.MonthlyAmounts) {
+				line = append(line, "")
+				continue
+			}
+			line = append(line, FormatVestingAmount(receiver.MonthlyAmounts[month], exponent))
+		}
+		if err := csvWriter.Write(line); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// FormatVestingAmount formats a base units amount in display units, without
+// trailing zeros.
+func FormatVestingAmount(amount math.Int, exponent uint32) string {
+	if exponent == 0 {
+		return amount.String()
+	}
+	str := sdk.NewDecFromIntWithPrec(amount, int64(exponent)).String()
+	str = strings.TrimRight(str, "0")
+	return strings.TrimSuffix(str, ".")
+}
+
+// VestingScheduleChange is the change of a developer rewards receiver slot
+// between two vesting schedules.
+type VestingScheduleChange struct {
+	Slot          int
+	OldAddress    string
+	NewAddress    string
+	OldTotal      math.Int
+	NewTotal      math.Int
+	ChangedMonths int
+}
+
+// DiffVestingSchedules returns the changes of the slots that differ between the
+// old and new vesting schedules. A slot missing from a schedule has an empty
+// address and no amounts.
+func DiffVestingSchedules(oldReceivers, newReceivers []MonthlyVestingAddress) []VestingScheduleChange {
+	slots := len(oldReceivers)
+	if len(newReceivers) > slots {
+		slots = len(newReceivers)
+	}
+
+	changes := []VestingScheduleChange{}
+	for slot := 0; slot < slots; slot++ {
+		var oldReceiver, newReceiver MonthlyVestingAddress
+		if slot < len(oldReceivers) {
+			oldReceiver = oldReceivers[slot]
+		}
+		if slot < len(newReceivers) {
+			newReceiver = newReceivers[slot]
+		}
+
+		change := VestingScheduleChange{
+			Slot:       slot,
+			OldAddress: oldReceiver.Address,
+			NewAddress: newReceiver.Address,
+			OldTotal:   totalVestingAmount(oldReceiver.MonthlyAmounts),
+			NewTotal:   totalVestingAmount(newReceiver.MonthlyAmounts),
+		}
+		months := len(oldReceiver.MonthlyAmounts)
+		if len(newReceiver.MonthlyAmounts) > months {
+			months = len(newReceiver.MonthlyAmounts)
+		}
+		for month := 0; month < months; month++ {
+			if !monthlyAmount(oldReceiver, month).Equal(monthlyAmount(newReceiver, month)) {
+				change.ChangedMonths++
+			}
+		}
+
+		if change.OldAddress != change.NewAddress || change.ChangedMonths > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func monthlyAmount(receiver MonthlyVestingAddress, month int) math.Int {
+	if month >= len(receiver.MonthlyAmounts) || receiver.MonthlyAmounts[month].IsNil() {
+		return math.ZeroInt()
+	}
+	return receiver.MonthlyAmounts[month]
+}
+
+func totalVestingAmount(amounts []math.Int) math.Int {
+	total := math.ZeroInt()
+	for _, amount := range amounts {
+		if !amount.IsNil() {
+			total = total.Add(amount)
+		}
+	}
+	return total
+}
+
+// ValidateDeveloperRewardsBudget checks that, from the current month to the end
+// of the vesting schedule, the developer rewards allocated in each block cover
+// the amounts vested to the developer rewards receivers in the block, assuming
+// the parameters do not change. The budget of a month is the developer rewards
+// of its last block, the lowest of the month. In time mode, reduction periods
+// are assumed to last reduction_period_in_blocks blocks.
+func ValidateDeveloperRewardsBudget(params Params, minter Minter, lastReductionBlock int64, monthInfo TeamVestingMonthInfo) error {
+	var developerRewardsWeight *sdk.Dec
+	for _, r := range params.DistributionRecipients {
+		if r.TargetType == DistributionTargetDeveloperVesting {
+			weight := r.Weight
+			developerRewardsWeight = &weight
+		}
+	}
+	// the vesting schedule is not used without a developer vesting recipient
+	if developerRewardsWeight == nil {
+		return nil
+	}
+
+	lastMonth := int64(0)
+	for _, w := range params.WeightedDeveloperRewardsReceivers {
+		if int64(len(w.MonthlyAmounts)) > lastMonth {
+			lastMonth = int64(len(w.MonthlyAmounts))
+		}
+	}
+	if lastMonth <= monthInfo.MonthsSinceGenesis {
+		return nil
+	}
+	if monthInfo.OneMonthPeriodInBlocks <= 0 {
+		return fmt.Errorf("invalid one month period in blocks: %d", monthInfo.OneMonthPeriodInBlocks)
+	}
+
+	// minting and the vesting months start at the distribution start block
+	periodStart := lastReductionBlock
+	if periodStart < params.MintingRewardsDistributionStartBlock {
+		periodStart = params.MintingRewardsDistributionStartBlock
+	}
+	monthStart := monthInfo.MonthStartedBlock
+	if monthStart < params.MintingRewardsDistributionStartBlock {
+		monthStart = params.MintingRewardsDistributionStartBlock
+	}
+
+	for month := monthInfo.MonthsSinceGenesis; month < lastMonth; month++ {
+		lastBlock := monthStart + (month-monthInfo.MonthsSinceGenesis+1)*monthInfo.OneMonthPeriodInBlocks - 1
+		blockProvisions := minter.BlockProvisions
+		for height := periodStart + params.ReductionPeriodInBlocks; height <= lastBlock; height += params.ReductionPeriodInBlocks {
+			blockProvisions = blockProvisions.Mul(params.ReductionFactor)
+		}
+		budget := sdk.NewDecFromInt(blockProvisions.TruncateInt()).Mul(*developerRewardsWeight).TruncateInt()
+
+		// the amounts vested in a block are at most the monthly amounts spread
+		// over the month, rounded up
+		vested := math.ZeroInt()
+		for _, w := range params.WeightedDeveloperRewardsReceivers {
+			if w.Address == "" {
+				continue
+			}
+			amount := monthlyAmount(w, int(month))
+			vested = vested.Add(amount.AddRaw(monthInfo.OneMonthPeriodInBlocks - 1).QuoRaw(monthInfo.OneMonthPeriodInBlocks))
+		}
+		if vested.GT(budget) {
+			return fmt.Errorf("month %d: developer vesting of up to %s per block exceeds the developer rewards of %s per block", month, vested, budget)
+		}
+	}
+
+	return nil
+}
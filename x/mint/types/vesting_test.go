@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TERITORI/teritori-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func testAddress(t *testing.T, b byte) string {
+	addr, err := bech32.ConvertAndEncode(sdk.GetConfig().GetBech32AccountAddrPrefix(), bytes.Repeat([]byte{b}, 20))
+	require.NoError(t, err)
+	return addr
+}
+
+func TestVestingScheduleCSV(t *testing.T) {
+	addr1, addr2 := testAddress(t, 1), testAddress(t, 2)
+	csv := addr1 + "," + addr2 + "\n" +
+		"1.5,2\n" +
+		"0.000001,\n"
+
+	receivers, err := types.ParseVestingScheduleCSV(strings.NewReader(csv), 6)
+	require.NoError(t, err)
+	require.Equal(t, []types.MonthlyVestingAddress{
+		{Address: addr1, MonthlyAmounts: []math.Int{math.NewInt(1500000), math.NewInt(1)}},
+		{Address: addr2, MonthlyAmounts: []math.Int{math.NewInt(2000000), math.NewInt(0)}},
+	}, receivers)
+
+	var buf bytes.Buffer
+	require.NoError(t, types.WriteVestingScheduleCSV(&buf, receivers, 6))
+	require.Equal(t, addr1+","+addr2+"\n1.5,2\n0.000001,0\n", buf.String())
+
+	_, err = types.ParseVestingScheduleCSV(strings.NewReader("invalid\n1\n"), 6)
+	require.Error(t, err)
+	_, err = types.ParseVestingScheduleCSV(strings.NewReader(addr1+"\n-1\n"), 6)
+	require.Error(t, err)
+	_, err = types.ParseVestingScheduleCSV(strings.NewReader(addr1+"\n1,2\n"), 6)
+	require.Error(t, err)
+}
+
+func TestDiffVestingSchedules(t *testing.T) {
+	addr1, addr2, addr3 := testAddress(t, 1), testAddress(t, 2), testAddress(t, 3)
+	oldReceivers := []types.MonthlyVestingAddress{
+		{Address: addr1, MonthlyAmounts: []math.Int{math.NewInt(10), math.NewInt(10)}},
+		{Address: addr2, MonthlyAmounts: []math.Int{math.NewInt(20)}},
+	}
+	newReceivers := []types.MonthlyVestingAddress{
+		{Address: addr1, MonthlyAmounts: []math.Int{math.NewInt(10), math.NewInt(10)}},
+		{Address: addr3, MonthlyAmounts: []math.Int{math.NewInt(20), math.NewInt(5)}},
+		{Address: addr2, MonthlyAmounts: []math.Int{math.NewInt(1)}},
+	}
+
+	require.Empty(t, types.DiffVestingSchedules(oldReceivers, oldReceivers))
+	require.Equal(t, []types.VestingScheduleChange{
+		{Slot: 1, OldAddress: addr2, NewAddress: addr3, OldTotal: math.NewInt(20), NewTotal: math.NewInt(25), ChangedMonths: 1},
+		{Slot: 2, OldAddress: "", NewAddress: addr2, OldTotal: math.NewInt(0), NewTotal: math.NewInt(1), ChangedMonths: 1},
+	}, types.DiffVestingSchedules(oldReceivers, newReceivers))
+}
+
+func TestValidateDeveloperRewardsBudget(t *testing.T) {
+	params := types.DefaultParams()
+	params.ReductionPeriodInBlocks = 20
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	minter := types.NewMinter(sdk.NewDec(1000)) // 150 developer rewards per block
+	monthInfo := types.TeamVestingMonthInfo{OneMonthPeriodInBlocks: 10}
+
+	// 1500 per month vests 150 per block, the budget of the first reduction period
+	params.WeightedDeveloperRewardsReceivers = []types.MonthlyVestingAddress{
+		{Address: testAddress(t, 1), MonthlyAmounts: []math.Int{math.NewInt(1000), math.NewInt(1000), math.NewInt(750)}},
+		{Address: testAddress(t, 2), MonthlyAmounts: []math.Int{math.NewInt(500), math.NewInt(500)}},
+		{Address: "", MonthlyAmounts: []math.Int{math.NewInt(1000)}},
+	}
+	require.NoError(t, types.ValidateDeveloperRewardsBudget(params, minter, 0, monthInfo))
+
+	// the third month is in the second reduction period, with a budget of 75 per block
+	params.WeightedDeveloperRewardsReceivers[0].MonthlyAmounts[2] = math.NewInt(751)
+	require.ErrorContains(t, types.ValidateDeveloperRewardsBudget(params, minter, 0, monthInfo), "month 2")
+
+	// the past months are not checked
+	monthInfo.MonthsSinceGenesis = 3
+	require.NoError(t, types.ValidateDeveloperRewardsBudget(params, minter, 0, monthInfo))
+}